@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// registerAssigneeRoutes はTodoの担当者のルートを登録する
+// 担当者はリストのTodoにだけ、リストの所有者かメンバーから選ぶ（受信箱のTodoには付けない）
+func registerAssigneeRoutes(g *echo.Group, db bob.DB) {
+	// 自分が担当している未完了のTodo（アクセスできるすべてのリストから）
+	g.GET("/assigned", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		todos, err := models.Todos.Query(
+			sm.Where(accessibleTodos(userID)),
+			models.SelectWhere.Todos.AssigneeID.EQ(userID),
+			models.SelectWhere.Todos.Completed.EQ(false),
+			sm.OrderBy(sqlite.Raw(`"todos"."due_at" IS NULL`)),
+			sm.OrderBy(models.Todos.Columns.DueAt),
+			sm.OrderBy(models.Todos.Columns.Priority).Desc(),
+			sm.OrderBy(models.Todos.Columns.ID),
+			models.SelectThenLoad.Todo.Tags(sm.OrderBy(models.Tags.Columns.Name)),
+			models.SelectThenLoad.Todo.Children(sm.OrderBy(models.Todos.Columns.ID)),
+			models.SelectThenLoad.Todo.TimeEntries(),
+			models.SelectThenLoad.Todo.Assignee(),
+			todoBlockersLoad(),
+		).All(ctx, db)
+		if err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, views.AssignedIndex(todos, c.Get("csrf").(string)))
+	})
+
+	// 担当者の変更。assignee_id が空なら担当を外す
+	// 自分以外を担当者にしたときは、担当者に通知する
+	g.POST("/:id/assignee", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		userID := c.Get("user_id").(int64)
+		listID, ok := todo.ListID.Get()
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "受信箱のTodoには担当者を設定できません")
+		}
+
+		setter := &models.TodoSetter{UpdatedAt: omit.From(time.Now().UTC())}
+		var assigneeID int64
+		if v := c.FormValue("assignee_id"); v != "" {
+			id, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "担当者が正しくありません")
+			}
+			list, err := models.FindList(ctx, db, listID)
+			if err != nil {
+				return err
+			}
+			role, err := listRole(ctx, db, id, list)
+			if err != nil {
+				return err
+			}
+			if role == RoleNone {
+				return echo.NewHTTPError(http.StatusBadRequest, "担当者はリストのメンバーから選んでください")
+			}
+			assigneeID = id
+			setter.AssigneeID = omitnull.From(id)
+		} else {
+			setter.AssigneeID.Null()
+		}
+
+		previous := todo.AssigneeID
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			if err := todo.Update(ctx, exec, setter); err != nil {
+				return err
+			}
+			if assigneeID == 0 || assigneeID == userID || previous == null.From(assigneeID) {
+				return nil
+			}
+			return notify(ctx, exec, assigneeID, userID, notificationAssigned, todo.ID, 0)
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoDetailChange(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// listAssignees はリストのTodoの担当者として選べるユーザー（リストの所有者とメンバー）をメールアドレス順に返す
+func listAssignees(ctx context.Context, exec bob.Executor, listID int64) (models.UserSlice, error) {
+	return models.Users.Query(
+		sm.Where(sqlite.Or(
+			models.Users.Columns.ID.OP("IN", sqlite.Select(
+				sm.Columns(models.Lists.Columns.UserID),
+				sm.From(models.Lists.Name()),
+				models.SelectWhere.Lists.ID.EQ(listID),
+			)),
+			models.Users.Columns.ID.OP("IN", sqlite.Select(
+				sm.Columns(models.ListMembers.Columns.UserID),
+				sm.From(models.ListMembers.Name()),
+				models.SelectWhere.ListMembers.ListID.EQ(listID),
+			)),
+		)),
+		sm.OrderBy(models.Users.Columns.Email),
+	).All(ctx, exec)
+}
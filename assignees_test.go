@@ -0,0 +1,117 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestTodoAssignee(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	taro := createTestUser(t, db, "taro.yamada@example.com")
+	outsider := createTestUser(t, db, "outsider@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.Name("Shared"), factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(taro),
+		factory.ListMemberMods.Role(memberRoleEditor),
+	).CreateOrFail(ctx, t, db)
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(owner),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Title("write docs"),
+	).CreateOrFail(ctx, t, db)
+	inbox := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+
+	tc := login(t, e, owner)
+	assign := func(todo *models.Todo, assigneeID string) int {
+		t.Helper()
+		path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/assignee"
+		return tc.do(http.MethodPost, path, url.Values{"assignee_id": {assigneeID}}).Code
+	}
+
+	// メンバーを担当者にすると、行にイニシャルが出て、担当者に通知が届く
+	rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/assignee", url.Values{"assignee_id": {strconv.FormatInt(taro.ID, 10)}})
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), ">TY<") {
+		t.Fatalf("assign: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	notifications, err := models.Notifications.Query(models.SelectWhere.Notifications.UserID.EQ(taro.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifications) != 1 || notifications[0].Kind != notificationAssigned || notifications[0].ActorID != owner.ID {
+		t.Errorf("notifications = %+v", notifications)
+	}
+	if body := login(t, e, taro).do(http.MethodGet, "/notifications", nil).Body.String(); !strings.Contains(body, "をあなたに割り当てました") {
+		t.Errorf("notification index = %s", body)
+	}
+
+	// 自分の担当には担当しているTodoだけが出る
+	if body := login(t, e, taro).do(http.MethodGet, "/todos/assigned", nil).Body.String(); !strings.Contains(body, "write docs") {
+		t.Errorf("assigned view = %s", body)
+	}
+	if body := tc.do(http.MethodGet, "/todos/assigned", nil).Body.String(); strings.Contains(body, "write docs") {
+		t.Errorf("assigned view of the owner = %s", body)
+	}
+
+	// メンバーでないユーザーや受信箱のTodoには割り当てられない
+	if code := assign(todo, strconv.FormatInt(outsider.ID, 10)); code != http.StatusBadRequest {
+		t.Errorf("assign outsider: status = %d, want %d", code, http.StatusBadRequest)
+	}
+	if code := assign(inbox, strconv.FormatInt(owner.ID, 10)); code != http.StatusBadRequest {
+		t.Errorf("assign inbox todo: status = %d, want %d", code, http.StatusBadRequest)
+	}
+
+	// 自分への割り当てでは通知しない。担当の変更と解除は操作したユーザー付きで履歴に残る
+	if code := assign(todo, strconv.FormatInt(owner.ID, 10)); code != http.StatusOK {
+		t.Fatalf("reassign: status = %d", code)
+	}
+	if code := assign(todo, ""); code != http.StatusOK {
+		t.Fatalf("unassign: status = %d", code)
+	}
+	if n, _ := models.Notifications.Query().Count(ctx, db); n != 1 {
+		t.Errorf("notifications = %d, want 1", n)
+	}
+	events, err := models.TodoEvents.Query(
+		models.SelectWhere.TodoEvents.TodoID.EQ(todo.ID),
+		models.SelectWhere.TodoEvents.Kind.EQ(todoEventAssignee),
+	).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ from, to string }{
+		{"", taro.Email},
+		{taro.Email, owner.Email},
+		{owner.Email, ""},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("assignee events = %d, want %d", len(events), len(want))
+	}
+	for i, event := range events {
+		if event.OldValue.GetOrZero() != want[i].from || event.NewValue.GetOrZero() != want[i].to || event.UserID.GetOrZero() != owner.ID {
+			t.Errorf("event %d = %v → %v by %v", i, event.OldValue, event.NewValue, event.UserID)
+		}
+	}
+
+	// メンバーから外すと担当も外れる
+	if code := assign(todo, strconv.FormatInt(taro.ID, 10)); code != http.StatusOK {
+		t.Fatalf("assign again: status = %d", code)
+	}
+	if code := tc.do(http.MethodPost, "/lists/"+strconv.FormatInt(list.ID, 10)+"/members/"+strconv.FormatInt(taro.ID, 10)+"/delete", url.Values{}).Code; code != http.StatusOK {
+		t.Fatalf("remove member: status = %d", code)
+	}
+	if got, _ := models.FindTodo(ctx, db, todo.ID); got.AssigneeID.IsValue() {
+		t.Errorf("assignee after removing member = %v", got.AssigneeID)
+	}
+}
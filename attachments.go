@@ -0,0 +1,358 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/storage"
+)
+
+// maxAttachmentSize は添付ファイル1つの最大サイズ（10MB）
+// リクエスト全体の大きさは newServer の BodyLimit でも制限している
+const maxAttachmentSize = 10 << 20
+
+// thumbnailSize はサムネイルの長辺のピクセル数
+const thumbnailSize = 320
+
+// maxThumbnailPixels はサムネイルを作る画像の最大ピクセル数。これより大きい画像は展開しない
+const maxThumbnailPixels = 40_000_000
+
+// attachmentTypes は添付できるファイルの種類（中身から判定したMIMEタイプ）と、ブラウザで直接表示するかどうか
+// クライアントが送ってくるContent-Typeやファイル名の拡張子は信用しない
+var attachmentTypes = map[string]bool{
+	"image/png":                 true,
+	"image/jpeg":                true,
+	"image/gif":                 true,
+	"image/webp":                true,
+	"application/pdf":           true,
+	"text/plain; charset=utf-8": false,
+}
+
+// blobMu は保存先の中身の追加と削除を1件ずつ処理する
+// 参照されていないことを確かめてから中身を消すまでの間に、同じ中身の添付が割り込んで中身を失わないようにする
+var blobMu sync.Mutex
+
+// registerAttachmentRoutes は添付ファイルのルートを登録する
+// ファイルは保存先のキーやパスを出さず、TodoとIDで指定して権限を確かめてから返す
+func registerAttachmentRoutes(g *echo.Group, db bob.DB, store storage.Storage) {
+	// アップロード
+	g.POST("/:id/attachments", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "ファイルを選んでください")
+		}
+		if fh.Size > maxAttachmentSize {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "ファイルは10MBまでです")
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		data, err := io.ReadAll(io.LimitReader(f, maxAttachmentSize+1))
+		if err != nil {
+			return err
+		}
+		if len(data) > maxAttachmentSize {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "ファイルは10MBまでです")
+		}
+		if len(data) == 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "空のファイルは添付できません")
+		}
+		contentType := http.DetectContentType(data)
+		if _, ok := attachmentTypes[contentType]; !ok {
+			return echo.NewHTTPError(http.StatusUnsupportedMediaType, "添付できるのは画像・PDF・テキストファイルです")
+		}
+
+		// 中身のSHA-256をキーにするので、同じファイルは何度添付しても1つだけ保存される
+		sum := sha256.Sum256(data)
+		key := hex.EncodeToString(sum[:])
+		thumbnail, err := makeThumbnail(data)
+		if err != nil {
+			c.Logger().Warnf("thumbnail: %v", err)
+		}
+
+		err = func() error {
+			blobMu.Lock()
+			defer blobMu.Unlock()
+			if err := store.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+				return err
+			}
+			if thumbnail != nil {
+				if err := store.Put(ctx, thumbnailKey(key), bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/png"); err != nil {
+					return err
+				}
+			}
+			_, err := models.Attachments.Insert(&models.AttachmentSetter{
+				TodoID:       omit.From(todo.ID),
+				UserID:       omit.From(c.Get("user_id").(int64)),
+				Filename:     omit.From(attachmentFilename(fh.Filename)),
+				ContentType:  omit.From(contentType),
+				Size:         omit.From(int64(len(data))),
+				Sha256:       omit.From(key),
+				HasThumbnail: omit.From(thumbnail != nil),
+			}).One(ctx, db)
+			return err
+		}()
+		if err != nil {
+			return err
+		}
+		return renderTodoDetail(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+
+	// ダウンロード。画像とPDFはブラウザで表示し、それ以外は保存させる
+	g.GET("/:id/attachments/:attachment_id", func(c echo.Context) error {
+		attachment, err := findAttachment(c, db)
+		if err != nil {
+			return err
+		}
+		disposition := "attachment"
+		if attachmentTypes[attachment.ContentType] {
+			disposition = "inline"
+		}
+		return serveBlob(c, store, attachment.Sha256, attachment.ContentType,
+			mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename}))
+	}, requireTodoRole(db, RoleViewer))
+
+	// サムネイル
+	g.GET("/:id/attachments/:attachment_id/thumbnail", func(c echo.Context) error {
+		attachment, err := findAttachment(c, db)
+		if err != nil {
+			return err
+		}
+		if !attachment.HasThumbnail {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		return serveBlob(c, store, thumbnailKey(attachment.Sha256), "image/png", "inline")
+	}, requireTodoRole(db, RoleViewer))
+
+	// 削除
+	g.POST("/:id/attachments/:attachment_id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		attachment, err := findAttachment(c, db)
+		if err != nil {
+			return err
+		}
+		if err := attachment.Delete(ctx, db); err != nil {
+			return err
+		}
+		if err := deleteUnusedBlobs(ctx, db, store, attachment.Sha256); err != nil {
+			return err
+		}
+		return renderTodoDetail(c, db, c.Get("todo").(*models.Todo))
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// findAttachment はパスパラメータ :attachment_id の添付ファイルを返す。"todo" のTodoのものでなければ404とする
+func findAttachment(c echo.Context, db bob.DB) (*models.Attachment, error) {
+	id, err := strconv.ParseInt(c.Param("attachment_id"), 10, 64)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound)
+	}
+	attachment, err := models.Attachments.Query(
+		models.SelectWhere.Attachments.ID.EQ(id),
+		models.SelectWhere.Attachments.TodoID.EQ(c.Get("todo").(*models.Todo).ID),
+	).One(c.Request().Context(), db)
+	if err != nil {
+		return nil, notFoundIfNoRows(err)
+	}
+	return attachment, nil
+}
+
+// serveBlob は保存先の key の中身をレスポンスとして返す
+// 中身をContent-Typeどおりにだけ解釈させ、HTMLやスクリプトとして実行されないようにする
+func serveBlob(c echo.Context, store storage.Storage, key, contentType, disposition string) error {
+	r, err := store.Open(c.Request().Context(), key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	h := c.Response().Header()
+	h.Set("Content-Disposition", disposition)
+	h.Set("X-Content-Type-Options", "nosniff")
+	// PDFはブラウザのビューアで開けるよう除外する（画像やテキストはビューアのスタイル以外を読み込ませない）
+	if contentType != "application/pdf" {
+		h.Set("Content-Security-Policy", "default-src 'none'; img-src 'self'; style-src 'unsafe-inline'")
+	}
+	h.Set("Cache-Control", "private, max-age=86400")
+	return c.Stream(http.StatusOK, contentType, r)
+}
+
+// deleteUnusedBlobs は keys のうち、どの添付ファイルからも参照されなくなった中身とサムネイルを保存先から削除する
+func deleteUnusedBlobs(ctx context.Context, db bob.DB, store storage.Storage, keys ...string) error {
+	blobMu.Lock()
+	defer blobMu.Unlock()
+	for _, key := range keys {
+		used, err := models.Attachments.Query(models.SelectWhere.Attachments.Sha256.EQ(key)).Exists(ctx, db)
+		if err != nil {
+			return err
+		}
+		if used {
+			continue
+		}
+		if err := store.Delete(ctx, key); err != nil {
+			return err
+		}
+		if err := store.Delete(ctx, thumbnailKey(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// todoAttachmentKeys はTodoとそのサブタスクに添付されたファイルのキーを返す
+// Todoを削除すると添付ファイルの行は外部キーで消えるので、削除前に取得して deleteUnusedBlobs に渡す
+func todoAttachmentKeys(ctx context.Context, exec bob.Executor, todoID int64) ([]string, error) {
+	attachments, err := models.Attachments.Query(
+		sm.Where(sqlite.Or(
+			models.Attachments.Columns.TodoID.EQ(sqlite.Arg(todoID)),
+			models.Attachments.Columns.TodoID.OP("IN", sqlite.Select(
+				sm.Columns(models.Todos.Columns.ID),
+				sm.From(models.Todos.Name()),
+				models.SelectWhere.Todos.ParentID.EQ(todoID),
+			)),
+		)),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		keys = append(keys, attachment.Sha256)
+	}
+	return keys, nil
+}
+
+// listAttachmentKeys はリストのTodo（ゴミ箱にあるものとサブタスクを含む）に添付されたファイルのキーを返す
+// リストを削除するとTodoと添付ファイルの行は外部キーで消えるので、削除前に取得して deleteUnusedBlobs に渡す
+func listAttachmentKeys(ctx context.Context, exec bob.Executor, listID int64) ([]string, error) {
+	attachments, err := models.Attachments.Query(
+		sm.Where(models.Attachments.Columns.TodoID.OP("IN", sqlite.Select(
+			sm.Columns(models.Todos.Columns.ID),
+			sm.From(models.Todos.Name()),
+			models.SelectWhere.Todos.ListID.EQ(listID),
+		))),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		keys = append(keys, attachment.Sha256)
+	}
+	return keys, nil
+}
+
+func thumbnailKey(key string) string {
+	return key + "-thumb"
+}
+
+// attachmentFilename は表示とダウンロードに使うファイル名。パスや制御文字を取り除き、長すぎる名前は切り詰める
+func attachmentFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	for utf8.RuneCountInString(name) > 200 {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+// makeThumbnail は画像を長辺 thumbnailSize 以下に縮小したPNGを返す
+// 読めない形式（WebPなど）や小さい画像、大きすぎる画像ではnilを返す
+func makeThumbnail(data []byte) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil
+	}
+	if config.Width*config.Height > maxThumbnailPixels {
+		return nil, nil
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	b := src.Bounds()
+	scale := float64(thumbnailSize) / float64(max(b.Dx(), b.Dy()))
+	if scale >= 1 {
+		scale = 1
+	}
+	w, h := max(int(float64(b.Dx())*scale), 1), max(int(float64(b.Dy())*scale), 1)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, resizeImage(src, w, h)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resizeImage は src を w×h に縮小する。縮小先の1ピクセルに対応する範囲の平均を取る
+func resizeImage(src image.Image, w, h int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	b := src.Bounds()
+	for y := range h {
+		y0 := b.Min.Y + y*b.Dy()/h
+		y1 := max(b.Min.Y+(y+1)*b.Dy()/h, y0+1)
+		for x := range w {
+			x0 := b.Min.X + x*b.Dx()/w
+			x1 := max(b.Min.X+(x+1)*b.Dx()/w, x0+1)
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(pr), g+uint64(pg), bl+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			// RGBA() はアルファ乗算済みなので、アルファで割り戻してNRGBAにする
+			i := dst.PixOffset(x, y)
+			if a > 0 {
+				dst.Pix[i+0] = uint8(r * 0xff / a)
+				dst.Pix[i+1] = uint8(g * 0xff / a)
+				dst.Pix[i+2] = uint8(bl * 0xff / a)
+			}
+			dst.Pix[i+3] = uint8((a / n) >> 8)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/labstack/echo/v4"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+// upload はファイルを1つ含むマルチパートのフォームを送る
+func (tc *testClient) upload(target, filename string, data []byte) *httptest.ResponseRecorder {
+	tc.t.Helper()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if err := w.WriteField("csrf_token", testCSRFToken); err != nil {
+		tc.t.Fatal(err)
+	}
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		tc.t.Fatal(err)
+	}
+	part.Write(data)
+	if err := w.Close(); err != nil {
+		tc.t.Fatal(err)
+	}
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, target, &body)
+	req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+	return tc.send(req)
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestUploadAndDownloadAttachment(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/attachments"
+
+	// 拡張子に関係なく中身からMIMEタイプを判定する
+	screenshot := testPNG(t, 640, 480)
+	rec := tc.upload(path, "../../スクリーンショット.txt", screenshot)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("upload: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	attachment, err := models.Attachments.Query(models.SelectWhere.Attachments.TodoID.EQ(todo.ID)).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attachment.ContentType != "image/png" || attachment.Filename != "スクリーンショット.txt" || !attachment.HasThumbnail {
+		t.Errorf("attachment = %+v", attachment)
+	}
+	if strings.Contains(rec.Body.String(), attachment.Sha256) {
+		t.Error("detail exposes the storage key")
+	}
+
+	downloadPath := path + "/" + strconv.FormatInt(attachment.ID, 10)
+	rec = tc.do(http.MethodGet, downloadPath, nil)
+	if rec.Code != http.StatusOK || !bytes.Equal(rec.Body.Bytes(), screenshot) {
+		t.Fatalf("download: status = %d, %d bytes", rec.Code, rec.Body.Len())
+	}
+	if got := rec.Header().Get("Content-Type"); got != "image/png" {
+		t.Errorf("Content-Type = %q, want image/png", got)
+	}
+	if got := rec.Header().Get("X-Content-Type-Options"); got != "nosniff" {
+		t.Errorf("X-Content-Type-Options = %q, want nosniff", got)
+	}
+
+	rec = tc.do(http.MethodGet, downloadPath+"/thumbnail", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("thumbnail: status = %d", rec.Code)
+	}
+	thumbnail, err := png.DecodeConfig(bytes.NewReader(rec.Body.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thumbnail.Width != thumbnailSize || thumbnail.Height != 240 {
+		t.Errorf("thumbnail = %dx%d, want %dx240", thumbnail.Width, thumbnail.Height, thumbnailSize)
+	}
+
+	// テキストは表示せずダウンロードさせる
+	if rec := tc.upload(path, "memo.txt", []byte("買い物リスト")); rec.Code != http.StatusOK {
+		t.Fatalf("upload text: status = %d", rec.Code)
+	}
+	text, err := models.Attachments.Query(models.SelectWhere.Attachments.Filename.EQ("memo.txt")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec = tc.do(http.MethodGet, path+"/"+strconv.FormatInt(text.ID, 10), nil)
+	if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, "attachment") {
+		t.Errorf("Content-Disposition = %q, want attachment", got)
+	}
+	if rec := tc.do(http.MethodGet, path+"/"+strconv.FormatInt(text.ID, 10)+"/thumbnail", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("thumbnail of text: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestUploadAttachmentRejects(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/attachments"
+
+	tests := []struct {
+		name     string
+		filename string
+		data     []byte
+		want     int
+	}{
+		{"html", "image.png", []byte("<!DOCTYPE html><script>alert(1)</script>"), http.StatusUnsupportedMediaType},
+		{"executable", "a.pdf", []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff"), http.StatusUnsupportedMediaType},
+		{"empty", "empty.txt", nil, http.StatusBadRequest},
+		{"too large", "large.txt", bytes.Repeat([]byte("a"), maxAttachmentSize+1), http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := tc.upload(path, tt.filename, tt.data); rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+	if n, err := models.Attachments.Query().Count(ctx, db); err != nil || n != 0 {
+		t.Errorf("attachments = %d, %v; want 0", n, err)
+	}
+}
+
+func TestAttachmentAccessAndCleanup(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	viewer := createTestUser(t, db, "viewer@example.com")
+	stranger := createTestUser(t, db, "stranger@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(viewer),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+	first := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(owner), factory.TodoMods.ListID(null.From(list.ID))).CreateOrFail(ctx, t, db)
+	second := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(owner), factory.TodoMods.ListID(null.From(list.ID))).CreateOrFail(ctx, t, db)
+
+	ownerClient := login(t, e, owner)
+	content := []byte("shared content")
+	for _, todo := range []*models.Todo{first, second} {
+		if rec := ownerClient.upload("/todos/"+strconv.FormatInt(todo.ID, 10)+"/attachments", "shared.txt", content); rec.Code != http.StatusOK {
+			t.Fatalf("upload: status = %d", rec.Code)
+		}
+	}
+	attachments, err := models.Attachments.Query().All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attachments) != 2 || attachments[0].Sha256 != attachments[1].Sha256 {
+		t.Fatalf("attachments = %v, want two with the same content", attachments)
+	}
+	firstPath := "/todos/" + strconv.FormatInt(first.ID, 10) + "/attachments/" + strconv.FormatInt(attachments[0].ID, 10)
+	secondPath := "/todos/" + strconv.FormatInt(second.ID, 10) + "/attachments/" + strconv.FormatInt(attachments[1].ID, 10)
+
+	// 閲覧者はダウンロードできるが削除できない。他人や別のTodo経由では見つからない
+	viewerClient := login(t, e, viewer)
+	if rec := viewerClient.do(http.MethodGet, firstPath, nil); rec.Code != http.StatusOK {
+		t.Errorf("viewer download: status = %d", rec.Code)
+	}
+	if rec := viewerClient.do(http.MethodPost, firstPath+"/delete", url.Values{}); rec.Code != http.StatusForbidden {
+		t.Errorf("viewer delete: status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	if rec := login(t, e, stranger).do(http.MethodGet, firstPath, nil); rec.Code != http.StatusNotFound {
+		t.Errorf("stranger download: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	crossPath := "/todos/" + strconv.FormatInt(second.ID, 10) + "/attachments/" + strconv.FormatInt(attachments[0].ID, 10)
+	if rec := ownerClient.do(http.MethodGet, crossPath, nil); rec.Code != http.StatusNotFound {
+		t.Errorf("attachment via another todo: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// 同じ中身を参照する添付ファイルが残っているうちは中身を消さない
+	if rec := ownerClient.do(http.MethodPost, firstPath+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("delete: status = %d", rec.Code)
+	}
+	if rec := ownerClient.do(http.MethodGet, secondPath, nil); rec.Code != http.StatusOK || !bytes.Equal(rec.Body.Bytes(), content) {
+		t.Fatalf("download after deleting the other: status = %d", rec.Code)
+	}
+
+	// ゴミ箱に入れただけでは添付ファイルを残し、完全に削除すると中身も消える
+	if rec := ownerClient.do(http.MethodPost, "/todos/"+strconv.FormatInt(second.ID, 10)+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("delete todo: status = %d", rec.Code)
+	}
+	if n, err := models.Attachments.Query().Count(ctx, db); err != nil || n != 1 {
+		t.Errorf("attachments after soft delete = %d, %v; want 1", n, err)
+	}
+	if rec := ownerClient.do(http.MethodPost, "/trash/"+strconv.FormatInt(second.ID, 10)+"/purge", url.Values{}); rec.Code != http.StatusFound {
+		t.Fatalf("purge todo: status = %d", rec.Code)
+	}
+	if n, err := models.Attachments.Query().Count(ctx, db); err != nil || n != 0 {
+		t.Errorf("attachments = %d, %v; want 0", n, err)
+	}
+}
+
+func TestAttachmentFilename(t *testing.T) {
+	tests := map[string]string{
+		"report.pdf":            "report.pdf",
+		"../../etc/passwd":      "passwd",
+		`C:\Users\me\image.png`: "image.png",
+		"a\r\nb.txt":            "ab.txt",
+		"":                      "file",
+		"/":                     "file",
+	}
+	for in, want := range tests {
+		if got := attachmentFilename(in); got != want {
+			t.Errorf("attachmentFilename(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestListAttachmentKeys(t *testing.T) {
+	_, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	other := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	newTodo := func(listID int64, mods ...factory.TodoMod) *models.Todo {
+		mods = append([]factory.TodoMod{factory.TodoMods.WithExistingUser(alice), factory.TodoMods.ListID(null.From(listID))}, mods...)
+		return f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db)
+	}
+	parent := newTodo(list.ID)
+	subtask := newTodo(list.ID, factory.TodoMods.ParentID(null.From(parent.ID)))
+	trashed := newTodo(list.ID, factory.TodoMods.DeletedAt(null.From(time.Now().UTC())))
+	elsewhere := newTodo(other.ID)
+	for key, todo := range map[string]*models.Todo{"parent": parent, "subtask": subtask, "trashed": trashed, "elsewhere": elsewhere} {
+		f.NewAttachmentWithContext(ctx, factory.AttachmentMods.WithExistingTodo(todo), factory.AttachmentMods.Sha256(key)).CreateOrFail(ctx, t, db)
+	}
+
+	// ゴミ箱のTodoやサブタスクの添付ファイルも、リストと一緒に消えるので含める
+	keys, err := listAttachmentKeys(ctx, db, list.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(keys)
+	if want := []string{"parent", "subtask", "trashed"}; !slices.Equal(keys, want) {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+}
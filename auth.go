@@ -0,0 +1,147 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/alexedwards/scs/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"golang.org/x/crypto/bcrypt"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zhttp"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// バリデーション用の構造体とスキーマ
+type LoginInput struct {
+	Email    string `zog:"email"`
+	Password string `zog:"password"`
+}
+
+var loginSchema = z.Struct(z.Shape{
+	"Email":    z.String().Required(z.Message("メールアドレスは必須です")).Email(z.Message("有効なメールアドレスを入力してください")),
+	"Password": z.String().Required(z.Message("パスワードは必須です")),
+})
+
+type RegisterInput struct {
+	Email           string `zog:"email"`
+	Password        string `zog:"password"`
+	ConfirmPassword string `zog:"confirm_password"`
+}
+
+var registerSchema = z.Struct(z.Shape{
+	"Email":           z.String().Required(z.Message("メールアドレスは必須です")).Email(z.Message("有効なメールアドレスを入力してください")),
+	"Password":        z.String().Required(z.Message("パスワードは必須です")).Min(8, z.Message("パスワードは8文字以上で入力してください")),
+	"ConfirmPassword": z.String().Required(z.Message("パスワード確認は必須です")),
+})
+
+// registerAuthRoutes はログイン・新規登録・ログアウトのルートを登録する
+func registerAuthRoutes(e *echo.Echo, db bob.DB, sessionManager *scs.SessionManager) {
+	// ログインページ表示
+	e.GET("/auth/login", func(c echo.Context) error {
+		// 既にログイン済みならリダイレクト
+		if sessionManager.GetInt64(c.Request().Context(), "user_id") != 0 {
+			return c.Redirect(http.StatusFound, "/todos")
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.LoginPage(csrfToken, nil))
+	})
+
+	// ログイン処理
+	e.POST("/auth/login", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		csrfToken := c.Get("csrf").(string)
+
+		var input LoginInput
+		if issues := loginSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return render(c, http.StatusBadRequest, views.LoginPage(csrfToken, issuesToMap(issues)))
+		}
+
+		// ユーザーを検索
+		user, err := models.Users.Query(
+			models.SelectWhere.Users.Email.EQ(input.Email),
+		).One(ctx, db)
+		if err != nil {
+			return render(c, http.StatusBadRequest, views.LoginPage(csrfToken, map[string][]string{"_": {"メールアドレスまたはパスワードが正しくありません"}}))
+		}
+
+		// パスワード検証
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.Password)); err != nil {
+			return render(c, http.StatusBadRequest, views.LoginPage(csrfToken, map[string][]string{"_": {"メールアドレスまたはパスワードが正しくありません"}}))
+		}
+
+		// セッションにユーザーIDとタイムゾーンを保存
+		sessionManager.Put(ctx, "user_id", user.ID)
+		sessionManager.Put(ctx, "timezone", user.Timezone)
+
+		return c.Redirect(http.StatusFound, "/todos")
+	})
+
+	// 新規登録ページ表示
+	e.GET("/auth/register", func(c echo.Context) error {
+		// 既にログイン済みならリダイレクト
+		if sessionManager.GetInt64(c.Request().Context(), "user_id") != 0 {
+			return c.Redirect(http.StatusFound, "/todos")
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.RegisterPage(csrfToken, nil))
+	})
+
+	// 新規登録処理
+	e.POST("/auth/register", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		csrfToken := c.Get("csrf").(string)
+
+		var input RegisterInput
+		if issues := registerSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return render(c, http.StatusBadRequest, views.RegisterPage(csrfToken, issuesToMap(issues)))
+		}
+
+		// パスワード確認チェック
+		if input.Password != input.ConfirmPassword {
+			return render(c, http.StatusBadRequest, views.RegisterPage(csrfToken, map[string][]string{"confirm_password": {"パスワードが一致しません"}}))
+		}
+
+		// 既存ユーザーチェック
+		_, err := models.Users.Query(
+			models.SelectWhere.Users.Email.EQ(input.Email),
+		).One(ctx, db)
+		if err == nil {
+			return render(c, http.StatusBadRequest, views.RegisterPage(csrfToken, map[string][]string{"email": {"このメールアドレスは既に登録されています"}}))
+		}
+
+		// パスワードハッシュ化
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+
+		// ユーザー作成
+		now := time.Now().UTC()
+		user, err := models.Users.Insert(&models.UserSetter{
+			Email:     omit.From(input.Email),
+			Password:  omit.From(string(hashedPassword)),
+			CreatedAt: omit.From(now),
+			UpdatedAt: omit.From(now),
+		}).One(ctx, db)
+		if err != nil {
+			return err
+		}
+
+		// セッションにユーザーIDとタイムゾーンを保存（自動ログイン）
+		sessionManager.Put(ctx, "user_id", user.ID)
+		sessionManager.Put(ctx, "timezone", user.Timezone)
+
+		return c.Redirect(http.StatusFound, "/todos")
+	})
+
+	// ログアウト
+	e.POST("/auth/logout", func(c echo.Context) error {
+		sessionManager.Destroy(c.Request().Context())
+		return c.Redirect(http.StatusFound, "/auth/login")
+	})
+}
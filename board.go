@@ -0,0 +1,446 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zhttp"
+	"github.com/kimihito-sandbox/gostack-test/dberrors"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+type StatusInput struct {
+	Name string `zog:"name"`
+}
+
+var statusSchema = z.Struct(z.Shape{
+	"Name": z.String().Trim().Required(z.Message("状態の名前は必須です")).Max(30, z.Message("状態の名前は30文字以内で入力してください")),
+})
+
+// defaultListStatuses は新しいリストに作る状態（かんばんボードの列）
+var defaultListStatuses = []struct {
+	Name string
+	Done bool
+}{
+	{"未着手", false},
+	{"進行中", false},
+	{"レビュー", false},
+	{"完了", true},
+}
+
+// createDefaultStatuses はリストに既定の状態を作る
+func createDefaultStatuses(ctx context.Context, exec bob.Executor, listID int64) error {
+	setters := make([]*models.ListStatusSetter, len(defaultListStatuses))
+	for i, status := range defaultListStatuses {
+		setters[i] = &models.ListStatusSetter{
+			ListID:   omit.From(listID),
+			Name:     omit.From(status.Name),
+			Done:     omit.From(status.Done),
+			Position: omit.From(int64(i)),
+		}
+	}
+	_, err := models.ListStatuses.Insert(bob.ToMods(setters...)).Exec(ctx, exec)
+	return err
+}
+
+// listStatuses はリストの状態を列の並び順で返す
+func listStatuses(ctx context.Context, exec bob.Executor, listID int64) (models.ListStatusSlice, error) {
+	return models.ListStatuses.Query(
+		models.SelectWhere.ListStatuses.ListID.EQ(listID),
+		sm.OrderBy(models.ListStatuses.Columns.Position),
+		sm.OrderBy(models.ListStatuses.Columns.ID),
+	).All(ctx, exec)
+}
+
+// statusOf はTodoの状態。status_id が無ければ完了状態から決める（未完了なら最初の未完了の状態、完了なら最初の完了の状態）
+// 該当する状態が無ければ最初の状態にする。状態が1つも無ければnilを返す
+func statusOf(statuses models.ListStatusSlice, todo *models.Todo) *models.ListStatus {
+	if statusID, ok := todo.StatusID.Get(); ok {
+		for _, status := range statuses {
+			if status.ID == statusID {
+				return status
+			}
+		}
+	}
+	for _, status := range statuses {
+		if status.Done == todo.Completed {
+			return status
+		}
+	}
+	if len(statuses) == 0 {
+		return nil
+	}
+	return statuses[0]
+}
+
+// checkStatusKinds は未完了の状態と完了の状態がそれぞれ1つ以上あるかどうかを確かめる
+func checkStatusKinds(statuses models.ListStatusSlice) error {
+	var open, done bool
+	for _, status := range statuses {
+		open = open || !status.Done
+		done = done || status.Done
+	}
+	if !open || !done {
+		return echo.NewHTTPError(http.StatusBadRequest, "未完了の状態と完了の状態がそれぞれ1つ以上必要です")
+	}
+	return nil
+}
+
+// registerBoardRoutes はリストのかんばんボードと状態の設定のルートを登録する
+func registerBoardRoutes(g *echo.Group, db bob.DB) {
+	// かんばんボード。状態ごとの列に、サブタスクを除くTodoを手動の並び順で並べる
+	g.GET("/:id/board", func(c echo.Context) error {
+		return renderBoard(c, db, c.Get("list").(*models.List))
+	}, requireListRole(db, RoleViewer))
+
+	// 状態の設定ページ
+	g.GET("/:id/statuses", func(c echo.Context) error {
+		list := c.Get("list").(*models.List)
+		statuses, err := listStatuses(c.Request().Context(), db, list.ID)
+		if err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, views.StatusIndex(list, statuses, c.Get("csrf").(string)))
+	}, requireListRole(db, RoleAdmin))
+
+	// 状態の追加（最後の列に足す）
+	g.POST("/:id/statuses", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+
+		var input StatusInput
+		if issues := statusSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, issues[0].Message)
+		}
+		statuses, err := listStatuses(ctx, db, list.ID)
+		if err != nil {
+			return err
+		}
+		var position int64
+		if len(statuses) > 0 {
+			position = statuses[len(statuses)-1].Position + 1
+		}
+		_, err = models.ListStatuses.Insert(&models.ListStatusSetter{
+			ListID:   omit.From(list.ID),
+			Name:     omit.From(input.Name),
+			Done:     omit.From(c.FormValue("done") != ""),
+			Position: omit.From(position),
+		}).One(ctx, db)
+		if err != nil {
+			return statusNameConflict(err)
+		}
+		return c.Redirect(http.StatusFound, statusesPath(list))
+	}, requireListRole(db, RoleAdmin))
+
+	// 状態の名前と完了扱いの変更
+	// 完了扱いを変えたら、その状態にあるTodoの完了状態も合わせる
+	g.POST("/:id/statuses/:status_id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		statuses, status, err := findListStatus(c, db, list)
+		if err != nil {
+			return err
+		}
+
+		var input StatusInput
+		if issues := statusSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, issues[0].Message)
+		}
+		done := c.FormValue("done") != ""
+		if done != status.Done {
+			status.Done = done
+			if err := checkStatusKinds(statuses); err != nil {
+				return err
+			}
+		}
+		// 完了扱いにすると状態にある未完了のTodoも完了になるので、ブロック中のTodoがあれば変更しない
+		if done {
+			open, err := models.Todos.Query(
+				models.SelectWhere.Todos.StatusID.EQ(status.ID),
+				models.SelectWhere.Todos.Completed.EQ(false),
+			).All(ctx, db)
+			if err != nil {
+				return err
+			}
+			if err := checkTodosNotBlocked(ctx, db, open); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().UTC()
+		loc := c.Get("location").(*time.Location)
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			if err := status.Update(ctx, exec, &models.ListStatusSetter{
+				Name: omit.From(input.Name),
+				Done: omit.From(done),
+			}); err != nil {
+				return err
+			}
+			todos, err := models.Todos.Query(
+				models.SelectWhere.Todos.StatusID.EQ(status.ID),
+				models.SelectWhere.Todos.Completed.EQ(!done),
+			).All(ctx, exec)
+			if err != nil {
+				return err
+			}
+			return setStatusTodosCompleted(ctx, exec, todos, done, now, loc)
+		})
+		if err != nil {
+			return statusNameConflict(err)
+		}
+		return c.Redirect(http.StatusFound, statusesPath(list))
+	}, requireListRole(db, RoleAdmin))
+
+	// 状態の並び替え（dir=up で左へ、それ以外で右へ1つ動かす）
+	g.POST("/:id/statuses/:status_id/move", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		statuses, status, err := findListStatus(c, db, list)
+		if err != nil {
+			return err
+		}
+		index := 0
+		for i, s := range statuses {
+			if s.ID == status.ID {
+				index = i
+			}
+		}
+		other := index + 1
+		if c.FormValue("dir") == "up" {
+			other = index - 1
+		}
+		if other < 0 || other >= len(statuses) {
+			return c.Redirect(http.StatusFound, statusesPath(list))
+		}
+		statuses[index], statuses[other] = statuses[other], statuses[index]
+
+		// 位置が重なっていることもあるので、並び全体を振り直す
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			for i, s := range statuses {
+				if s.Position == int64(i) {
+					continue
+				}
+				if err := s.Update(ctx, exec, &models.ListStatusSetter{Position: omit.From(int64(i))}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, statusesPath(list))
+	}, requireListRole(db, RoleAdmin))
+
+	// 状態の削除。その状態にあったTodoは完了状態から決まる状態に移る（外部キーの SET NULL）
+	g.POST("/:id/statuses/:status_id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		statuses, status, err := findListStatus(c, db, list)
+		if err != nil {
+			return err
+		}
+		var rest models.ListStatusSlice
+		for _, s := range statuses {
+			if s.ID != status.ID {
+				rest = append(rest, s)
+			}
+		}
+		if err := checkStatusKinds(rest); err != nil {
+			return err
+		}
+		if err := status.Delete(ctx, db); err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, statusesPath(list))
+	}, requireListRole(db, RoleAdmin))
+}
+
+// registerTodoStatusRoutes はTodoの状態を変えるルートを登録する
+func registerTodoStatusRoutes(g *echo.Group, db bob.DB) {
+	// 状態の変更。かんばんボードのドラッグ＆ドロップとカードの選択肢から呼ばれ、ボードを描き直す
+	// 完了扱いの状態に移すと完了に（繰り返しのTodoなら次のTodoを作る）、それ以外へ移すと未完了にする
+	// 移動先の列の前後のカード（before_id・after_id）があれば、その間に並べる
+	g.POST("/:id/status", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		listID, ok := todo.ListID.Get()
+		if !ok || todo.ParentID.IsValue() {
+			return echo.NewHTTPError(http.StatusBadRequest, "状態はリストのTodoにだけ設定できます")
+		}
+		statusID, err := strconv.ParseInt(c.FormValue("status_id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "状態が正しくありません")
+		}
+		status, err := models.ListStatuses.Query(
+			models.SelectWhere.ListStatuses.ID.EQ(statusID),
+			models.SelectWhere.ListStatuses.ListID.EQ(listID),
+		).One(ctx, db)
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusBadRequest, "状態が正しくありません")
+		}
+		if err != nil {
+			return err
+		}
+		beforeID, err := optionalID(c.FormValue("before_id"))
+		if err != nil {
+			return err
+		}
+		afterID, err := optionalID(c.FormValue("after_id"))
+		if err != nil {
+			return err
+		}
+
+		// 完了扱いの状態へ移すときも、未完了のTodoにブロックされていれば force を指定したときだけ完了にする
+		if status.Done && !todo.Completed && c.FormValue("force") == "" {
+			if err := checkNotBlocked(ctx, db, todo); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().UTC()
+		loc := c.Get("location").(*time.Location)
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			setter := &models.TodoSetter{StatusID: omitnull.From(status.ID)}
+			// 繰り返しのTodoは完了にするのと一緒に状態を変える。次の発生日のTodoは描き直したボードに出る
+			if status.Done && !todo.Completed && todo.Recurrence.IsValue() {
+				if _, err := completeRecurringTodo(ctx, exec, todo, now, loc, setter); err != nil {
+					return err
+				}
+			} else {
+				setter.Completed = omit.From(status.Done)
+				setter.UpdatedAt = omit.From(now)
+				if err := todo.Update(ctx, exec, setter); err != nil {
+					return err
+				}
+			}
+			if beforeID != 0 || afterID != 0 {
+				return reorderTodo(ctx, exec, todo, beforeID, afterID)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		list, err := models.FindList(ctx, db, listID)
+		if err != nil {
+			return err
+		}
+		return renderBoard(c, db, list)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// setStatusTodosCompleted は状態を完了扱いにした・やめたときに、状態にあるTodoの完了状態を合わせる
+// 1件ずつ完了にしたときと同じく、繰り返しのTodoは次の発生日のTodoを作り、サブタスクなら親の完了状態も更新する
+func setStatusTodosCompleted(ctx context.Context, exec bob.Executor, todos models.TodoSlice, done bool, now time.Time, loc *time.Location) error {
+	var plain []int64
+	for _, todo := range todos {
+		if done && todo.Recurrence.IsValue() {
+			if _, err := completeRecurringTodo(ctx, exec, todo, now, loc, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		plain = append(plain, todo.ID)
+	}
+	if err := setTodosCompleted(ctx, exec, plain, done, now); err != nil {
+		return err
+	}
+
+	synced := map[int64]bool{}
+	for _, todo := range todos {
+		parentID, ok := todo.ParentID.Get()
+		if !ok || synced[parentID] {
+			continue
+		}
+		synced[parentID] = true
+		parent, err := subtaskParent(ctx, exec, todo)
+		if err != nil {
+			return err
+		}
+		if _, err := syncParentCompletion(ctx, exec, parent, now, loc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderBoard はかんばんボードを返す。HTMXでボードだけを差し替えるリクエスト（HX-Target が board）にはボード部分だけを返す
+func renderBoard(c echo.Context, db bob.DB, list *models.List) error {
+	ctx := c.Request().Context()
+	statuses, err := listStatuses(ctx, db, list.ID)
+	if err != nil {
+		return err
+	}
+	todos, err := models.Todos.Query(
+		models.SelectWhere.Todos.ListID.EQ(list.ID),
+		models.SelectWhere.Todos.ParentID.IsNull(),
+		positionOrder(),
+		models.SelectThenLoad.Todo.Tags(sm.OrderBy(models.Tags.Columns.Name)),
+	).All(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	columns := make([]views.BoardColumn, len(statuses))
+	index := make(map[int64]int, len(statuses))
+	for i, status := range statuses {
+		columns[i].Status = status
+		index[status.ID] = i
+	}
+	for _, todo := range todos {
+		if status := statusOf(statuses, todo); status != nil {
+			i := index[status.ID]
+			columns[i].Todos = append(columns[i].Todos, todo)
+		}
+	}
+
+	csrfToken := c.Get("csrf").(string)
+	if c.Request().Header.Get("HX-Target") == "board" {
+		return render(c, http.StatusOK, views.Board(list, columns, csrfToken))
+	}
+	return render(c, http.StatusOK, views.BoardPage(list, columns, csrfToken))
+}
+
+// findListStatus はパスパラメータ :status_id のリストの状態を、リストのすべての状態とあわせて返す
+func findListStatus(c echo.Context, db bob.DB, list *models.List) (models.ListStatusSlice, *models.ListStatus, error) {
+	id, err := strconv.ParseInt(c.Param("status_id"), 10, 64)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound)
+	}
+	statuses, err := listStatuses(c.Request().Context(), db, list.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, status := range statuses {
+		if status.ID == id {
+			return statuses, status, nil
+		}
+	}
+	return nil, nil, echo.NewHTTPError(http.StatusNotFound)
+}
+
+// statusNameConflict は同じリストに同じ名前の状態があるエラーを400にする
+func statusNameConflict(err error) error {
+	if dberrors.ListStatusErrors.ErrUniqueSqliteAutoindexListStatuses1.Is(err) {
+		return echo.NewHTTPError(http.StatusBadRequest, "同じ名前の状態がすでにあります")
+	}
+	return err
+}
+
+func statusesPath(list *models.List) string {
+	return "/lists/" + strconv.FormatInt(list.ID, 10) + "/statuses"
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestBoardMovesTodoBetweenStatuses(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	tc := login(t, e, alice)
+
+	// 作ったリストには既定の状態ができる
+	if rec := tc.do(http.MethodPost, "/lists", url.Values{"name": {"project"}}); rec.Code != http.StatusOK {
+		t.Fatalf("create list: status = %d", rec.Code)
+	}
+	list, err := models.Lists.Query(models.SelectWhere.Lists.Name.EQ("project")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := listStatuses(ctx, db, list.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != len(defaultListStatuses) || !statuses[len(statuses)-1].Done {
+		t.Fatalf("statuses = %v", statuses)
+	}
+	doing, done := statuses[1], statuses[3]
+
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Title("write spec"),
+	).CreateOrFail(ctx, t, db)
+	boardPath := "/lists/" + strconv.FormatInt(list.ID, 10) + "/board"
+	statusPath := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/status"
+	reload := func() *models.Todo {
+		t.Helper()
+		todo, err := models.FindTodo(ctx, db, todo.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return todo
+	}
+
+	body := tc.do(http.MethodGet, boardPath, nil).Body.String()
+	assertOrder(t, body, "未着手", "write spec", "進行中", "レビュー", "完了")
+
+	rec := tc.doWithHeader(http.MethodPost, statusPath, url.Values{"status_id": {strconv.FormatInt(doing.ID, 10)}}, http.Header{"Hx-Target": {"board"}})
+	if rec.Code != http.StatusOK || strings.Contains(rec.Body.String(), "<html") {
+		t.Fatalf("move to doing: status = %d", rec.Code)
+	}
+	assertOrder(t, rec.Body.String(), "未着手", "進行中", "write spec", "レビュー")
+	if got := reload(); got.StatusID != null.From(doing.ID) || got.Completed {
+		t.Errorf("after doing: status_id = %v, completed = %v", got.StatusID, got.Completed)
+	}
+
+	// 完了扱いの状態へ移すと完了になり、完了の切り替えで戻すと状態は外れて最初の列に戻る
+	tc.do(http.MethodPost, statusPath, url.Values{"status_id": {strconv.FormatInt(done.ID, 10)}})
+	if got := reload(); !got.Completed {
+		t.Error("moving to a done status did not complete the todo")
+	}
+	tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/toggle", url.Values{})
+	if got := reload(); got.Completed || got.StatusID.IsValue() {
+		t.Errorf("after toggle: status_id = %v, completed = %v", got.StatusID, got.Completed)
+	}
+
+	// ほかのリストの状態は選べず、ほかのリストへ移すと状態は外れる
+	other := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	if err := createDefaultStatuses(ctx, db, other.ID); err != nil {
+		t.Fatal(err)
+	}
+	otherStatuses, err := listStatuses(ctx, db, other.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := tc.do(http.MethodPost, statusPath, url.Values{"status_id": {strconv.FormatInt(otherStatuses[1].ID, 10)}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("status of another list: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	tc.do(http.MethodPost, statusPath, url.Values{"status_id": {strconv.FormatInt(doing.ID, 10)}})
+	if err := reload().Update(ctx, db, &models.TodoSetter{ListID: omitnull.From(other.ID)}); err != nil {
+		t.Fatal(err)
+	}
+	if got := reload(); got.StatusID.IsValue() {
+		t.Errorf("status_id = %v after moving to another list", got.StatusID)
+	}
+
+	// 閲覧者は状態を変えられない
+	viewer := createTestUser(t, db, "viewer@example.com")
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(other),
+		factory.ListMemberMods.WithExistingUser(viewer),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+	rec = login(t, e, viewer).do(http.MethodPost, statusPath, url.Values{"status_id": {strconv.FormatInt(otherStatuses[1].ID, 10)}})
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("viewer: status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestListStatusSettings(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	if err := createDefaultStatuses(ctx, db, list.ID); err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := listStatuses(ctx, db, list.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	review, done := statuses[2], statuses[3]
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.StatusID(null.From(review.ID)),
+		factory.TodoMods.Completed(false),
+	).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := func(status *models.ListStatus, action string) string {
+		return "/lists/" + strconv.FormatInt(list.ID, 10) + "/statuses/" + strconv.FormatInt(status.ID, 10) + action
+	}
+
+	// 状態にブロック中のTodoがあるうちは完了扱いにできない
+	blocker := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Completed(false),
+	).CreateOrFail(ctx, t, db)
+	f.NewTodoDependencyWithContext(ctx,
+		factory.TodoDependencyMods.WithExistingTodo(todo),
+		factory.TodoDependencyMods.WithExistingBlocker(blocker),
+	).CreateOrFail(ctx, t, db)
+	if rec := tc.do(http.MethodPost, path(review, ""), url.Values{"name": {"検収"}, "done": {"1"}}); rec.Code != http.StatusConflict {
+		t.Errorf("update with a blocked todo: status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	if got, _ := models.FindListStatus(ctx, db, review.ID); got.Done {
+		t.Error("status became done while it has a blocked todo")
+	}
+	if err := blocker.Update(ctx, db, &models.TodoSetter{Completed: omit.From(true)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 完了扱いにすると、その状態のTodoも完了になる
+	if rec := tc.do(http.MethodPost, path(review, ""), url.Values{"name": {"検収"}, "done": {"1"}}); rec.Code != http.StatusFound {
+		t.Fatalf("update: status = %d", rec.Code)
+	}
+	if got, _ := models.FindTodo(ctx, db, todo.ID); !got.Completed || got.StatusID != null.From(review.ID) {
+		t.Errorf("todo = completed %v, status_id %v", got.Completed, got.StatusID)
+	}
+
+	tc.do(http.MethodPost, path(done, "/move"), url.Values{"dir": {"up"}})
+	statuses, err = listStatuses(ctx, db, list.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses[2].ID != done.ID || statuses[3].Name != "検収" {
+		t.Errorf("order = %v", statuses)
+	}
+
+	// 完了扱いの状態を全部なくすことはできない
+	tc.do(http.MethodPost, path(done, "/delete"), url.Values{})
+	if rec := tc.do(http.MethodPost, path(review, "/delete"), url.Values{}); rec.Code != http.StatusBadRequest {
+		t.Errorf("delete last done status: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec := tc.do(http.MethodPost, "/lists/"+strconv.FormatInt(list.ID, 10)+"/statuses", url.Values{"name": {"未着手"}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("duplicate name: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if n, _ := models.ListStatuses.Query(models.SelectWhere.ListStatuses.ListID.EQ(list.ID)).Count(ctx, db); n != 3 {
+		t.Errorf("statuses = %d, want 3", n)
+	}
+}
+
+func TestBoardCompletesRecurringTodo(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	if err := createDefaultStatuses(ctx, db, list.ID); err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := listStatuses(ctx, db, list.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := statuses[len(statuses)-1]
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Title("weekly report"),
+		factory.TodoMods.Completed(false),
+		factory.TodoMods.Recurrence(null.From("FREQ=WEEKLY")),
+	).CreateOrFail(ctx, t, db)
+
+	// 完了の列へ移すと1回の更新で完了になり、次の発生日のTodoは最初の列に出る
+	rec := login(t, e, alice).doWithHeader(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/status",
+		url.Values{"status_id": {strconv.FormatInt(done.ID, 10)}}, http.Header{"Hx-Target": {"board"}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("move to done: status = %d", rec.Code)
+	}
+	next, err := models.Todos.Query(
+		models.SelectWhere.Todos.ListID.EQ(list.ID),
+		models.SelectWhere.Todos.ID.NE(todo.ID),
+	).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextCard := `id="card-` + strconv.FormatInt(next.ID, 10) + `"`
+	assertOrder(t, rec.Body.String(), statuses[0].Name, nextCard, done.Name, `id="card-`+strconv.FormatInt(todo.ID, 10)+`"`)
+	if got, _ := models.FindTodo(ctx, db, todo.ID); !got.Completed || got.StatusID != null.From(done.ID) || got.Recurrence.IsValue() {
+		t.Errorf("completed todo = completed %v, status_id %v, recurrence %v", got.Completed, got.StatusID, got.Recurrence)
+	}
+	if n, _ := models.TodoEvents.Query(
+		models.SelectWhere.TodoEvents.TodoID.EQ(todo.ID),
+		models.SelectWhere.TodoEvents.Kind.EQ(todoEventCompleted),
+	).Count(ctx, db); n != 1 {
+		t.Errorf("completed events = %d, want 1", n)
+	}
+}
+
+func TestStatusDoneCompletesRecurringTodosAndParents(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	if err := createDefaultStatuses(ctx, db, list.ID); err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := listStatuses(ctx, db, list.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	review := statuses[2]
+	newTodo := func(mods ...factory.TodoMod) *models.Todo {
+		mods = append([]factory.TodoMod{
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.ListID(null.From(list.ID)),
+			factory.TodoMods.Completed(false),
+		}, mods...)
+		return f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db)
+	}
+	recurring := newTodo(
+		factory.TodoMods.Title("weekly review"),
+		factory.TodoMods.StatusID(null.From(review.ID)),
+		factory.TodoMods.Recurrence(null.From("FREQ=WEEKLY")),
+	)
+	parent := newTodo()
+	newTodo(factory.TodoMods.ParentID(null.From(parent.ID)), factory.TodoMods.Completed(true))
+	subtask := newTodo(factory.TodoMods.ParentID(null.From(parent.ID)), factory.TodoMods.StatusID(null.From(review.ID)))
+
+	// 状態を完了扱いにすると、1件ずつ完了にしたときと同じく次の発生日のTodoを作り、最後のサブタスクを完了にした親も完了にする
+	path := "/lists/" + strconv.FormatInt(list.ID, 10) + "/statuses/" + strconv.FormatInt(review.ID, 10)
+	if rec := login(t, e, alice).do(http.MethodPost, path, url.Values{"name": {review.Name}, "done": {"1"}}); rec.Code != http.StatusFound {
+		t.Fatalf("update: status = %d", rec.Code)
+	}
+	if got, _ := models.FindTodo(ctx, db, recurring.ID); !got.Completed || got.Recurrence.IsValue() {
+		t.Errorf("recurring todo = completed %v, recurrence %v", got.Completed, got.Recurrence)
+	}
+	if _, err := models.Todos.Query(
+		models.SelectWhere.Todos.Title.EQ("weekly review"),
+		models.SelectWhere.Todos.Completed.EQ(false),
+		models.SelectWhere.Todos.Recurrence.EQ("FREQ=WEEKLY"),
+	).One(ctx, db); err != nil {
+		t.Errorf("next occurrence: %v", err)
+	}
+	for _, todo := range []*models.Todo{subtask, parent} {
+		if got, _ := models.FindTodo(ctx, db, todo.ID); !got.Completed {
+			t.Errorf("todo %d is not completed", todo.ID)
+		}
+	}
+}
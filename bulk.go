@@ -0,0 +1,303 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// maxBulkTodos は一括操作で一度に扱えるTodoの最大件数
+const maxBulkTodos = 1000
+
+// 一括操作の種類（フォームの action の値）
+const (
+	bulkComplete   = "complete"
+	bulkUncomplete = "uncomplete"
+	bulkDelete     = "delete"
+	bulkMove       = "move"
+	bulkTag        = "tag"
+)
+
+// registerBulkRoutes は複数のTodoへの一括操作のルートを登録する
+func registerBulkRoutes(g *echo.Group, db bob.DB) {
+	// 選択したTodo（ids）に action をまとめて適用する。すべてのTodoに編集権限が必要で、1件でも足りなければ何もしない
+	// 「すべて完了」「完了したTodoを削除」のボタンも、表示中の該当するTodoを選んでここへ送る
+	// 変更した行は out-of-band で描き直し、移動・削除した行は一覧から消す
+	g.POST("/bulk", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		ids, err := bulkTodoIDs(c)
+		if err != nil {
+			return err
+		}
+		todos, err := findBulkTodos(ctx, db, userID, ids)
+		if err != nil {
+			return err
+		}
+
+		var apply func(ctx context.Context, exec bob.Executor) error
+		var created models.TodoSlice
+		now := time.Now().UTC()
+		action := c.FormValue("action")
+		switch action {
+		case bulkComplete:
+			// 未完了のTodoにブロックされているTodoがあれば、force を指定したときだけ完了にする
+			if c.FormValue("force") == "" {
+				if err := checkTodosNotBlocked(ctx, db, todos); err != nil {
+					return err
+				}
+			}
+			loc := c.Get("location").(*time.Location)
+			apply = func(ctx context.Context, exec bob.Executor) error {
+				// 繰り返しのTodoは1件ずつ完了にして次の発生日のTodoを作る
+				var plain []int64
+				for _, todo := range todos {
+					if !todo.Recurrence.IsValue() || todo.Completed {
+						plain = append(plain, todo.ID)
+						continue
+					}
+					next, err := completeRecurringTodo(ctx, exec, todo, now, loc, nil)
+					if err != nil {
+						return err
+					}
+					if next != nil {
+						created = append(created, next)
+					}
+				}
+				return setTodosCompleted(ctx, exec, plain, true, now)
+			}
+		case bulkUncomplete:
+			// 完了にした繰り返しのTodoは、繰り返しを次の発生日のTodoへ引き継いでいる（completeRecurringTodo）
+			// 一覧の切り替えと同じく、未完了に戻しても繰り返しは付け直さず、同じ繰り返しが2件にならないようにする
+			apply = func(ctx context.Context, exec bob.Executor) error {
+				var completed []int64
+				for _, todo := range todos {
+					if todo.Completed {
+						completed = append(completed, todo.ID)
+					}
+				}
+				return setTodosCompleted(ctx, exec, completed, false, now)
+			}
+		case bulkDelete:
+			apply = func(ctx context.Context, exec bob.Executor) error {
+				return softDeleteTodos(ctx, exec, todos, now)
+			}
+		case bulkMove:
+			scope := positionScope{UserID: userID}
+			setter := &models.TodoSetter{UpdatedAt: omit.From(now)}
+			if v := c.FormValue("list_id"); v != "" {
+				id, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusBadRequest)
+				}
+				// 移動先のリストにも編集権限が必要
+				list, _, err := findListWithRole(ctx, db, userID, id, RoleEditor)
+				if err != nil {
+					return err
+				}
+				setter.ListID = omitnull.From(list.ID)
+				scope.ListID = null.From(list.ID)
+			} else {
+				setter.ListID.Null()
+				setter.UserID = omit.From(userID)
+			}
+			apply = func(ctx context.Context, exec bob.Executor) error {
+				return moveTodos(ctx, exec, todos, setter, scope)
+			}
+		case bulkTag:
+			name := strings.TrimSpace(c.FormValue("tag"))
+			if name == "" || len([]rune(name)) > 30 {
+				return echo.NewHTTPError(http.StatusBadRequest, "タグ名は1〜30文字で入力してください")
+			}
+			tag, err := findOrCreateTag(ctx, db, userID, name)
+			if err != nil {
+				return err
+			}
+			apply = func(ctx context.Context, exec bob.Executor) error {
+				setters := make([]*models.TodoTagSetter, len(ids))
+				for i, id := range ids {
+					setters[i] = &models.TodoTagSetter{TodoID: omit.From(id), TagID: omit.From(tag.ID)}
+				}
+				_, err := models.TodoTags.Insert(bob.ToMods(setters...), im.OnConflict().DoNothing()).Exec(ctx, exec)
+				return err
+			}
+		default:
+			return echo.NewHTTPError(http.StatusBadRequest, "操作が正しくありません")
+		}
+
+		if err := db.RunInTx(ctx, nil, apply); err != nil {
+			return err
+		}
+
+		csrfToken := c.Get("csrf").(string)
+		var items []templ.Component
+		if action == bulkDelete || action == bulkMove {
+			for _, id := range ids {
+				items = append(items, views.RemovedTodoItem(id))
+			}
+			return render(c, http.StatusOK, templ.Join(items...))
+		}
+		updated, err := models.Todos.Query(
+			models.SelectWhere.Todos.ID.In(ids...),
+			models.SelectThenLoad.Todo.Tags(sm.OrderBy(models.Tags.Columns.Name)),
+			models.SelectThenLoad.Todo.Children(sm.OrderBy(models.Todos.Columns.ID)),
+			models.SelectThenLoad.Todo.TimeEntries(),
+			models.SelectThenLoad.Todo.Assignee(),
+			todoBlockersLoad(),
+		).All(ctx, db)
+		if err != nil {
+			return err
+		}
+		for _, todo := range updated {
+			items = append(items, views.TodoItemOOB(todo, csrfToken))
+		}
+		if len(created) > 0 {
+			if err := created.LoadTags(ctx, db, sm.OrderBy(models.Tags.Columns.Name)); err != nil {
+				return err
+			}
+			if err := created.LoadChildren(ctx, db, sm.OrderBy(models.Todos.Columns.ID)); err != nil {
+				return err
+			}
+			if err := created.LoadTimeEntries(ctx, db); err != nil {
+				return err
+			}
+			if err := created.LoadAssignee(ctx, db); err != nil {
+				return err
+			}
+			if err := created.LoadDependencies(ctx, db, models.SelectThenLoad.TodoDependency.Blocker()); err != nil {
+				return err
+			}
+			items = append(items, views.AppendTodoItems(created, csrfToken))
+		}
+		return render(c, http.StatusOK, templ.Join(items...))
+	})
+}
+
+// bulkTodoIDs はフォームの ids を重複を除いて読む
+func bulkTodoIDs(c echo.Context) ([]int64, error) {
+	params, err := c.FormParams()
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest)
+	}
+	values := params["ids"]
+	if len(values) == 0 {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Todoを選択してください")
+	}
+	if len(values) > maxBulkTodos {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "一度に操作できるのは"+strconv.Itoa(maxBulkTodos)+"件までです")
+	}
+	ids := make([]int64, 0, len(values))
+	for _, v := range values {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "Todoの指定が正しくありません")
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// findBulkTodos は一括操作するTodoを読み込み、すべてに編集権限があることを確かめる
+// 見られないTodoが含まれていれば404、閲覧だけのTodoが含まれていれば403を返す
+// サブタスクは親の行の中で操作するので対象にしない
+func findBulkTodos(ctx context.Context, db bob.DB, userID int64, ids []int64) (models.TodoSlice, error) {
+	todos, err := models.Todos.Query(models.SelectWhere.Todos.ID.In(ids...)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if len(todos) != len(ids) {
+		return nil, echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	// 同じリストのTodoが多いので、リストごとの権限は1回だけ調べる
+	listRoles := map[int64]ListRole{}
+	for _, todo := range todos {
+		if todo.ParentID.IsValue() {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "サブタスクは一括操作できません")
+		}
+		listID, inList := todo.ListID.Get()
+		role, ok := listRoles[listID]
+		if !inList || !ok {
+			if role, err = todoRole(ctx, db, userID, todo); err != nil {
+				return nil, err
+			}
+			if inList {
+				listRoles[listID] = role
+			}
+		}
+		if err := checkRole(role, RoleEditor); err != nil {
+			return nil, err
+		}
+	}
+	return todos, nil
+}
+
+// setTodosCompleted は複数のTodoの完了状態を1回の更新で変える
+func setTodosCompleted(ctx context.Context, exec bob.Executor, ids []int64, completed bool, now time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := models.Todos.Update(
+		models.TodoSetter{Completed: omit.From(completed), UpdatedAt: omit.From(now)}.UpdateMod(),
+		models.UpdateWhere.Todos.ID.In(ids...),
+	).Exec(ctx, exec)
+	return err
+}
+
+// moveTodos は複数のTodoをサブタスクごと1回の更新で別のリスト（または受信箱）へ移し、元の並び順のまま移動先の末尾に並べる
+func moveTodos(ctx context.Context, exec bob.Executor, todos models.TodoSlice, setter *models.TodoSetter, scope positionScope) error {
+	slices.SortFunc(todos, func(a, b *models.Todo) int {
+		if c := strings.Compare(a.Position, b.Position); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	ids := make([]int64, len(todos))
+	for i, todo := range todos {
+		ids[i] = todo.ID
+	}
+	positions, err := appendPositions(ctx, exec, scope, len(todos), ids...)
+	if err != nil {
+		return err
+	}
+
+	// 移すTodoの位置は CASE で1件ずつ決め、サブタスクは位置を変えない
+	position := sqlite.Case()
+	for i, todo := range todos {
+		position = position.When(models.Todos.Columns.ID.EQ(sqlite.Arg(todo.ID)), sqlite.Arg(positions[i]))
+	}
+	_, err = models.Todos.Update(
+		setter.UpdateMod(),
+		um.SetCol("position").To(position.Else(models.Todos.Columns.Position)),
+		sqlite.WhereOr(
+			models.UpdateWhere.Todos.ID.In(ids...),
+			models.UpdateWhere.Todos.ParentID.In(ids...),
+		),
+	).Exec(ctx, exec)
+	if err != nil {
+		return err
+	}
+	for i, todo := range todos {
+		todo.Position = positions[i]
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+// bulkForm は一括操作のフォームの値
+func bulkForm(action string, todos ...*models.Todo) url.Values {
+	form := url.Values{"action": {action}}
+	for _, todo := range todos {
+		form.Add("ids", strconv.FormatInt(todo.ID, 10))
+	}
+	return form
+}
+
+func TestBulkCompleteAndUncomplete(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	newTodo := func(mods ...factory.TodoMod) *models.Todo {
+		mods = append([]factory.TodoMod{factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Completed(false)}, mods...)
+		return f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db)
+	}
+	a := newTodo()
+	b := newTodo()
+	untouched := newTodo()
+	recurring := newTodo(
+		factory.TodoMods.Title("bulk-weekly"),
+		factory.TodoMods.Recurrence(null.From("FREQ=WEEKLY")),
+	)
+	tc := login(t, e, alice)
+
+	rec := tc.do(http.MethodPost, "/todos/bulk", bulkForm(bulkComplete, a, b, recurring))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("bulk complete: status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+	for _, todo := range []*models.Todo{a, b, recurring} {
+		if err := todo.Reload(ctx, db); err != nil {
+			t.Fatal(err)
+		}
+		if !todo.Completed {
+			t.Errorf("todo %d is not completed", todo.ID)
+		}
+		if !strings.Contains(body, `<li id="todo-`+strconv.FormatInt(todo.ID, 10)+`" hx-swap-oob="true"`) {
+			t.Errorf("response has no out-of-band row for todo %d", todo.ID)
+		}
+	}
+	if err := untouched.Reload(ctx, db); err != nil || untouched.Completed {
+		t.Errorf("unselected todo completed = %v, %v", untouched.Completed, err)
+	}
+	// 繰り返しのTodoは1件ずつ操作したときと同じく次のTodoを作り、一覧の末尾に足す
+	next, err := models.Todos.Query(
+		models.SelectWhere.Todos.Title.EQ("bulk-weekly"),
+		models.SelectWhere.Todos.Completed.EQ(false),
+	).One(ctx, db)
+	if err != nil {
+		t.Fatalf("next occurrence: %v", err)
+	}
+	if !strings.Contains(body, `hx-swap-oob="beforeend:#todo-items"`) || !strings.Contains(body, `id="todo-`+strconv.FormatInt(next.ID, 10)+`"`) {
+		t.Errorf("response does not append the next occurrence: %s", body)
+	}
+
+	// 未完了に戻しても、次のTodoへ引き継いだ繰り返しは付け直さない。未完了のTodoは書き換えない
+	untouchedAt := untouched.UpdatedAt
+	if rec := tc.do(http.MethodPost, "/todos/bulk", bulkForm(bulkUncomplete, a, b, recurring, untouched)); rec.Code != http.StatusOK {
+		t.Fatalf("bulk uncomplete: status = %d", rec.Code)
+	}
+	for _, todo := range []*models.Todo{a, b, recurring} {
+		if err := todo.Reload(ctx, db); err != nil || todo.Completed {
+			t.Errorf("todo %d completed = %v, %v", todo.ID, todo.Completed, err)
+		}
+	}
+	if n, err := models.Todos.Query(
+		models.SelectWhere.Todos.Title.EQ("bulk-weekly"),
+		models.SelectWhere.Todos.Recurrence.IsNotNull(),
+	).Count(ctx, db); err != nil || n != 1 {
+		t.Errorf("recurring todos after uncomplete = %d, %v; want 1", n, err)
+	}
+	if err := untouched.Reload(ctx, db); err != nil || !untouched.UpdatedAt.Equal(untouchedAt) {
+		t.Errorf("open todo updated_at = %v, %v; want %v", untouched.UpdatedAt, err, untouchedAt)
+	}
+}
+
+func TestBulkDeleteMoveAndTag(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	newTodo := func() *models.Todo {
+		return f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	}
+	a, b, c := newTodo(), newTodo(), newTodo()
+	child := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ParentID(null.From(b.ID)),
+	).CreateOrFail(ctx, t, db)
+	existing := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Position("n"),
+	).CreateOrFail(ctx, t, db)
+	for i, todo := range []*models.Todo{a, b} {
+		if err := todo.Update(ctx, db, &models.TodoSetter{Position: omit.From([]string{"b", "a"}[i])}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	childPosition := child.Position
+	tc := login(t, e, alice)
+
+	// タグを付ける
+	form := bulkForm(bulkTag, a, b)
+	form.Set("tag", "bulk-tag")
+	if rec := tc.do(http.MethodPost, "/todos/bulk", form); rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "bulk-tag") {
+		t.Fatalf("bulk tag: status = %d", rec.Code)
+	}
+	for _, todo := range []*models.Todo{a, b} {
+		if err := todo.LoadTags(ctx, db); err != nil || len(todo.R.Tags) != 1 {
+			t.Errorf("todo %d tags = %v, %v", todo.ID, todo.R.Tags, err)
+		}
+	}
+
+	// リストへ移動する。サブタスクも一緒に移る
+	form = bulkForm(bulkMove, a, b)
+	form.Set("list_id", strconv.FormatInt(list.ID, 10))
+	rec := tc.do(http.MethodPost, "/todos/bulk", form)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("bulk move: status = %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `<li id="todo-`+strconv.FormatInt(a.ID, 10)+`" hx-swap-oob="delete">`) {
+		t.Errorf("moved row is not removed: %s", rec.Body.String())
+	}
+	for _, todo := range []*models.Todo{a, b, child} {
+		if err := todo.Reload(ctx, db); err != nil || todo.ListID.GetOrZero() != list.ID {
+			t.Errorf("todo %d list = %v, %v; want %d", todo.ID, todo.ListID, err, list.ID)
+		}
+	}
+	// 元の並び順（b, a）のまま、移動先のTodoより後ろに並ぶ。サブタスクの位置は変えない
+	if !(existing.Position < b.Position && b.Position < a.Position) {
+		t.Errorf("positions = existing %q, b %q, a %q; want them in this order", existing.Position, b.Position, a.Position)
+	}
+	if child.Position != childPosition {
+		t.Errorf("subtask position = %q, want %q", child.Position, childPosition)
+	}
+
+	// 削除する。サブタスクも一緒にゴミ箱に入る
+	if rec := tc.do(http.MethodPost, "/todos/bulk", bulkForm(bulkDelete, b, c)); rec.Code != http.StatusOK {
+		t.Fatalf("bulk delete: status = %d", rec.Code)
+	}
+	for _, tt := range []struct {
+		todo *models.Todo
+		want bool
+	}{
+		{a, true},
+		{b, false},
+		{child, false},
+		{c, false},
+	} {
+		if exists, _ := models.TodoExists(ctx, db, tt.todo.ID); exists != tt.want {
+			t.Errorf("todo %d exists = %v, want %v", tt.todo.ID, exists, tt.want)
+		}
+	}
+	if rec := tc.do(http.MethodGet, "/trash", nil); !strings.Contains(rec.Body.String(), "サブタスク1件") {
+		t.Error("subtask deleted with its parent is not grouped in the trash")
+	}
+}
+
+func TestBulkRequiresEditorForEveryTodo(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	bob := createTestUser(t, db, "bob@example.com")
+	shared := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(bob)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(shared),
+		factory.ListMemberMods.WithExistingUser(alice),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+	own := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	viewOnly := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(bob),
+		factory.TodoMods.ListID(null.From(shared.ID)),
+		factory.TodoMods.Completed(false),
+	).CreateOrFail(ctx, t, db)
+	private := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(bob), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	subtask := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.ParentID(null.From(own.ID))).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	for _, tt := range []struct {
+		name string
+		form url.Values
+		want int
+	}{
+		{"view only", bulkForm(bulkComplete, own, viewOnly), http.StatusForbidden},
+		{"inaccessible", bulkForm(bulkComplete, own, private), http.StatusNotFound},
+		{"subtask", bulkForm(bulkComplete, own, subtask), http.StatusBadRequest},
+		{"no ids", bulkForm(bulkComplete), http.StatusBadRequest},
+		{"unknown action", bulkForm("archive", own), http.StatusBadRequest},
+	} {
+		if rec := tc.do(http.MethodPost, "/todos/bulk", tt.form); rec.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.want)
+		}
+	}
+	// 1件でも権限が足りなければ何も変えない
+	if err := own.Reload(ctx, db); err != nil || own.Completed {
+		t.Errorf("own todo completed = %v, %v; want false", own.Completed, err)
+	}
+}
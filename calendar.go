@@ -0,0 +1,196 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// カレンダーの表示単位（view パラメータの値）
+const (
+	calendarMonth = "month"
+	calendarWeek  = "week"
+)
+
+// maxUndatedTodos はカレンダーの「期限なし」に並べるTodoの最大件数
+const maxUndatedTodos = 50
+
+// registerCalendarRoutes はカレンダーのルートを登録する
+func registerCalendarRoutes(g *echo.Group, db bob.DB) {
+	// 月・週のカレンダー。アクセスできるTodo（サブタスクを除く）を期限日に並べる
+	// view（month・week）と date（表示する日を含む月・週）で表示する範囲を決める
+	g.GET("", func(c echo.Context) error {
+		return renderCalendar(c, db)
+	})
+}
+
+// registerTodoScheduleRoutes はカレンダーからTodoの期限日を変えるルートを登録する
+func registerTodoScheduleRoutes(g *echo.Group, db bob.DB) {
+	// 期限日の変更。カレンダーのドラッグ＆ドロップから呼ばれ、カレンダーを描き直す
+	// due_on が空なら期限を外す（「期限なし」に移す）。view・date は描き直すカレンダーの範囲
+	g.POST("/:id/schedule", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		if todo.ParentID.IsValue() {
+			return echo.NewHTTPError(http.StatusBadRequest, "サブタスクはカレンダーで動かせません")
+		}
+
+		setter := &models.TodoSetter{UpdatedAt: omit.From(time.Now().UTC())}
+		if v := c.FormValue("due_on"); v != "" {
+			dueAt, err := parseDueDate(v, c.Get("location").(*time.Location))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "期限日が正しくありません")
+			}
+			setter.DueAt = omitnull.From(dueAt)
+		} else {
+			setter.DueAt.Null()
+		}
+		if err := todo.Update(ctx, db, setter); err != nil {
+			return err
+		}
+		return renderCalendar(c, db)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// renderCalendar はカレンダーを返す。HTMXでカレンダーだけを差し替えるリクエスト（HX-Target が calendar）にはカレンダー部分だけを返す
+func renderCalendar(c echo.Context, db bob.DB) error {
+	ctx := c.Request().Context()
+	userID := c.Get("user_id").(int64)
+	loc := c.Get("location").(*time.Location)
+
+	calendar, err := buildCalendar(c.FormValue("view"), c.FormValue("date"), time.Now(), loc)
+	if err != nil {
+		return err
+	}
+	first := calendar.Weeks[0][0].Date
+	last := calendar.Weeks[len(calendar.Weeks)-1][6].Date
+	from, err := parseDueDate(first, loc)
+	if err != nil {
+		return err
+	}
+	to, err := parseDueDate(civilDate(last).AddDate(0, 0, 1).Format(time.DateOnly), loc)
+	if err != nil {
+		return err
+	}
+
+	todos, err := models.Todos.Query(
+		sm.Where(accessibleTodos(userID)),
+		models.SelectWhere.Todos.ParentID.IsNull(),
+		models.SelectWhere.Todos.DueAt.GTE(from),
+		models.SelectWhere.Todos.DueAt.LT(to),
+		sm.OrderBy(models.Todos.Columns.Completed),
+		sm.OrderBy(models.Todos.Columns.Priority).Desc(),
+		sm.OrderBy(models.Todos.Columns.ID),
+		models.SelectThenLoad.Todo.List(),
+	).All(ctx, db)
+	if err != nil {
+		return err
+	}
+	days := make(map[string]*views.CalendarDay)
+	for _, week := range calendar.Weeks {
+		for i := range week {
+			days[week[i].Date] = &week[i]
+		}
+	}
+	for _, todo := range todos {
+		if day, ok := days[todo.DueAt.MustGet().In(loc).Format(time.DateOnly)]; ok {
+			day.Todos = append(day.Todos, todo)
+		}
+	}
+
+	calendar.Undated, err = models.Todos.Query(
+		sm.Where(accessibleTodos(userID)),
+		models.SelectWhere.Todos.ParentID.IsNull(),
+		models.SelectWhere.Todos.DueAt.IsNull(),
+		models.SelectWhere.Todos.Completed.EQ(false),
+		sm.OrderBy(models.Todos.Columns.ID).Desc(),
+		sm.Limit(maxUndatedTodos),
+		models.SelectThenLoad.Todo.List(),
+	).All(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	csrfToken := c.Get("csrf").(string)
+	if c.Request().Header.Get("HX-Target") == "calendar" {
+		return render(c, http.StatusOK, views.Calendar(calendar, csrfToken))
+	}
+	return render(c, http.StatusOK, views.CalendarPage(calendar, csrfToken))
+}
+
+// buildCalendar は表示する範囲の日付を週（月曜始まり）ごとに並べる。Todoは入れない
+// 日付は loc の暦日で扱い、月表示では前後の月の日も週が埋まるまで含める
+func buildCalendar(view, date string, now time.Time, loc *time.Location) (views.CalendarData, error) {
+	if view == "" {
+		view = calendarMonth
+	}
+	if view != calendarMonth && view != calendarWeek {
+		return views.CalendarData{}, echo.NewHTTPError(http.StatusBadRequest, "表示の指定が正しくありません")
+	}
+	today := civilDate(now.In(loc).Format(time.DateOnly))
+	anchor := today
+	if date != "" {
+		t, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			return views.CalendarData{}, echo.NewHTTPError(http.StatusBadRequest, "日付が正しくありません")
+		}
+		anchor = t
+	}
+
+	calendar := views.CalendarData{View: view, Today: today.Format(time.DateOnly)}
+	var start, end time.Time
+	switch view {
+	case calendarMonth:
+		first := time.Date(anchor.Year(), anchor.Month(), 1, 0, 0, 0, 0, time.UTC)
+		last := first.AddDate(0, 1, -1)
+		start = first.AddDate(0, 0, -weekdayOffset(first))
+		end = last.AddDate(0, 0, 7-weekdayOffset(last))
+		calendar.Title = strconv.Itoa(first.Year()) + "年" + strconv.Itoa(int(first.Month())) + "月"
+		calendar.Prev = first.AddDate(0, -1, 0).Format(time.DateOnly)
+		calendar.Next = first.AddDate(0, 1, 0).Format(time.DateOnly)
+		calendar.Date = first.Format(time.DateOnly)
+	case calendarWeek:
+		start = anchor.AddDate(0, 0, -weekdayOffset(anchor))
+		end = start.AddDate(0, 0, 7)
+		last := end.AddDate(0, 0, -1)
+		calendar.Title = strconv.Itoa(start.Year()) + "年" + strconv.Itoa(int(start.Month())) + "月" + strconv.Itoa(start.Day()) + "日〜" +
+			strconv.Itoa(int(last.Month())) + "月" + strconv.Itoa(last.Day()) + "日"
+		calendar.Prev = start.AddDate(0, 0, -7).Format(time.DateOnly)
+		calendar.Next = end.Format(time.DateOnly)
+		calendar.Date = start.Format(time.DateOnly)
+	}
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 7) {
+		week := make([]views.CalendarDay, 7)
+		for i := range week {
+			d := day.AddDate(0, 0, i)
+			week[i] = views.CalendarDay{
+				Date:    d.Format(time.DateOnly),
+				Day:     d.Day(),
+				Outside: view == calendarMonth && d.Month() != anchor.Month(),
+			}
+		}
+		calendar.Weeks = append(calendar.Weeks, week)
+	}
+	return calendar, nil
+}
+
+// civilDate は "2006-01-02" 形式の日付をUTCの0時で表す。暦日の計算だけに使う
+func civilDate(date string) time.Time {
+	t, _ := time.Parse(time.DateOnly, date)
+	return t
+}
+
+// weekdayOffset は月曜日からの日数（月曜が0、日曜が6）
+func weekdayOffset(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
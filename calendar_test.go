@@ -0,0 +1,101 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestBuildCalendar(t *testing.T) {
+	loc := loadLocation(defaultTimezone)
+	now := time.Date(2026, 3, 18, 9, 0, 0, 0, loc)
+
+	// 2026年3月は日曜始まりなので、前の週の月曜日（2/23）から4/5までの6週を並べる
+	month, err := buildCalendar("", "", now, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(month.Weeks) != 6 || month.Weeks[0][0].Date != "2026-02-23" || month.Weeks[5][6].Date != "2026-04-05" {
+		t.Errorf("month = %s..%s (%d weeks)", month.Weeks[0][0].Date, month.Weeks[len(month.Weeks)-1][6].Date, len(month.Weeks))
+	}
+	if !month.Weeks[0][0].Outside || month.Weeks[0][6].Outside || month.Prev != "2026-02-01" || month.Next != "2026-04-01" {
+		t.Errorf("month = %+v", month)
+	}
+
+	week, err := buildCalendar("week", "2026-03-18", now, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(week.Weeks) != 1 || week.Date != "2026-03-16" || week.Next != "2026-03-23" || week.Title != "2026年3月16日〜3月22日" {
+		t.Errorf("week = %+v", week)
+	}
+
+	for _, tt := range [][2]string{{"year", ""}, {"month", "2026-13-01"}} {
+		if _, err := buildCalendar(tt[0], tt[1], now, loc); err == nil {
+			t.Errorf("buildCalendar(%q, %q) did not fail", tt[0], tt[1])
+		}
+	}
+}
+
+func TestCalendarSchedulesTodos(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+	loc := loadLocation(defaultTimezone)
+	due := func(date string) null.Val[time.Time] {
+		t.Helper()
+		d, err := parseDueDate(date, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return null.From(d)
+	}
+
+	alice := createTestUser(t, db, "alice@example.com")
+	bob := createTestUser(t, db, "bob@example.com")
+	dated := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title("dentist"), factory.TodoMods.DueAt(due("2026-03-10"))).CreateOrFail(ctx, t, db)
+	f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title("someday"), factory.TodoMods.DueAt(null.Val[time.Time]{})).CreateOrFail(ctx, t, db)
+	f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(bob), factory.TodoMods.Title("bob's errand"), factory.TodoMods.DueAt(due("2026-03-10"))).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	body := tc.do(http.MethodGet, "/calendar?view=month&date=2026-03-05", nil).Body.String()
+	assertOrder(t, body, "2026年3月", `data-calendar-day="2026-03-10"`, "dentist", `data-calendar-day="2026-03-11"`, "期限なし", "someday")
+	if strings.Contains(body, "bob&#39;s errand") {
+		t.Error("calendar shows another user's todo")
+	}
+
+	// 別の日に落とすと期限日が変わり、カレンダーだけを描き直す
+	schedulePath := "/todos/" + strconv.FormatInt(dated.ID, 10) + "/schedule"
+	rec := tc.doWithHeader(http.MethodPost, schedulePath,
+		url.Values{"due_on": {"2026-03-12"}, "view": {"week"}, "date": {"2026-03-09"}},
+		http.Header{"Hx-Target": {"calendar"}})
+	if rec.Code != http.StatusOK || strings.Contains(rec.Body.String(), "<html") {
+		t.Fatalf("schedule: status = %d", rec.Code)
+	}
+	assertOrder(t, rec.Body.String(), `data-calendar-day="2026-03-12"`, "dentist", `data-calendar-day="2026-03-13"`)
+	got, err := models.FindTodo(ctx, db, dated.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.DueAt != due("2026-03-12") {
+		t.Errorf("due_at = %v", got.DueAt)
+	}
+
+	// 「期限なし」に落とすと期限を外す
+	tc.do(http.MethodPost, schedulePath, url.Values{"due_on": {""}})
+	if got, _ := models.FindTodo(ctx, db, dated.ID); got.DueAt.IsValue() {
+		t.Errorf("due_at = %v, want null", got.DueAt)
+	}
+
+	if rec := login(t, e, bob).do(http.MethodPost, schedulePath, url.Values{"due_on": {"2026-03-12"}}); rec.Code != http.StatusNotFound {
+		t.Errorf("other user: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// maxCommentLength はコメントの最大文字数
+const maxCommentLength = 2000
+
+// mentionPattern はコメント中のメンション（@メールアドレス）
+var mentionPattern = regexp.MustCompile(`@([A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+
+// registerCommentRoutes はTodoのコメントのルートを登録する
+// コメントは詳細ペインに表示し、投稿・削除のたびに詳細ペインを描画し直す
+func registerCommentRoutes(g *echo.Group, db bob.DB) {
+	// コメントの投稿。parent_id を指定すると返信になる
+	g.POST("/:id/comments", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		todo := c.Get("todo").(*models.Todo)
+
+		body := strings.TrimSpace(c.FormValue("body"))
+		if body == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "コメントを入力してください")
+		}
+		if utf8.RuneCountInString(body) > maxCommentLength {
+			return echo.NewHTTPError(http.StatusBadRequest, "コメントは"+strconv.Itoa(maxCommentLength)+"文字以内で入力してください")
+		}
+
+		setter := &models.CommentSetter{
+			TodoID: omit.From(todo.ID),
+			UserID: omit.From(userID),
+			Body:   omit.From(body),
+		}
+		if v := c.FormValue("parent_id"); v != "" {
+			parentID, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "返信先が正しくありません")
+			}
+			parent, err := models.Comments.Query(
+				models.SelectWhere.Comments.ID.EQ(parentID),
+				models.SelectWhere.Comments.TodoID.EQ(todo.ID),
+			).One(ctx, db)
+			if err != nil {
+				return notFoundIfNoRows(err)
+			}
+			// 返信への返信は元のコメントにつけ、スレッドを1階層に保つ
+			setter.ParentID = omitnull.From(parent.ParentID.GetOr(parent.ID))
+		}
+
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			comment, err := models.Comments.Insert(setter).One(ctx, exec)
+			if err != nil {
+				return err
+			}
+			mentioned, err := mentionedUsers(ctx, exec, todo, body, userID)
+			if err != nil {
+				return err
+			}
+			for _, user := range mentioned {
+				if err := notify(ctx, exec, user.ID, userID, notificationMention, todo.ID, comment.ID); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoDetail(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+
+	// コメントの削除。投稿者とリストの管理者だけが削除でき、返信もあわせて削除される
+	g.POST("/:id/comments/:comment_id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+
+		commentID, err := strconv.ParseInt(c.Param("comment_id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		comment, err := models.Comments.Query(
+			models.SelectWhere.Comments.ID.EQ(commentID),
+			models.SelectWhere.Comments.TodoID.EQ(todo.ID),
+		).One(ctx, db)
+		if err != nil {
+			return notFoundIfNoRows(err)
+		}
+		if comment.UserID != c.Get("user_id").(int64) && !views.PermissionFromContext(ctx).CanManage {
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+		if err := comment.Delete(ctx, db); err != nil {
+			return err
+		}
+		return renderTodoDetail(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// mentionedUsers は本文でメンションされたユーザーのうち、Todoを閲覧できるユーザーを返す
+// 存在しないメールアドレスや閲覧できないユーザー、投稿者自身は無視する
+func mentionedUsers(ctx context.Context, exec bob.Executor, todo *models.Todo, body string, authorID int64) (models.UserSlice, error) {
+	var emails []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		if !slices.Contains(emails, match[1]) {
+			emails = append(emails, match[1])
+		}
+	}
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	users, err := models.Users.Query(models.SelectWhere.Users.Email.In(emails...)).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	var mentioned models.UserSlice
+	for _, user := range users {
+		if user.ID == authorID {
+			continue
+		}
+		role, err := todoRole(ctx, exec, user.ID, todo)
+		if err != nil {
+			return nil, err
+		}
+		if role != RoleNone {
+			mentioned = append(mentioned, user)
+		}
+	}
+	return mentioned, nil
+}
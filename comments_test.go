@@ -0,0 +1,129 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestCommentMentionsNotifyMembers(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	editor := createTestUser(t, db, "editor@example.com")
+	stranger := createTestUser(t, db, "stranger@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(editor),
+		factory.ListMemberMods.Role(memberRoleEditor),
+	).CreateOrFail(ctx, t, db)
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(owner),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Title("release-notes"),
+	).CreateOrFail(ctx, t, db)
+	ownerClient := login(t, e, owner)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/comments"
+
+	// 閲覧できないユーザー・存在しないユーザー・自分自身へのメンションは通知しない
+	body := "@editor@example.com 確認お願いします。@editor@example.com cc @stranger@example.com @nobody@example.com @owner@example.com"
+	rec := ownerClient.do(http.MethodPost, path, url.Values{"body": {body}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("post comment: status = %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), "確認お願いします") {
+		t.Error("detail does not show the comment")
+	}
+	notifications, err := models.Notifications.Query().All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifications) != 1 || notifications[0].UserID != editor.ID || notifications[0].ActorID != owner.ID || notifications[0].Kind != notificationMention {
+		t.Fatalf("notifications = %+v, want one mention for editor", notifications)
+	}
+	if n, err := stranger.Notifications().Count(ctx, db); err != nil || n != 0 {
+		t.Errorf("stranger notifications = %d, %v", n, err)
+	}
+
+	// 通知先は未読件数と通知一覧で確認し、既読にできる
+	editorClient := login(t, e, editor)
+	page := editorClient.do(http.MethodGet, "/notifications", nil).Body.String()
+	if !strings.Contains(page, "<mark>1</mark>") || !strings.Contains(page, "release-notes") || !strings.Contains(page, "owner@example.com") {
+		t.Errorf("notification page does not show the mention: %s", page)
+	}
+	if rec := editorClient.do(http.MethodPost, "/notifications/read", url.Values{}); rec.Code != http.StatusFound {
+		t.Fatalf("mark read: status = %d", rec.Code)
+	}
+	if n, err := unreadNotificationCount(ctx, db, editor.ID); err != nil || n != 0 {
+		t.Errorf("unread = %d, %v; want 0", n, err)
+	}
+}
+
+func TestCommentRepliesAndDelete(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	editor := createTestUser(t, db, "editor@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(editor),
+		factory.ListMemberMods.Role(memberRoleEditor),
+	).CreateOrFail(ctx, t, db)
+	todo := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(owner), factory.TodoMods.ListID(null.From(list.ID))).CreateOrFail(ctx, t, db)
+	ownerClient := login(t, e, owner)
+	editorClient := login(t, e, editor)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/comments"
+
+	ownerClient.do(http.MethodPost, path, url.Values{"body": {"top"}})
+	top, err := models.Comments.Query(models.SelectWhere.Comments.Body.EQ("top")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	editorClient.do(http.MethodPost, path, url.Values{"body": {"reply"}, "parent_id": {strconv.FormatInt(top.ID, 10)}})
+	reply, err := models.Comments.Query(models.SelectWhere.Comments.Body.EQ("reply")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 返信への返信は元のコメントにつく
+	rec := ownerClient.do(http.MethodPost, path, url.Values{"body": {"nested"}, "parent_id": {strconv.FormatInt(reply.ID, 10)}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("reply to reply: status = %d", rec.Code)
+	}
+	assertOrder(t, rec.Body.String(), "top", "reply", "nested")
+	nested, err := models.Comments.Query(models.SelectWhere.Comments.Body.EQ("nested")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nested.ParentID.GetOrZero() != top.ID {
+		t.Errorf("nested parent = %v, want %d", nested.ParentID, top.ID)
+	}
+
+	if rec := ownerClient.do(http.MethodPost, path, url.Values{"body": {"  "}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("empty comment: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	// 編集者は他人のコメントを削除できない。管理者（所有者）は削除でき、返信も消える
+	deletePath := path + "/" + strconv.FormatInt(top.ID, 10) + "/delete"
+	if rec := editorClient.do(http.MethodPost, deletePath, url.Values{}); rec.Code != http.StatusForbidden {
+		t.Errorf("editor delete: status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	if rec := ownerClient.do(http.MethodPost, deletePath, url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("owner delete: status = %d", rec.Code)
+	}
+	if n, err := models.Comments.Query().Count(ctx, db); err != nil || n != 0 {
+		t.Errorf("comments = %d, %v; want 0", n, err)
+	}
+}
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- SQLiteはALTER TABLEで外部キー付きのNOT NULL列を追加できないためテーブルを作り直す
+CREATE TABLE todos_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- 既存のTodoは最初に登録されたユーザーの所有とする
+-- ユーザーがいなければ user_id が NULL になり、NOT NULL 制約で移行ごと失敗する（Todoを黙って捨てない）
+INSERT INTO todos_new (id, user_id, title, completed, created_at, updated_at)
+SELECT id, (SELECT MIN(id) FROM users), title, completed, created_at, updated_at
+FROM todos;
+DROP TABLE todos;
+ALTER TABLE todos_new RENAME TO todos;
+CREATE INDEX todos_user_id_idx ON todos(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE todos_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title TEXT NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO todos_old (id, title, completed, created_at, updated_at)
+SELECT id, title, completed, created_at, updated_at FROM todos;
+DROP TABLE todos;
+ALTER TABLE todos_old RENAME TO todos;
+-- +goose StatementEnd
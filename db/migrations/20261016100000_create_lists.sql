@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE lists (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX lists_user_id_idx ON lists(user_id);
+
+-- list_id がNULLのTodoは受信箱（/todos）に表示する
+ALTER TABLE todos ADD COLUMN list_id INTEGER REFERENCES lists(id) ON DELETE CASCADE;
+CREATE INDEX todos_list_id_idx ON todos(list_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS todos_list_id_idx;
+-- 外部キー列はDROP COLUMNできないためテーブルを作り直す
+CREATE TABLE todos_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO todos_old (id, user_id, title, completed, created_at, updated_at)
+SELECT id, user_id, title, completed, created_at, updated_at FROM todos;
+DROP TABLE todos;
+ALTER TABLE todos_old RENAME TO todos;
+CREATE INDEX todos_user_id_idx ON todos(user_id);
+DROP INDEX IF EXISTS lists_user_id_idx;
+DROP TABLE lists;
+-- +goose StatementEnd
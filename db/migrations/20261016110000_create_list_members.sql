@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- リストの所有者（lists.user_id）以外の共同編集者と権限
+CREATE TABLE list_members (
+    list_id INTEGER NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor', 'admin')),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (list_id, user_id)
+);
+CREATE INDEX list_members_user_id_idx ON list_members(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS list_members_user_id_idx;
+DROP TABLE list_members;
+-- +goose StatementEnd
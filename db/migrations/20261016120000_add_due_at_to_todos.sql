@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 期限日はユーザーのタイムゾーンでのその日の0時をUTCで保存する
+ALTER TABLE todos ADD COLUMN due_at DATETIME;
+CREATE INDEX todos_due_at_idx ON todos(due_at);
+-- 期限日の判定に使うユーザーのタイムゾーン（IANA名）
+ALTER TABLE users ADD COLUMN timezone TEXT NOT NULL DEFAULT 'Asia/Tokyo';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN timezone;
+DROP INDEX IF EXISTS todos_due_at_idx;
+ALTER TABLE todos DROP COLUMN due_at;
+-- +goose StatementEnd
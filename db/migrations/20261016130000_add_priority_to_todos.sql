@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 0: なし, 1: 低, 2: 中, 3: 高, 4: 緊急
+ALTER TABLE todos ADD COLUMN priority INTEGER NOT NULL DEFAULT 0 CHECK (priority BETWEEN 0 AND 4);
+-- Todo一覧の並び順（ユーザーごとに最後に選んだものを保存する）
+ALTER TABLE users ADD COLUMN todo_sort TEXT NOT NULL DEFAULT 'created';
+ALTER TABLE users ADD COLUMN todo_sort_dir TEXT NOT NULL DEFAULT 'asc';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN todo_sort_dir;
+ALTER TABLE users DROP COLUMN todo_sort;
+ALTER TABLE todos DROP COLUMN priority;
+-- +goose StatementEnd
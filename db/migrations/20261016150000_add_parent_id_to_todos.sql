@@ -0,0 +1,37 @@
+-- +goose NO TRANSACTION
+-- Downでテーブルを作り直す間だけ外部キー制約を無効にするため、トランザクションを使わない
+-- （トランザクション中は PRAGMA foreign_keys を変更できない）
+
+-- +goose Up
+-- +goose StatementBegin
+-- サブタスクは親のTodoを指す。親を削除するとサブタスクも削除する
+ALTER TABLE todos ADD COLUMN parent_id INTEGER REFERENCES todos(id) ON DELETE CASCADE;
+CREATE INDEX todos_parent_id_idx ON todos(parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+PRAGMA foreign_keys = OFF;
+DROP INDEX IF EXISTS todos_parent_id_idx;
+-- 外部キー列はDROP COLUMNできないためテーブルを作り直す
+-- todo_tags などから参照されているので、作り直しで連鎖削除されないよう制約を外して行う
+CREATE TABLE todos_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    list_id INTEGER REFERENCES lists(id) ON DELETE CASCADE,
+    due_at DATETIME,
+    priority INTEGER NOT NULL DEFAULT 0 CHECK (priority BETWEEN 0 AND 4)
+);
+INSERT INTO todos_old (id, user_id, title, completed, created_at, updated_at, list_id, due_at, priority)
+SELECT id, user_id, title, completed, created_at, updated_at, list_id, due_at, priority FROM todos;
+DROP TABLE todos;
+ALTER TABLE todos_old RENAME TO todos;
+CREATE INDEX todos_user_id_idx ON todos(user_id);
+CREATE INDEX todos_list_id_idx ON todos(list_id);
+CREATE INDEX todos_due_at_idx ON todos(due_at);
+PRAGMA foreign_keys = ON;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 繰り返しルール（RFC 5545 のRRULE。"RRULE:" は付けない）。NULLなら繰り返さない
+ALTER TABLE todos ADD COLUMN recurrence TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE todos DROP COLUMN recurrence;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Markdownのメモ。表示するときにサニタイズしたHTMLに変換する
+ALTER TABLE todos ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE todos DROP COLUMN notes;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 添付ファイル。中身は保存先（storage.Storage）に sha256 をキーにして置き、同じ中身は共有する
+CREATE TABLE attachments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    todo_id INTEGER NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    filename TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    sha256 TEXT NOT NULL,
+    has_thumbnail BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX attachments_todo_id_idx ON attachments(todo_id);
+CREATE INDEX attachments_sha256_idx ON attachments(sha256);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS attachments_sha256_idx;
+DROP INDEX IF EXISTS attachments_todo_id_idx;
+DROP TABLE attachments;
+-- +goose StatementEnd
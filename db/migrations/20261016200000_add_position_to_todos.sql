@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 手動の並び順の位置（rank パッケージが作る文字列）
+-- 同じリスト（受信箱ならユーザーごと）のサブタスクでないTodoの間で辞書順に比較する
+ALTER TABLE todos ADD COLUMN position TEXT NOT NULL DEFAULT '';
+-- 既存のTodoは作成順に並べておく（ゼロ埋めしたIDは辞書順でもIDの順になる）
+UPDATE todos SET position = printf('%010d', id) WHERE parent_id IS NULL;
+CREATE INDEX todos_list_id_position_idx ON todos(list_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS todos_list_id_position_idx;
+ALTER TABLE todos DROP COLUMN position;
+-- +goose StatementEnd
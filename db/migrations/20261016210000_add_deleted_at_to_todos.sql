@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 削除したTodoはゴミ箱に入れ、保持期間が過ぎたら完全に削除する
+ALTER TABLE todos ADD COLUMN deleted_at DATETIME;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE INDEX todos_deleted_at_idx ON todos(deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX todos_deleted_at_idx;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE todos DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Todoのタイトルとメモの全文検索用の索引。本文は todos を参照し、索引だけを持つ
+-- 日本語は単語に区切れないので、3文字ずつの組（trigram）で索引を作る
+CREATE VIRTUAL TABLE todos_fts USING fts5(
+    title,
+    notes,
+    content = 'todos',
+    content_rowid = 'id',
+    tokenize = 'trigram'
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+INSERT INTO todos_fts(todos_fts) VALUES ('rebuild');
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TRIGGER todos_fts_after_insert AFTER INSERT ON todos BEGIN
+    INSERT INTO todos_fts(rowid, title, notes) VALUES (new.id, new.title, new.notes);
+END;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TRIGGER todos_fts_after_delete AFTER DELETE ON todos BEGIN
+    INSERT INTO todos_fts(todos_fts, rowid, title, notes) VALUES ('delete', old.id, old.title, old.notes);
+END;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE TRIGGER todos_fts_after_update AFTER UPDATE OF title, notes ON todos BEGIN
+    INSERT INTO todos_fts(todos_fts, rowid, title, notes) VALUES ('delete', old.id, old.title, old.notes);
+    INSERT INTO todos_fts(rowid, title, notes) VALUES (new.id, new.title, new.notes);
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER todos_fts_after_update;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TRIGGER todos_fts_after_delete;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TRIGGER todos_fts_after_insert;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE todos_fts;
+-- +goose StatementEnd
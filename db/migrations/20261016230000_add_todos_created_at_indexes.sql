@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Todo一覧は作成日順に (created_at, id) のキーセットでページを分けて読むので、
+-- 受信箱（ユーザーごと）とリストのそれぞれで作成日順にたどれるようにする
+-- IDはrowidなので索引の末尾に暗黙に含まれる
+CREATE INDEX todos_user_id_list_id_created_at_idx ON todos(user_id, list_id, created_at);
+CREATE INDEX todos_list_id_created_at_idx ON todos(list_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS todos_list_id_created_at_idx;
+DROP INDEX IF EXISTS todos_user_id_list_id_created_at_idx;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Todoの変更履歴。追記するだけで書き換えない（Todoを完全に削除したときは一緒に消える）
+-- user_id は操作したユーザー。ユーザーが退会しても履歴は残す
+-- old_value / new_value は変更前後の値を文字列にしたもの。値のない変更（削除・復元など）では NULL
+CREATE TABLE todo_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    todo_id INTEGER NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    kind TEXT NOT NULL,
+    old_value TEXT,
+    new_value TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX todo_events_todo_id_idx ON todo_events(todo_id, id);
+
+-- 履歴の内容は書き換えさせない（退会による user_id の NULL への更新だけは通す）
+CREATE TRIGGER todo_events_append_only BEFORE UPDATE OF todo_id, kind, old_value, new_value, created_at ON todo_events
+BEGIN
+    SELECT RAISE(ABORT, 'todo_events is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS todo_events_append_only;
+DROP INDEX IF EXISTS todo_events_todo_id_idx;
+DROP TABLE todo_events;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Todoにかけた時間の記録。ended_at が NULL のものは計測中のタイマー
+CREATE TABLE time_entries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    todo_id INTEGER NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    started_at DATETIME NOT NULL,
+    ended_at DATETIME
+);
+CREATE INDEX time_entries_todo_id_idx ON time_entries(todo_id);
+CREATE INDEX time_entries_user_id_idx ON time_entries(user_id, started_at);
+-- 計測中のタイマーは1ユーザーにつき1つまで
+CREATE UNIQUE INDEX time_entries_running_idx ON time_entries(user_id) WHERE ended_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS time_entries_running_idx;
+DROP INDEX IF EXISTS time_entries_user_id_idx;
+DROP INDEX IF EXISTS time_entries_todo_id_idx;
+DROP TABLE time_entries;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+-- リストごとのワークフローの状態（かんばんボードの列）。done の状態にあるTodoは完了として扱う
+CREATE TABLE list_statuses (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    list_id INTEGER NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    position INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (list_id, name)
+);
+
+-- 既存のリストには既定の状態を作る（新しいリストにはアプリで作る）
+INSERT INTO list_statuses (list_id, name, done, position)
+SELECT id, '未着手', FALSE, 0 FROM lists
+UNION ALL SELECT id, '進行中', FALSE, 1 FROM lists
+UNION ALL SELECT id, 'レビュー', FALSE, 2 FROM lists
+UNION ALL SELECT id, '完了', TRUE, 3 FROM lists;
+-- +goose StatementEnd
+-- +goose StatementBegin
+-- status_id が NULL のTodoは、未完了なら最初の未完了の状態に、完了なら最初の完了の状態にあるものとして扱う
+ALTER TABLE todos ADD COLUMN status_id INTEGER REFERENCES list_statuses(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE INDEX todos_status_id_idx ON todos(status_id);
+
+-- 一覧での完了の切り替えなどで完了状態と状態の done が食い違ったら、状態は完了状態から決め直す
+CREATE TRIGGER todos_status_follows_completed AFTER UPDATE OF completed ON todos
+WHEN NEW.status_id IS NOT NULL AND NEW.completed IS NOT (SELECT done FROM list_statuses WHERE id = NEW.status_id)
+BEGIN
+    UPDATE todos SET status_id = NULL WHERE id = NEW.id;
+END;
+
+-- 別のリストや受信箱へ移したら、元のリストの状態は外す
+CREATE TRIGGER todos_status_follows_list AFTER UPDATE OF list_id ON todos
+WHEN NEW.status_id IS NOT NULL AND NEW.list_id IS NOT (SELECT list_id FROM list_statuses WHERE id = NEW.status_id)
+BEGIN
+    UPDATE todos SET status_id = NULL WHERE id = NEW.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS todos_status_follows_list;
+DROP TRIGGER IF EXISTS todos_status_follows_completed;
+DROP INDEX IF EXISTS todos_status_id_idx;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE todos DROP COLUMN status_id;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP TABLE list_statuses;
+-- +goose StatementEnd
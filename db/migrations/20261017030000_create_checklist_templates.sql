@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- チェックリストのテンプレート。使うと項目をまとめてTodoとして作る
+CREATE TABLE checklist_templates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+-- テンプレートの項目。due_days は使った日から期限日までの日数（NULL なら期限なし）
+CREATE TABLE checklist_template_items (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    template_id INTEGER NOT NULL REFERENCES checklist_templates(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    due_days INTEGER CHECK (due_days >= 0),
+    position INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX checklist_template_items_template_id_idx ON checklist_template_items(template_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS checklist_template_items_template_id_idx;
+DROP TABLE checklist_template_items;
+DROP TABLE checklist_templates;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Todoの依存関係。todo_id のTodoは blocker_id のTodoが完了するまでブロックされる
+-- 循環する依存はアプリケーション側で追加前に拒否する
+CREATE TABLE todo_dependencies (
+    todo_id INTEGER NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    blocker_id INTEGER NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (todo_id, blocker_id),
+    CHECK (todo_id <> blocker_id)
+);
+CREATE INDEX todo_dependencies_blocker_id_idx ON todo_dependencies(blocker_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS todo_dependencies_blocker_id_idx;
+DROP TABLE todo_dependencies;
+-- +goose StatementEnd
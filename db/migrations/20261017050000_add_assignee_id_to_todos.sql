@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 担当者。リストのTodoにだけ、リストの所有者かメンバーを割り当てる
+ALTER TABLE todos ADD COLUMN assignee_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+-- +goose StatementBegin
+CREATE INDEX todos_assignee_id_idx ON todos(assignee_id);
+
+-- メンバーから外したら、そのリストのTodoの担当からも外す
+CREATE TRIGGER todos_assignee_follows_members AFTER DELETE ON list_members
+BEGIN
+    UPDATE todos SET assignee_id = NULL WHERE list_id = OLD.list_id AND assignee_id = OLD.user_id;
+END;
+
+-- 別のリストや受信箱へ移したら、移した先の所有者でもメンバーでもない担当者は外す
+CREATE TRIGGER todos_assignee_follows_list AFTER UPDATE OF list_id ON todos
+WHEN NEW.assignee_id IS NOT NULL AND (
+    NEW.list_id IS NULL
+    OR (NOT EXISTS (SELECT 1 FROM lists WHERE id = NEW.list_id AND user_id = NEW.assignee_id)
+        AND NOT EXISTS (SELECT 1 FROM list_members WHERE list_id = NEW.list_id AND user_id = NEW.assignee_id))
+)
+BEGIN
+    UPDATE todos SET assignee_id = NULL WHERE id = NEW.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS todos_assignee_follows_list;
+DROP TRIGGER IF EXISTS todos_assignee_follows_members;
+DROP INDEX IF EXISTS todos_assignee_id_idx;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE todos DROP COLUMN assignee_id;
+-- +goose StatementEnd
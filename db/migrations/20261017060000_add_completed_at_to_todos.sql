@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 完了日時（UTC）。未完了なら NULL。統計の完了日と完了までの時間に使う
+-- 更新日時は完了後の編集でも変わるので、完了したときの日時を別に残す
+ALTER TABLE todos ADD COLUMN completed_at DATETIME;
+-- +goose StatementEnd
+-- +goose StatementBegin
+-- 完了にした・未完了に戻したときに記録する。完了にする経路（一覧・一括操作・ボード・繰り返し）によらず同じように残す
+CREATE TRIGGER todos_completed_at_on_update AFTER UPDATE OF completed ON todos
+WHEN NEW.completed IS NOT OLD.completed
+BEGIN
+    UPDATE todos SET completed_at = CASE WHEN NEW.completed THEN CURRENT_TIMESTAMP END WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER todos_completed_at_on_insert AFTER INSERT ON todos
+WHEN NEW.completed AND NEW.completed_at IS NULL
+BEGIN
+    UPDATE todos SET completed_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+-- 完了済みのTodoは、履歴の最後の「完了にした」日時で埋める。履歴がなければ更新日時を使う
+-- DBが書いた "2006-01-02 15:04:05" 形式は datetime でそのまま読める
+-- アプリが書いた "2006-01-02 15:04:05.999 -0700 MST" 形式は "2006-01-02 15:04:05-07:00" に直してから datetime でUTCにする
+-- どちらとしても読めない値はそのまま残す
+UPDATE todos SET completed_at = (
+    SELECT COALESCE(
+        datetime(v),
+        datetime(substr(v, 1, 19) || substr(v, p, 3) || ':' || substr(v, p + 3, 2)),
+        v
+    )
+    FROM (SELECT v, instr(substr(v, 20), ' ') + 20 AS p FROM (SELECT COALESCE(
+        (SELECT MAX(e.created_at) FROM todo_events e
+         WHERE e.todo_id = todos.id AND e.kind = 'completed' AND e.new_value = 'true'),
+        todos.updated_at
+    ) AS v))
+)
+WHERE completed;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS todos_completed_at_on_insert;
+DROP TRIGGER IF EXISTS todos_completed_at_on_update;
+ALTER TABLE todos DROP COLUMN completed_at;
+-- +goose StatementEnd
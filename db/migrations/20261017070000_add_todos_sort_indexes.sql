@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Todo一覧は並び順の値とIDのキーセットでページを分けて読むので、
+-- 受信箱（ユーザーごと）とリストのそれぞれで、どの並び順でも索引でたどれるようにする
+-- IDはrowidなので索引の末尾に暗黙に含まれる
+-- 日時はアプリが書いた形式（time.Time.String()）とDBの既定値（CURRENT_TIMESTAMP）の形式が混ざっているので、
+-- 先頭の秒までの文字列で並べる（todoSortTime と同じ式）
+DROP INDEX IF EXISTS todos_user_id_list_id_created_at_idx;
+DROP INDEX IF EXISTS todos_list_id_created_at_idx;
+DROP INDEX IF EXISTS todos_list_id_position_idx;
+CREATE INDEX todos_user_id_list_id_created_at_idx ON todos(user_id, list_id, substr(created_at, 1, 19));
+CREATE INDEX todos_list_id_created_at_idx ON todos(list_id, substr(created_at, 1, 19));
+CREATE INDEX todos_user_id_list_id_updated_at_idx ON todos(user_id, list_id, substr(updated_at, 1, 19));
+CREATE INDEX todos_list_id_updated_at_idx ON todos(list_id, substr(updated_at, 1, 19));
+CREATE INDEX todos_user_id_list_id_due_at_idx ON todos(user_id, list_id, substr(due_at, 1, 19));
+CREATE INDEX todos_list_id_due_at_idx ON todos(list_id, substr(due_at, 1, 19));
+CREATE INDEX todos_user_id_list_id_priority_idx ON todos(user_id, list_id, priority);
+CREATE INDEX todos_list_id_priority_idx ON todos(list_id, priority);
+CREATE INDEX todos_user_id_list_id_title_idx ON todos(user_id, list_id, title);
+CREATE INDEX todos_list_id_title_idx ON todos(list_id, title);
+CREATE INDEX todos_user_id_list_id_position_idx ON todos(user_id, list_id, position);
+CREATE INDEX todos_list_id_position_idx ON todos(list_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS todos_list_id_position_idx;
+DROP INDEX IF EXISTS todos_user_id_list_id_position_idx;
+DROP INDEX IF EXISTS todos_list_id_title_idx;
+DROP INDEX IF EXISTS todos_user_id_list_id_title_idx;
+DROP INDEX IF EXISTS todos_list_id_priority_idx;
+DROP INDEX IF EXISTS todos_user_id_list_id_priority_idx;
+DROP INDEX IF EXISTS todos_list_id_due_at_idx;
+DROP INDEX IF EXISTS todos_user_id_list_id_due_at_idx;
+DROP INDEX IF EXISTS todos_list_id_updated_at_idx;
+DROP INDEX IF EXISTS todos_user_id_list_id_updated_at_idx;
+DROP INDEX IF EXISTS todos_list_id_created_at_idx;
+DROP INDEX IF EXISTS todos_user_id_list_id_created_at_idx;
+CREATE INDEX todos_list_id_position_idx ON todos(list_id, position);
+CREATE INDEX todos_user_id_list_id_created_at_idx ON todos(user_id, list_id, created_at);
+CREATE INDEX todos_list_id_created_at_idx ON todos(list_id, created_at);
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ChecklistTemplateItemErrors = &checklistTemplateItemErrors{
+	ErrUniquePkMainChecklistTemplateItems: &UniqueConstraintError{
+		schema:  "",
+		table:   "checklist_template_items",
+		columns: []string{"id"},
+		s:       "pk_main_checklist_template_items",
+	},
+}
+
+type checklistTemplateItemErrors struct {
+	ErrUniquePkMainChecklistTemplateItems *UniqueConstraintError
+}
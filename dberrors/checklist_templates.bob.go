@@ -0,0 +1,26 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ChecklistTemplateErrors = &checklistTemplateErrors{
+	ErrUniquePkMainChecklistTemplates: &UniqueConstraintError{
+		schema:  "",
+		table:   "checklist_templates",
+		columns: []string{"id"},
+		s:       "pk_main_checklist_templates",
+	},
+
+	ErrUniqueSqliteAutoindexChecklistTemplates1: &UniqueConstraintError{
+		schema:  "",
+		table:   "checklist_templates",
+		columns: []string{"user_id", "name"},
+		s:       "sqlite_autoindex_checklist_templates_1",
+	},
+}
+
+type checklistTemplateErrors struct {
+	ErrUniquePkMainChecklistTemplates *UniqueConstraintError
+
+	ErrUniqueSqliteAutoindexChecklistTemplates1 *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var CommentErrors = &commentErrors{
+	ErrUniquePkMainComments: &UniqueConstraintError{
+		schema:  "",
+		table:   "comments",
+		columns: []string{"id"},
+		s:       "pk_main_comments",
+	},
+}
+
+type commentErrors struct {
+	ErrUniquePkMainComments *UniqueConstraintError
+}
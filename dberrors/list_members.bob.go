@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ListMemberErrors = &listMemberErrors{
+	ErrUniquePkMainListMembers: &UniqueConstraintError{
+		schema:  "",
+		table:   "list_members",
+		columns: []string{"list_id", "user_id"},
+		s:       "pk_main_list_members",
+	},
+}
+
+type listMemberErrors struct {
+	ErrUniquePkMainListMembers *UniqueConstraintError
+}
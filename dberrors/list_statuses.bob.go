@@ -0,0 +1,26 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ListStatusErrors = &listStatusErrors{
+	ErrUniquePkMainListStatuses: &UniqueConstraintError{
+		schema:  "",
+		table:   "list_statuses",
+		columns: []string{"id"},
+		s:       "pk_main_list_statuses",
+	},
+
+	ErrUniqueSqliteAutoindexListStatuses1: &UniqueConstraintError{
+		schema:  "",
+		table:   "list_statuses",
+		columns: []string{"list_id", "name"},
+		s:       "sqlite_autoindex_list_statuses_1",
+	},
+}
+
+type listStatusErrors struct {
+	ErrUniquePkMainListStatuses *UniqueConstraintError
+
+	ErrUniqueSqliteAutoindexListStatuses1 *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ListErrors = &listErrors{
+	ErrUniquePkMainLists: &UniqueConstraintError{
+		schema:  "",
+		table:   "lists",
+		columns: []string{"id"},
+		s:       "pk_main_lists",
+	},
+}
+
+type listErrors struct {
+	ErrUniquePkMainLists *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var NotificationErrors = &notificationErrors{
+	ErrUniquePkMainNotifications: &UniqueConstraintError{
+		schema:  "",
+		table:   "notifications",
+		columns: []string{"id"},
+		s:       "pk_main_notifications",
+	},
+}
+
+type notificationErrors struct {
+	ErrUniquePkMainNotifications *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var TagErrors = &tagErrors{
+	ErrUniquePkMainTags: &UniqueConstraintError{
+		schema:  "",
+		table:   "tags",
+		columns: []string{"id"},
+		s:       "pk_main_tags",
+	},
+
+	ErrUniqueSqliteAutoindexTags1: &UniqueConstraintError{
+		schema:  "",
+		table:   "tags",
+		columns: []string{"user_id", "name"},
+		s:       "sqlite_autoindex_tags_1",
+	},
+}
+
+type tagErrors struct {
+	ErrUniquePkMainTags *UniqueConstraintError
+
+	ErrUniqueSqliteAutoindexTags1 *UniqueConstraintError
+}
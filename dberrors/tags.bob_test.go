@@ -0,0 +1,109 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	factory "github.com/kimihito-sandbox/gostack-test/factory"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+func TestTagUniqueConstraintErrors(t *testing.T) {
+	if testDB == nil {
+		t.Skip("No database connection provided")
+	}
+
+	f := factory.New()
+	tests := []struct {
+		name         string
+		expectedErr  *UniqueConstraintError
+		conflictMods func(context.Context, *testing.T, bob.Executor, *models.Tag) factory.TagModSlice
+	}{
+		{
+			name:        "ErrUniquePkMainTags",
+			expectedErr: TagErrors.ErrUniquePkMainTags,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.Tag) factory.TagModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.TagModSlice, 0, 1)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewTagWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.TagModSlice{
+					factory.TagMods.ID(obj.ID),
+				}
+			},
+		},
+		{
+			name:        "ErrUniqueSqliteAutoindexTags1",
+			expectedErr: TagErrors.ErrUniqueSqliteAutoindexTags1,
+			conflictMods: func(ctx context.Context, t *testing.T, exec bob.Executor, obj *models.Tag) factory.TagModSlice {
+				shouldUpdate := false
+				updateMods := make(factory.TagModSlice, 0, 2)
+
+				if shouldUpdate {
+					if err := obj.Update(ctx, exec, f.NewTagWithContext(ctx, updateMods...).BuildSetter()); err != nil {
+						t.Fatalf("Error updating object: %v", err)
+					}
+				}
+
+				return factory.TagModSlice{
+					factory.TagMods.UserID(obj.UserID),
+					factory.TagMods.Name(obj.Name),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			t.Cleanup(cancel)
+
+			tx, err := testDB.Begin(ctx)
+			if err != nil {
+				t.Fatalf("Couldn't start database transaction: %v", err)
+			}
+
+			defer func() {
+				if err := tx.Rollback(ctx); err != nil {
+					t.Fatalf("Error rolling back transaction: %v", err)
+				}
+			}()
+
+			var exec bob.Executor = tx
+
+			obj, err := f.NewTagWithContext(ctx, factory.TagMods.WithParentsCascading()).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			obj2, err := f.NewTagWithContext(ctx).Create(ctx, exec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = obj2.Update(ctx, exec, f.NewTagWithContext(ctx, tt.conflictMods(ctx, t, exec, obj)...).BuildSetter())
+			if !errors.Is(ErrUniqueConstraint, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !errors.Is(tt.expectedErr, err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+			if !ErrUniqueConstraint.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.name, err)
+			}
+			if !tt.expectedErr.Is(err) {
+				t.Fatalf("Expected: %s, Got: %v", tt.expectedErr.Error(), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var TimeEntryErrors = &timeEntryErrors{
+	ErrUniquePkMainTimeEntries: &UniqueConstraintError{
+		schema:  "",
+		table:   "time_entries",
+		columns: []string{"id"},
+		s:       "pk_main_time_entries",
+	},
+}
+
+type timeEntryErrors struct {
+	ErrUniquePkMainTimeEntries *UniqueConstraintError
+}
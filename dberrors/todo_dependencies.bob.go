@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var TodoDependencyErrors = &todoDependencyErrors{
+	ErrUniquePkMainTodoDependencies: &UniqueConstraintError{
+		schema:  "",
+		table:   "todo_dependencies",
+		columns: []string{"todo_id", "blocker_id"},
+		s:       "pk_main_todo_dependencies",
+	},
+}
+
+type todoDependencyErrors struct {
+	ErrUniquePkMainTodoDependencies *UniqueConstraintError
+}
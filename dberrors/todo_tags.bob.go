@@ -0,0 +1,17 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var TodoTagErrors = &todoTagErrors{
+	ErrUniquePkMainTodoTags: &UniqueConstraintError{
+		schema:  "",
+		table:   "todo_tags",
+		columns: []string{"todo_id", "tag_id"},
+		s:       "pk_main_todo_tags",
+	},
+}
+
+type todoTagErrors struct {
+	ErrUniquePkMainTodoTags *UniqueConstraintError
+}
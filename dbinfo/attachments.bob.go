@@ -0,0 +1,224 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Attachments = Table[
+	attachmentColumns,
+	attachmentIndexes,
+	attachmentForeignKeys,
+	attachmentUniques,
+	attachmentChecks,
+]{
+	Schema: "",
+	Name:   "attachments",
+	Columns: attachmentColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TodoID: column{
+			Name:      "todo_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Filename: column{
+			Name:      "filename",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ContentType: column{
+			Name:      "content_type",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Size: column{
+			Name:      "size",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Sha256: column{
+			Name:      "sha256",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		HasThumbnail: column{
+			Name:      "has_thumbnail",
+			DBType:    "BOOLEAN",
+			Default:   "FALSE",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: attachmentIndexes{
+		PKMainAttachments: index{
+			Type: "pk",
+			Name: "pk_main_attachments",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		AttachmentsSha256Idx: index{
+			Type: "c",
+			Name: "attachments_sha256_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "sha256",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		AttachmentsTodoIDIdx: index{
+			Type: "c",
+			Name: "attachments_todo_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "todo_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_attachments",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: attachmentForeignKeys{
+		FKAttachments0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_attachments_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FKAttachments1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_attachments_1",
+				Columns: []string{"todo_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type attachmentColumns struct {
+	ID           column
+	TodoID       column
+	UserID       column
+	Filename     column
+	ContentType  column
+	Size         column
+	Sha256       column
+	HasThumbnail column
+	CreatedAt    column
+}
+
+func (c attachmentColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.TodoID, c.UserID, c.Filename, c.ContentType, c.Size, c.Sha256, c.HasThumbnail, c.CreatedAt,
+	}
+}
+
+type attachmentIndexes struct {
+	PKMainAttachments    index
+	AttachmentsSha256Idx index
+	AttachmentsTodoIDIdx index
+}
+
+func (i attachmentIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainAttachments, i.AttachmentsSha256Idx, i.AttachmentsTodoIDIdx,
+	}
+}
+
+type attachmentForeignKeys struct {
+	FKAttachments0 foreignKey
+	FKAttachments1 foreignKey
+}
+
+func (f attachmentForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKAttachments0, f.FKAttachments1,
+	}
+}
+
+type attachmentUniques struct{}
+
+func (u attachmentUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type attachmentChecks struct{}
+
+func (c attachmentChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,164 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var ChecklistTemplateItems = Table[
+	checklistTemplateItemColumns,
+	checklistTemplateItemIndexes,
+	checklistTemplateItemForeignKeys,
+	checklistTemplateItemUniques,
+	checklistTemplateItemChecks,
+]{
+	Schema: "",
+	Name:   "checklist_template_items",
+	Columns: checklistTemplateItemColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TemplateID: column{
+			Name:      "template_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Title: column{
+			Name:      "title",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		DueDays: column{
+			Name:      "due_days",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Position: column{
+			Name:      "position",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: checklistTemplateItemIndexes{
+		PKMainChecklistTemplateItems: index{
+			Type: "pk",
+			Name: "pk_main_checklist_template_items",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		ChecklistTemplateItemsTemplateIDIdx: index{
+			Type: "c",
+			Name: "checklist_template_items_template_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "template_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "position",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_checklist_template_items",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: checklistTemplateItemForeignKeys{
+		FKChecklistTemplateItems0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_checklist_template_items_0",
+				Columns: []string{"template_id"},
+				Comment: "",
+			},
+			ForeignTable:   "checklist_templates",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type checklistTemplateItemColumns struct {
+	ID         column
+	TemplateID column
+	Title      column
+	DueDays    column
+	Position   column
+}
+
+func (c checklistTemplateItemColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.TemplateID, c.Title, c.DueDays, c.Position,
+	}
+}
+
+type checklistTemplateItemIndexes struct {
+	PKMainChecklistTemplateItems        index
+	ChecklistTemplateItemsTemplateIDIdx index
+}
+
+func (i checklistTemplateItemIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainChecklistTemplateItems, i.ChecklistTemplateItemsTemplateIDIdx,
+	}
+}
+
+type checklistTemplateItemForeignKeys struct {
+	FKChecklistTemplateItems0 foreignKey
+}
+
+func (f checklistTemplateItemForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKChecklistTemplateItems0,
+	}
+}
+
+type checklistTemplateItemUniques struct{}
+
+func (u checklistTemplateItemUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type checklistTemplateItemChecks struct{}
+
+func (c checklistTemplateItemChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,175 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var ChecklistTemplates = Table[
+	checklistTemplateColumns,
+	checklistTemplateIndexes,
+	checklistTemplateForeignKeys,
+	checklistTemplateUniques,
+	checklistTemplateChecks,
+]{
+	Schema: "",
+	Name:   "checklist_templates",
+	Columns: checklistTemplateColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: checklistTemplateIndexes{
+		PKMainChecklistTemplates: index{
+			Type: "pk",
+			Name: "pk_main_checklist_templates",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexChecklistTemplates1: index{
+			Type: "u",
+			Name: "sqlite_autoindex_checklist_templates_1",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "name",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_checklist_templates",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: checklistTemplateForeignKeys{
+		FKChecklistTemplates0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_checklist_templates_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: checklistTemplateUniques{
+		SqliteAutoindexChecklistTemplates1: constraint{
+			Name:    "sqlite_autoindex_checklist_templates_1",
+			Columns: []string{"user_id", "name"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type checklistTemplateColumns struct {
+	ID        column
+	UserID    column
+	Name      column
+	CreatedAt column
+	UpdatedAt column
+}
+
+func (c checklistTemplateColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.Name, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type checklistTemplateIndexes struct {
+	PKMainChecklistTemplates           index
+	SqliteAutoindexChecklistTemplates1 index
+}
+
+func (i checklistTemplateIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainChecklistTemplates, i.SqliteAutoindexChecklistTemplates1,
+	}
+}
+
+type checklistTemplateForeignKeys struct {
+	FKChecklistTemplates0 foreignKey
+}
+
+func (f checklistTemplateForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKChecklistTemplates0,
+	}
+}
+
+type checklistTemplateUniques struct {
+	SqliteAutoindexChecklistTemplates1 constraint
+}
+
+func (u checklistTemplateUniques) AsSlice() []constraint {
+	return []constraint{
+		u.SqliteAutoindexChecklistTemplates1,
+	}
+}
+
+type checklistTemplateChecks struct{}
+
+func (c checklistTemplateChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,189 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Comments = Table[
+	commentColumns,
+	commentIndexes,
+	commentForeignKeys,
+	commentUniques,
+	commentChecks,
+]{
+	Schema: "",
+	Name:   "comments",
+	Columns: commentColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TodoID: column{
+			Name:      "todo_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ParentID: column{
+			Name:      "parent_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Body: column{
+			Name:      "body",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: commentIndexes{
+		PKMainComments: index{
+			Type: "pk",
+			Name: "pk_main_comments",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		CommentsTodoIDIdx: index{
+			Type: "c",
+			Name: "comments_todo_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "todo_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_comments",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: commentForeignKeys{
+		FKComments0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_comments_0",
+				Columns: []string{"parent_id"},
+				Comment: "",
+			},
+			ForeignTable:   "comments",
+			ForeignColumns: []string{"id"},
+		},
+		FKComments1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_comments_1",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FKComments2: foreignKey{
+			constraint: constraint{
+				Name:    "fk_comments_2",
+				Columns: []string{"todo_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type commentColumns struct {
+	ID        column
+	TodoID    column
+	UserID    column
+	ParentID  column
+	Body      column
+	CreatedAt column
+}
+
+func (c commentColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.TodoID, c.UserID, c.ParentID, c.Body, c.CreatedAt,
+	}
+}
+
+type commentIndexes struct {
+	PKMainComments    index
+	CommentsTodoIDIdx index
+}
+
+func (i commentIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainComments, i.CommentsTodoIDIdx,
+	}
+}
+
+type commentForeignKeys struct {
+	FKComments0 foreignKey
+	FKComments1 foreignKey
+	FKComments2 foreignKey
+}
+
+func (f commentForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKComments0, f.FKComments1, f.FKComments2,
+	}
+}
+
+type commentUniques struct{}
+
+func (u commentUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type commentChecks struct{}
+
+func (c commentChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,174 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var ListMembers = Table[
+	listMemberColumns,
+	listMemberIndexes,
+	listMemberForeignKeys,
+	listMemberUniques,
+	listMemberChecks,
+]{
+	Schema: "",
+	Name:   "list_members",
+	Columns: listMemberColumns{
+		ListID: column{
+			Name:      "list_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Role: column{
+			Name:      "role",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: listMemberIndexes{
+		ListMembersUserIDIdx: index{
+			Type: "c",
+			Name: "list_members_user_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexListMembers1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_list_members_1",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_list_members",
+		Columns: []string{"list_id", "user_id"},
+		Comment: "",
+	},
+	ForeignKeys: listMemberForeignKeys{
+		FKListMembers0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_list_members_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FKListMembers1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_list_members_1",
+				Columns: []string{"list_id"},
+				Comment: "",
+			},
+			ForeignTable:   "lists",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type listMemberColumns struct {
+	ListID    column
+	UserID    column
+	Role      column
+	CreatedAt column
+	UpdatedAt column
+}
+
+func (c listMemberColumns) AsSlice() []column {
+	return []column{
+		c.ListID, c.UserID, c.Role, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type listMemberIndexes struct {
+	ListMembersUserIDIdx        index
+	SqliteAutoindexListMembers1 index
+}
+
+func (i listMemberIndexes) AsSlice() []index {
+	return []index{
+		i.ListMembersUserIDIdx, i.SqliteAutoindexListMembers1,
+	}
+}
+
+type listMemberForeignKeys struct {
+	FKListMembers0 foreignKey
+	FKListMembers1 foreignKey
+}
+
+func (f listMemberForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKListMembers0, f.FKListMembers1,
+	}
+}
+
+type listMemberUniques struct{}
+
+func (u listMemberUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type listMemberChecks struct{}
+
+func (c listMemberChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,175 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var ListStatuses = Table[
+	listStatusColumns,
+	listStatusIndexes,
+	listStatusForeignKeys,
+	listStatusUniques,
+	listStatusChecks,
+]{
+	Schema: "",
+	Name:   "list_statuses",
+	Columns: listStatusColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ListID: column{
+			Name:      "list_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Done: column{
+			Name:      "done",
+			DBType:    "BOOLEAN",
+			Default:   "FALSE",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Position: column{
+			Name:      "position",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: listStatusIndexes{
+		PKMainListStatuses: index{
+			Type: "pk",
+			Name: "pk_main_list_statuses",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexListStatuses1: index{
+			Type: "u",
+			Name: "sqlite_autoindex_list_statuses_1",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "name",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_list_statuses",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: listStatusForeignKeys{
+		FKListStatuses0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_list_statuses_0",
+				Columns: []string{"list_id"},
+				Comment: "",
+			},
+			ForeignTable:   "lists",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: listStatusUniques{
+		SqliteAutoindexListStatuses1: constraint{
+			Name:    "sqlite_autoindex_list_statuses_1",
+			Columns: []string{"list_id", "name"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type listStatusColumns struct {
+	ID       column
+	ListID   column
+	Name     column
+	Done     column
+	Position column
+}
+
+func (c listStatusColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.ListID, c.Name, c.Done, c.Position,
+	}
+}
+
+type listStatusIndexes struct {
+	PKMainListStatuses           index
+	SqliteAutoindexListStatuses1 index
+}
+
+func (i listStatusIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainListStatuses, i.SqliteAutoindexListStatuses1,
+	}
+}
+
+type listStatusForeignKeys struct {
+	FKListStatuses0 foreignKey
+}
+
+func (f listStatusForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKListStatuses0,
+	}
+}
+
+type listStatusUniques struct {
+	SqliteAutoindexListStatuses1 constraint
+}
+
+func (u listStatusUniques) AsSlice() []constraint {
+	return []constraint{
+		u.SqliteAutoindexListStatuses1,
+	}
+}
+
+type listStatusChecks struct{}
+
+func (c listStatusChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,169 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Lists = Table[
+	listColumns,
+	listIndexes,
+	listForeignKeys,
+	listUniques,
+	listChecks,
+]{
+	Schema: "",
+	Name:   "lists",
+	Columns: listColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Archived: column{
+			Name:      "archived",
+			DBType:    "BOOLEAN",
+			Default:   "FALSE",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: listIndexes{
+		PKMainLists: index{
+			Type: "pk",
+			Name: "pk_main_lists",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		ListsUserIDIdx: index{
+			Type: "c",
+			Name: "lists_user_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_lists",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: listForeignKeys{
+		FKLists0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_lists_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type listColumns struct {
+	ID        column
+	UserID    column
+	Name      column
+	Archived  column
+	CreatedAt column
+	UpdatedAt column
+}
+
+func (c listColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.Name, c.Archived, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type listIndexes struct {
+	PKMainLists    index
+	ListsUserIDIdx index
+}
+
+func (i listIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainLists, i.ListsUserIDIdx,
+	}
+}
+
+type listForeignKeys struct {
+	FKLists0 foreignKey
+}
+
+func (f listForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKLists0,
+	}
+}
+
+type listUniques struct{}
+
+func (u listUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type listChecks struct{}
+
+func (c listChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,224 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Notifications = Table[
+	notificationColumns,
+	notificationIndexes,
+	notificationForeignKeys,
+	notificationUniques,
+	notificationChecks,
+]{
+	Schema: "",
+	Name:   "notifications",
+	Columns: notificationColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ActorID: column{
+			Name:      "actor_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Kind: column{
+			Name:      "kind",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TodoID: column{
+			Name:      "todo_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CommentID: column{
+			Name:      "comment_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ReadAt: column{
+			Name:      "read_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: notificationIndexes{
+		PKMainNotifications: index{
+			Type: "pk",
+			Name: "pk_main_notifications",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		NotificationsUserIDIdx: index{
+			Type: "c",
+			Name: "notifications_user_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "read_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_notifications",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: notificationForeignKeys{
+		FKNotifications0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_notifications_0",
+				Columns: []string{"comment_id"},
+				Comment: "",
+			},
+			ForeignTable:   "comments",
+			ForeignColumns: []string{"id"},
+		},
+		FKNotifications1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_notifications_1",
+				Columns: []string{"todo_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+		FKNotifications2: foreignKey{
+			constraint: constraint{
+				Name:    "fk_notifications_2",
+				Columns: []string{"actor_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FKNotifications3: foreignKey{
+			constraint: constraint{
+				Name:    "fk_notifications_3",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type notificationColumns struct {
+	ID        column
+	UserID    column
+	ActorID   column
+	Kind      column
+	TodoID    column
+	CommentID column
+	ReadAt    column
+	CreatedAt column
+}
+
+func (c notificationColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.ActorID, c.Kind, c.TodoID, c.CommentID, c.ReadAt, c.CreatedAt,
+	}
+}
+
+type notificationIndexes struct {
+	PKMainNotifications    index
+	NotificationsUserIDIdx index
+}
+
+func (i notificationIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainNotifications, i.NotificationsUserIDIdx,
+	}
+}
+
+type notificationForeignKeys struct {
+	FKNotifications0 foreignKey
+	FKNotifications1 foreignKey
+	FKNotifications2 foreignKey
+	FKNotifications3 foreignKey
+}
+
+func (f notificationForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKNotifications0, f.FKNotifications1, f.FKNotifications2, f.FKNotifications3,
+	}
+}
+
+type notificationUniques struct{}
+
+func (u notificationUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type notificationChecks struct{}
+
+func (c notificationChecks) AsSlice() []check {
+	return []check{}
+}
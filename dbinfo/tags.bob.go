@@ -0,0 +1,185 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Tags = Table[
+	tagColumns,
+	tagIndexes,
+	tagForeignKeys,
+	tagUniques,
+	tagChecks,
+]{
+	Schema: "",
+	Name:   "tags",
+	Columns: tagColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Name: column{
+			Name:      "name",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Color: column{
+			Name:      "color",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UpdatedAt: column{
+			Name:      "updated_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: tagIndexes{
+		PKMainTags: index{
+			Type: "pk",
+			Name: "pk_main_tags",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexTags1: index{
+			Type: "u",
+			Name: "sqlite_autoindex_tags_1",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "name",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_tags",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: tagForeignKeys{
+		FKTags0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_tags_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: tagUniques{
+		SqliteAutoindexTags1: constraint{
+			Name:    "sqlite_autoindex_tags_1",
+			Columns: []string{"user_id", "name"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type tagColumns struct {
+	ID        column
+	UserID    column
+	Name      column
+	Color     column
+	CreatedAt column
+	UpdatedAt column
+}
+
+func (c tagColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.Name, c.Color, c.CreatedAt, c.UpdatedAt,
+	}
+}
+
+type tagIndexes struct {
+	PKMainTags           index
+	SqliteAutoindexTags1 index
+}
+
+func (i tagIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainTags, i.SqliteAutoindexTags1,
+	}
+}
+
+type tagForeignKeys struct {
+	FKTags0 foreignKey
+}
+
+func (f tagForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKTags0,
+	}
+}
+
+type tagUniques struct {
+	SqliteAutoindexTags1 constraint
+}
+
+func (u tagUniques) AsSlice() []constraint {
+	return []constraint{
+		u.SqliteAutoindexTags1,
+	}
+}
+
+type tagChecks struct{}
+
+func (c tagChecks) AsSlice() []check {
+	return []check{}
+}
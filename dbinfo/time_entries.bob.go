@@ -0,0 +1,204 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var TimeEntries = Table[
+	timeEntryColumns,
+	timeEntryIndexes,
+	timeEntryForeignKeys,
+	timeEntryUniques,
+	timeEntryChecks,
+]{
+	Schema: "",
+	Name:   "time_entries",
+	Columns: timeEntryColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TodoID: column{
+			Name:      "todo_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		StartedAt: column{
+			Name:      "started_at",
+			DBType:    "DATETIME",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		EndedAt: column{
+			Name:      "ended_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: timeEntryIndexes{
+		PKMainTimeEntries: index{
+			Type: "pk",
+			Name: "pk_main_time_entries",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		TimeEntriesRunningIdx: index{
+			Type: "c",
+			Name: "time_entries_running_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: true,
+		},
+		TimeEntriesUserIDIdx: index{
+			Type: "c",
+			Name: "time_entries_user_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "started_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TimeEntriesTodoIDIdx: index{
+			Type: "c",
+			Name: "time_entries_todo_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "todo_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_time_entries",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: timeEntryForeignKeys{
+		FKTimeEntries0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_time_entries_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FKTimeEntries1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_time_entries_1",
+				Columns: []string{"todo_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type timeEntryColumns struct {
+	ID        column
+	TodoID    column
+	UserID    column
+	StartedAt column
+	EndedAt   column
+}
+
+func (c timeEntryColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.TodoID, c.UserID, c.StartedAt, c.EndedAt,
+	}
+}
+
+type timeEntryIndexes struct {
+	PKMainTimeEntries     index
+	TimeEntriesRunningIdx index
+	TimeEntriesUserIDIdx  index
+	TimeEntriesTodoIDIdx  index
+}
+
+func (i timeEntryIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainTimeEntries, i.TimeEntriesRunningIdx, i.TimeEntriesUserIDIdx, i.TimeEntriesTodoIDIdx,
+	}
+}
+
+type timeEntryForeignKeys struct {
+	FKTimeEntries0 foreignKey
+	FKTimeEntries1 foreignKey
+}
+
+func (f timeEntryForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKTimeEntries0, f.FKTimeEntries1,
+	}
+}
+
+type timeEntryUniques struct{}
+
+func (u timeEntryUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type timeEntryChecks struct{}
+
+func (c timeEntryChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,154 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var TodoDependencies = Table[
+	todoDependencyColumns,
+	todoDependencyIndexes,
+	todoDependencyForeignKeys,
+	todoDependencyUniques,
+	todoDependencyChecks,
+]{
+	Schema: "",
+	Name:   "todo_dependencies",
+	Columns: todoDependencyColumns{
+		TodoID: column{
+			Name:      "todo_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BlockerID: column{
+			Name:      "blocker_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: todoDependencyIndexes{
+		TodoDependenciesBlockerIDIdx: index{
+			Type: "c",
+			Name: "todo_dependencies_blocker_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "blocker_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexTodoDependencies1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_todo_dependencies_1",
+			Columns: []indexColumn{
+				{
+					Name:         "todo_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "blocker_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_todo_dependencies",
+		Columns: []string{"todo_id", "blocker_id"},
+		Comment: "",
+	},
+	ForeignKeys: todoDependencyForeignKeys{
+		FKTodoDependencies0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todo_dependencies_0",
+				Columns: []string{"blocker_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+		FKTodoDependencies1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todo_dependencies_1",
+				Columns: []string{"todo_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type todoDependencyColumns struct {
+	TodoID    column
+	BlockerID column
+	CreatedAt column
+}
+
+func (c todoDependencyColumns) AsSlice() []column {
+	return []column{
+		c.TodoID, c.BlockerID, c.CreatedAt,
+	}
+}
+
+type todoDependencyIndexes struct {
+	TodoDependenciesBlockerIDIdx     index
+	SqliteAutoindexTodoDependencies1 index
+}
+
+func (i todoDependencyIndexes) AsSlice() []index {
+	return []index{
+		i.TodoDependenciesBlockerIDIdx, i.SqliteAutoindexTodoDependencies1,
+	}
+}
+
+type todoDependencyForeignKeys struct {
+	FKTodoDependencies0 foreignKey
+	FKTodoDependencies1 foreignKey
+}
+
+func (f todoDependencyForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKTodoDependencies0, f.FKTodoDependencies1,
+	}
+}
+
+type todoDependencyUniques struct{}
+
+func (u todoDependencyUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type todoDependencyChecks struct{}
+
+func (c todoDependencyChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,194 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var TodoEvents = Table[
+	todoEventColumns,
+	todoEventIndexes,
+	todoEventForeignKeys,
+	todoEventUniques,
+	todoEventChecks,
+]{
+	Schema: "",
+	Name:   "todo_events",
+	Columns: todoEventColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "INTEGER",
+			Default:   "auto_increment",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TodoID: column{
+			Name:      "todo_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Kind: column{
+			Name:      "kind",
+			DBType:    "TEXT",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OldValue: column{
+			Name:      "old_value",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		NewValue: column{
+			Name:      "new_value",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "DATETIME",
+			Default:   "CURRENT_TIMESTAMP",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: todoEventIndexes{
+		PKMainTodoEvents: index{
+			Type: "pk",
+			Name: "pk_main_todo_events",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+		TodoEventsTodoIDIdx: index{
+			Type: "c",
+			Name: "todo_events_todo_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "todo_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_todo_events",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: todoEventForeignKeys{
+		FKTodoEvents0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todo_events_0",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FKTodoEvents1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todo_events_1",
+				Columns: []string{"todo_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type todoEventColumns struct {
+	ID        column
+	TodoID    column
+	UserID    column
+	Kind      column
+	OldValue  column
+	NewValue  column
+	CreatedAt column
+}
+
+func (c todoEventColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.TodoID, c.UserID, c.Kind, c.OldValue, c.NewValue, c.CreatedAt,
+	}
+}
+
+type todoEventIndexes struct {
+	PKMainTodoEvents    index
+	TodoEventsTodoIDIdx index
+}
+
+func (i todoEventIndexes) AsSlice() []index {
+	return []index{
+		i.PKMainTodoEvents, i.TodoEventsTodoIDIdx,
+	}
+}
+
+type todoEventForeignKeys struct {
+	FKTodoEvents0 foreignKey
+	FKTodoEvents1 foreignKey
+}
+
+func (f todoEventForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKTodoEvents0, f.FKTodoEvents1,
+	}
+}
+
+type todoEventUniques struct{}
+
+func (u todoEventUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type todoEventChecks struct{}
+
+func (c todoEventChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,144 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var TodoTags = Table[
+	todoTagColumns,
+	todoTagIndexes,
+	todoTagForeignKeys,
+	todoTagUniques,
+	todoTagChecks,
+]{
+	Schema: "",
+	Name:   "todo_tags",
+	Columns: todoTagColumns{
+		TodoID: column{
+			Name:      "todo_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TagID: column{
+			Name:      "tag_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: todoTagIndexes{
+		TodoTagsTagIDIdx: index{
+			Type: "c",
+			Name: "todo_tags_tag_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "tag_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		SqliteAutoindexTodoTags1: index{
+			Type: "pk",
+			Name: "sqlite_autoindex_todo_tags_1",
+			Columns: []indexColumn{
+				{
+					Name:         "todo_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "tag_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  true,
+			Comment: "",
+			Partial: false,
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "pk_main_todo_tags",
+		Columns: []string{"todo_id", "tag_id"},
+		Comment: "",
+	},
+	ForeignKeys: todoTagForeignKeys{
+		FKTodoTags0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todo_tags_0",
+				Columns: []string{"tag_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tags",
+			ForeignColumns: []string{"id"},
+		},
+		FKTodoTags1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todo_tags_1",
+				Columns: []string{"todo_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type todoTagColumns struct {
+	TodoID column
+	TagID  column
+}
+
+func (c todoTagColumns) AsSlice() []column {
+	return []column{
+		c.TodoID, c.TagID,
+	}
+}
+
+type todoTagIndexes struct {
+	TodoTagsTagIDIdx         index
+	SqliteAutoindexTodoTags1 index
+}
+
+func (i todoTagIndexes) AsSlice() []index {
+	return []index{
+		i.TodoTagsTagIDIdx, i.SqliteAutoindexTodoTags1,
+	}
+}
+
+type todoTagForeignKeys struct {
+	FKTodoTags0 foreignKey
+	FKTodoTags1 foreignKey
+}
+
+func (f todoTagForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FKTodoTags0, f.FKTodoTags1,
+	}
+}
+
+type todoTagUniques struct{}
+
+func (u todoTagUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type todoTagChecks struct{}
+
+func (c todoTagChecks) AsSlice() []check {
+	return []check{}
+}
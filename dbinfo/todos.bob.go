@@ -24,6 +24,15 @@ var Todos = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "INTEGER",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 		Title: column{
 			Name:      "title",
 			DBType:    "TEXT",
@@ -60,6 +69,105 @@ var Todos = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		ListID: column{
+			Name:      "list_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		DueAt: column{
+			Name:      "due_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Priority: column{
+			Name:      "priority",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ParentID: column{
+			Name:      "parent_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Recurrence: column{
+			Name:      "recurrence",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Notes: column{
+			Name:      "notes",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Position: column{
+			Name:      "position",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		DeletedAt: column{
+			Name:      "deleted_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		StatusID: column{
+			Name:      "status_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		AssigneeID: column{
+			Name:      "assignee_id",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CompletedAt: column{
+			Name:      "completed_at",
+			DBType:    "DATETIME",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: todoIndexes{
 		PKMainTodos: index{
@@ -76,44 +184,486 @@ var Todos = Table[
 			Comment: "",
 			Partial: false,
 		},
+		TodosListIDPositionIdx: index{
+			Type: "c",
+			Name: "todos_list_id_position_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "position",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosUserIDListIDPositionIdx: index{
+			Type: "c",
+			Name: "todos_user_id_list_id_position_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "position",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosListIDTitleIdx: index{
+			Type: "c",
+			Name: "todos_list_id_title_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "title",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosUserIDListIDTitleIdx: index{
+			Type: "c",
+			Name: "todos_user_id_list_id_title_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "title",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosListIDPriorityIdx: index{
+			Type: "c",
+			Name: "todos_list_id_priority_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "priority",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosUserIDListIDPriorityIdx: index{
+			Type: "c",
+			Name: "todos_user_id_list_id_priority_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "priority",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosListIDDueAtIdx: index{
+			Type: "c",
+			Name: "todos_list_id_due_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "substr(due_at, 1, 19)",
+					Desc:         null.FromCond(false, true),
+					IsExpression: true,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosUserIDListIDDueAtIdx: index{
+			Type: "c",
+			Name: "todos_user_id_list_id_due_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "substr(due_at, 1, 19)",
+					Desc:         null.FromCond(false, true),
+					IsExpression: true,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosListIDUpdatedAtIdx: index{
+			Type: "c",
+			Name: "todos_list_id_updated_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "substr(updated_at, 1, 19)",
+					Desc:         null.FromCond(false, true),
+					IsExpression: true,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosUserIDListIDUpdatedAtIdx: index{
+			Type: "c",
+			Name: "todos_user_id_list_id_updated_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "substr(updated_at, 1, 19)",
+					Desc:         null.FromCond(false, true),
+					IsExpression: true,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosListIDCreatedAtIdx: index{
+			Type: "c",
+			Name: "todos_list_id_created_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "substr(created_at, 1, 19)",
+					Desc:         null.FromCond(false, true),
+					IsExpression: true,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosUserIDListIDCreatedAtIdx: index{
+			Type: "c",
+			Name: "todos_user_id_list_id_created_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "substr(created_at, 1, 19)",
+					Desc:         null.FromCond(false, true),
+					IsExpression: true,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosAssigneeIDIdx: index{
+			Type: "c",
+			Name: "todos_assignee_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "assignee_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosStatusIDIdx: index{
+			Type: "c",
+			Name: "todos_status_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "status_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosDeletedAtIdx: index{
+			Type: "c",
+			Name: "todos_deleted_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "deleted_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosParentIDIdx: index{
+			Type: "c",
+			Name: "todos_parent_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "parent_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosDueAtIdx: index{
+			Type: "c",
+			Name: "todos_due_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "due_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosListIDIdx: index{
+			Type: "c",
+			Name: "todos_list_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "list_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
+		TodosUserIDIdx: index{
+			Type: "c",
+			Name: "todos_user_id_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "pk_main_todos",
 		Columns: []string{"id"},
 		Comment: "",
 	},
+	ForeignKeys: todoForeignKeys{
+		FKTodos0: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todos_0",
+				Columns: []string{"assignee_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FKTodos1: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todos_1",
+				Columns: []string{"status_id"},
+				Comment: "",
+			},
+			ForeignTable:   "list_statuses",
+			ForeignColumns: []string{"id"},
+		},
+		FKTodos2: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todos_2",
+				Columns: []string{"parent_id"},
+				Comment: "",
+			},
+			ForeignTable:   "todos",
+			ForeignColumns: []string{"id"},
+		},
+		FKTodos3: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todos_3",
+				Columns: []string{"list_id"},
+				Comment: "",
+			},
+			ForeignTable:   "lists",
+			ForeignColumns: []string{"id"},
+		},
+		FKTodos4: foreignKey{
+			constraint: constraint{
+				Name:    "fk_todos_4",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
 
 	Comment: "",
 }
 
 type todoColumns struct {
-	ID        column
-	Title     column
-	Completed column
-	CreatedAt column
-	UpdatedAt column
+	ID          column
+	UserID      column
+	Title       column
+	Completed   column
+	CreatedAt   column
+	UpdatedAt   column
+	ListID      column
+	DueAt       column
+	Priority    column
+	ParentID    column
+	Recurrence  column
+	Notes       column
+	Position    column
+	DeletedAt   column
+	StatusID    column
+	AssigneeID  column
+	CompletedAt column
 }
 
 func (c todoColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.Title, c.Completed, c.CreatedAt, c.UpdatedAt,
+		c.ID, c.UserID, c.Title, c.Completed, c.CreatedAt, c.UpdatedAt, c.ListID, c.DueAt, c.Priority, c.ParentID, c.Recurrence, c.Notes, c.Position, c.DeletedAt, c.StatusID, c.AssigneeID, c.CompletedAt,
 	}
 }
 
 type todoIndexes struct {
-	PKMainTodos index
+	PKMainTodos                   index
+	TodosListIDPositionIdx        index
+	TodosUserIDListIDPositionIdx  index
+	TodosListIDTitleIdx           index
+	TodosUserIDListIDTitleIdx     index
+	TodosListIDPriorityIdx        index
+	TodosUserIDListIDPriorityIdx  index
+	TodosListIDDueAtIdx           index
+	TodosUserIDListIDDueAtIdx     index
+	TodosListIDUpdatedAtIdx       index
+	TodosUserIDListIDUpdatedAtIdx index
+	TodosListIDCreatedAtIdx       index
+	TodosUserIDListIDCreatedAtIdx index
+	TodosAssigneeIDIdx            index
+	TodosStatusIDIdx              index
+	TodosDeletedAtIdx             index
+	TodosParentIDIdx              index
+	TodosDueAtIdx                 index
+	TodosListIDIdx                index
+	TodosUserIDIdx                index
 }
 
 func (i todoIndexes) AsSlice() []index {
 	return []index{
-		i.PKMainTodos,
+		i.PKMainTodos, i.TodosListIDPositionIdx, i.TodosUserIDListIDPositionIdx, i.TodosListIDTitleIdx, i.TodosUserIDListIDTitleIdx, i.TodosListIDPriorityIdx, i.TodosUserIDListIDPriorityIdx, i.TodosListIDDueAtIdx, i.TodosUserIDListIDDueAtIdx, i.TodosListIDUpdatedAtIdx, i.TodosUserIDListIDUpdatedAtIdx, i.TodosListIDCreatedAtIdx, i.TodosUserIDListIDCreatedAtIdx, i.TodosAssigneeIDIdx, i.TodosStatusIDIdx, i.TodosDeletedAtIdx, i.TodosParentIDIdx, i.TodosDueAtIdx, i.TodosListIDIdx, i.TodosUserIDIdx,
 	}
 }
 
-type todoForeignKeys struct{}
+type todoForeignKeys struct {
+	FKTodos0 foreignKey
+	FKTodos1 foreignKey
+	FKTodos2 foreignKey
+	FKTodos3 foreignKey
+	FKTodos4 foreignKey
+}
 
 func (f todoForeignKeys) AsSlice() []foreignKey {
-	return []foreignKey{}
+	return []foreignKey{
+		f.FKTodos0, f.FKTodos1, f.FKTodos2, f.FKTodos3, f.FKTodos4,
+	}
 }
 
 type todoUniques struct{}
@@ -60,6 +60,33 @@ var Users = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		Timezone: column{
+			Name:      "timezone",
+			DBType:    "TEXT",
+			Default:   "'Asia/Tokyo'",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TodoSort: column{
+			Name:      "todo_sort",
+			DBType:    "TEXT",
+			Default:   "'created'",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TodoSortDir: column{
+			Name:      "todo_sort_dir",
+			DBType:    "TEXT",
+			Default:   "'asc'",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: userIndexes{
 		PKMainUsers: index{
@@ -109,16 +136,19 @@ var Users = Table[
 }
 
 type userColumns struct {
-	ID        column
-	Email     column
-	Password  column
-	CreatedAt column
-	UpdatedAt column
+	ID          column
+	Email       column
+	Password    column
+	CreatedAt   column
+	UpdatedAt   column
+	Timezone    column
+	TodoSort    column
+	TodoSortDir column
 }
 
 func (c userColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.Email, c.Password, c.CreatedAt, c.UpdatedAt,
+		c.ID, c.Email, c.Password, c.CreatedAt, c.UpdatedAt, c.Timezone, c.TodoSort, c.TodoSortDir,
 	}
 }
 
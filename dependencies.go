@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// registerDependencyRoutes はTodoの依存関係（ブロックしているTodo）のルートを登録する
+// 依存関係は詳細ペインで編集し、操作のたびに詳細ペインとTodoの行を描き直す
+func registerDependencyRoutes(g *echo.Group, db bob.DB) {
+	// すぐに取りかかれるTodo（アクセスできる未完了のTodoのうち、未完了のTodoにブロックされていないもの）
+	g.GET("/ready", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		todos, err := models.Todos.Query(
+			sm.Where(accessibleTodos(userID)),
+			models.SelectWhere.Todos.ParentID.IsNull(),
+			models.SelectWhere.Todos.Completed.EQ(false),
+			sm.Where(sqlite.Not(blockedTodos())),
+			sm.OrderBy(models.Todos.Columns.Priority).Desc(),
+			sm.OrderBy(sqlite.Raw(`"todos"."due_at" IS NULL`)),
+			sm.OrderBy(models.Todos.Columns.DueAt),
+			sm.OrderBy(models.Todos.Columns.ID),
+			models.SelectThenLoad.Todo.Tags(sm.OrderBy(models.Tags.Columns.Name)),
+			models.SelectThenLoad.Todo.Children(sm.OrderBy(models.Todos.Columns.ID)),
+			models.SelectThenLoad.Todo.TimeEntries(),
+			models.SelectThenLoad.Todo.Assignee(),
+			todoBlockersLoad(),
+		).All(ctx, db)
+		if err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, views.ReadyIndex(todos, c.Get("csrf").(string)))
+	})
+
+	// ブロックしているTodoを追加する（blocker_id のTodoが完了するまでこのTodoをブロックする）
+	// ブロックするTodoはログイン中のユーザーが見られるものに限り、循環する依存関係は拒否する
+	g.POST("/:id/dependencies", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		blockerID, err := strconv.ParseInt(c.FormValue("blocker_id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "ブロックしているTodoが正しくありません")
+		}
+		if blockerID == todo.ID {
+			return echo.NewHTTPError(http.StatusBadRequest, "自分自身にはブロックされません")
+		}
+		blocker, err := models.FindTodo(ctx, db, blockerID)
+		if err != nil {
+			return notFoundIfNoRows(err)
+		}
+		role, err := todoRole(ctx, db, c.Get("user_id").(int64), blocker)
+		if err != nil {
+			return err
+		}
+		if err := checkRole(role, RoleViewer); err != nil {
+			return err
+		}
+
+		// 循環を確かめてから追加するまでに別の追加が割り込み、循環ができないよう1つのトランザクションで行う
+		// トランザクションは始めるときに書き込みロックを取る（dbOptions）
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			cyclic, err := blockedBy(ctx, exec, blocker.ID, todo.ID)
+			if err != nil {
+				return err
+			}
+			if cyclic {
+				return echo.NewHTTPError(http.StatusBadRequest, "「"+blocker.Title+"」はこのTodoを待っているため、循環する依存関係になります")
+			}
+			_, err = models.TodoDependencies.Insert(&models.TodoDependencySetter{
+				TodoID:    omit.From(todo.ID),
+				BlockerID: omit.From(blocker.ID),
+				CreatedAt: omit.From(time.Now().UTC()),
+			}, im.OnConflict().DoNothing()).Exec(ctx, exec)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoDetailChange(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+
+	// ブロックしているTodoを外す
+	g.POST("/:id/dependencies/:blocker_id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		blockerID, err := strconv.ParseInt(c.Param("blocker_id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		_, err = models.TodoDependencies.Delete(
+			models.DeleteWhere.TodoDependencies.TodoID.EQ(todo.ID),
+			models.DeleteWhere.TodoDependencies.BlockerID.EQ(blockerID),
+		).Exec(ctx, db)
+		if err != nil {
+			return err
+		}
+		return renderTodoDetailChange(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// blockedBy は todoID のTodoが、依存関係をたどって blockerID のTodoにブロックされているかどうかを返す
+func blockedBy(ctx context.Context, exec bob.Executor, todoID, blockerID int64) (bool, error) {
+	return bob.One(ctx, exec, sqlite.RawQuery(`
+		WITH RECURSIVE blockers(id) AS (
+			SELECT blocker_id FROM todo_dependencies WHERE todo_id = ?
+			UNION
+			SELECT d.blocker_id FROM todo_dependencies d JOIN blockers b ON d.todo_id = b.id
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE id = ?)`, todoID, blockerID), scan.SingleColumnMapper[bool])
+}
+
+// blockedTodos はTodoが未完了のTodoにブロックされている条件。ゴミ箱にあるTodoはブロックしない
+// sm.Where(blockedTodos()) の形で使う
+func blockedTodos() bob.Expression {
+	return sqlite.Raw(`EXISTS (
+		SELECT 1 FROM todo_dependencies d JOIN todos b ON b.id = d.blocker_id
+		WHERE d.todo_id = "todos"."id" AND b.completed = 0 AND b.deleted_at IS NULL
+	)`)
+}
+
+// openBlockers はTodoをブロックしている未完了のTodoを返す（ゴミ箱にあるものは除く）
+func openBlockers(ctx context.Context, exec bob.Executor, todo *models.Todo) (models.TodoSlice, error) {
+	return models.Todos.Query(
+		sm.Where(models.Todos.Columns.ID.OP("IN", todoBlockerIDs(todo))),
+		models.SelectWhere.Todos.Completed.EQ(false),
+		sm.OrderBy(models.Todos.Columns.ID),
+	).All(ctx, exec)
+}
+
+// checkNotBlocked はTodoが未完了のTodoにブロックされていれば 409 を返す
+func checkNotBlocked(ctx context.Context, exec bob.Executor, todo *models.Todo) error {
+	blockers, err := openBlockers(ctx, exec, todo)
+	if err != nil || len(blockers) == 0 {
+		return err
+	}
+	titles := make([]string, len(blockers))
+	for i, blocker := range blockers {
+		titles[i] = "「" + blocker.Title + "」"
+	}
+	return echo.NewHTTPError(http.StatusConflict, strings.Join(titles, "、")+"が完了していないため完了にできません")
+}
+
+// checkTodosNotBlocked はまとめて完了にするTodoのどれかが未完了のTodoにブロックされていれば 409 を返す
+// 一緒に完了にするTodoにだけブロックされているなら完了にできる
+func checkTodosNotBlocked(ctx context.Context, exec bob.Executor, todos models.TodoSlice) error {
+	completing := make(map[int64]bool, len(todos))
+	for _, todo := range todos {
+		completing[todo.ID] = true
+	}
+	for _, todo := range todos {
+		if todo.Completed {
+			continue
+		}
+		blockers, err := openBlockers(ctx, exec, todo)
+		if err != nil {
+			return err
+		}
+		var titles []string
+		for _, blocker := range blockers {
+			if !completing[blocker.ID] {
+				titles = append(titles, "「"+blocker.Title+"」")
+			}
+		}
+		if len(titles) > 0 {
+			return echo.NewHTTPError(http.StatusConflict, "「"+todo.Title+"」は"+strings.Join(titles, "、")+"が完了していないため完了にできません")
+		}
+	}
+	return nil
+}
+
+// todoBlockersLoad はTodoの行のブロック中の表示に使う依存関係とブロックしているTodoの読み込み
+func todoBlockersLoad() bob.Mod[*dialect.SelectQuery] {
+	return models.SelectThenLoad.Todo.Dependencies(models.SelectThenLoad.TodoDependency.Blocker())
+}
+
+// dependencyCandidates は詳細ペインでブロックしているTodoとして選べるTodo
+// 同じリスト（受信箱なら自分の受信箱）の未完了のTodoのうち、このTodo自身とすでに選んだものを除く
+func dependencyCandidates(ctx context.Context, exec bob.Executor, todo *models.Todo) (models.TodoSlice, error) {
+	return models.Todos.Query(
+		todoPositionScope(todo).where(),
+		models.SelectWhere.Todos.ID.NE(todo.ID),
+		models.SelectWhere.Todos.Completed.EQ(false),
+		sm.Where(models.Todos.Columns.ID.OP("NOT IN", todoBlockerIDs(todo))),
+		positionOrder(),
+	).All(ctx, exec)
+}
+
+// todoBlockerIDs はTodoをブロックしているTodoのIDを返すサブクエリ
+func todoBlockerIDs(todo *models.Todo) bob.Expression {
+	return sqlite.Select(
+		sm.Columns(models.TodoDependencies.Columns.BlockerID),
+		sm.From(models.TodoDependencies.Name()),
+		models.SelectWhere.TodoDependencies.TodoID.EQ(todo.ID),
+	)
+}
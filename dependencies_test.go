@@ -0,0 +1,202 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestTodoDependencies(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	newTodo := func(title string) *models.Todo {
+		return f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title(title)).CreateOrFail(ctx, t, db)
+	}
+	release, review, build := newTodo("release"), newTodo("review"), newTodo("build")
+	tc := login(t, e, alice)
+	block := func(todo, blocker *models.Todo) int {
+		t.Helper()
+		path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/dependencies"
+		return tc.do(http.MethodPost, path, url.Values{"blocker_id": {strconv.FormatInt(blocker.ID, 10)}}).Code
+	}
+
+	// release は review を、review は build を待つ
+	rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(release.ID, 10)+"/dependencies", url.Values{"blocker_id": {strconv.FormatInt(review.ID, 10)}})
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "ブロック中") {
+		t.Fatalf("block release: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if code := block(review, build); code != http.StatusOK {
+		t.Fatalf("block review: status = %d", code)
+	}
+
+	// 直接・間接に循環する依存と、自分自身への依存は拒否する
+	for _, tt := range []struct{ todo, blocker *models.Todo }{{review, release}, {build, release}, {build, build}} {
+		if code := block(tt.todo, tt.blocker); code != http.StatusBadRequest {
+			t.Errorf("%s blocked by %s: status = %d, want %d", tt.todo.Title, tt.blocker.Title, code, http.StatusBadRequest)
+		}
+	}
+	if n, _ := models.TodoDependencies.Query().Count(ctx, db); n != 2 {
+		t.Errorf("dependencies = %d, want 2", n)
+	}
+
+	// ほかのユーザーのTodoは指定できない
+	other := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(createTestUser(t, db, "bob@example.com"))).CreateOrFail(ctx, t, db)
+	if code := block(build, other); code != http.StatusNotFound {
+		t.Errorf("blocked by another user's todo: status = %d, want %d", code, http.StatusNotFound)
+	}
+
+	// 取りかかれるのはブロックされていない build だけ
+	body := tc.do(http.MethodGet, "/todos/ready", nil).Body.String()
+	if !strings.Contains(body, ">build<") || strings.Contains(body, ">review<") || strings.Contains(body, ">release<") {
+		t.Errorf("ready view = %s", body)
+	}
+
+	// ブロック中のTodoは force を付けたときだけ完了にできる
+	togglePath := "/todos/" + strconv.FormatInt(release.ID, 10) + "/toggle"
+	if rec := tc.do(http.MethodPost, togglePath, url.Values{}); rec.Code != http.StatusConflict {
+		t.Errorf("toggle blocked todo: status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	if rec := tc.do(http.MethodPost, togglePath, url.Values{"force": {"1"}}); rec.Code != http.StatusOK {
+		t.Errorf("force toggle: status = %d", rec.Code)
+	}
+	if got, _ := models.FindTodo(ctx, db, release.ID); !got.Completed {
+		t.Error("forced toggle did not complete the todo")
+	}
+
+	// build を完了すると review が取りかかれるようになる
+	if err := build.Update(ctx, db, &models.TodoSetter{Completed: omit.From(true)}); err != nil {
+		t.Fatal(err)
+	}
+	if body := tc.do(http.MethodGet, "/todos/ready", nil).Body.String(); !strings.Contains(body, ">review<") {
+		t.Error("review is not ready after its blocker was completed")
+	}
+	if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(review.ID, 10)+"/dependencies/"+strconv.FormatInt(build.ID, 10)+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Errorf("remove dependency: status = %d", rec.Code)
+	}
+	if n, _ := models.TodoDependencies.Query().Count(ctx, db); n != 1 {
+		t.Errorf("dependencies = %d, want 1", n)
+	}
+}
+
+func TestCompleteBlockedTodoInBulkAndBoard(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	tc := login(t, e, alice)
+	if rec := tc.do(http.MethodPost, "/lists", url.Values{"name": {"project"}}); rec.Code != http.StatusOK {
+		t.Fatalf("create list: status = %d", rec.Code)
+	}
+	list, err := models.Lists.Query(models.SelectWhere.Lists.Name.EQ("project")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := listStatuses(ctx, db, list.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := statuses[len(statuses)-1]
+
+	newTodo := func(title string) *models.Todo {
+		return f.NewTodoWithContext(ctx,
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.ListID(null.From(list.ID)),
+			factory.TodoMods.Title(title),
+			factory.TodoMods.Completed(false),
+		).CreateOrFail(ctx, t, db)
+	}
+	release, review, deploy := newTodo("release"), newTodo("review"), newTodo("deploy")
+	for _, todo := range []*models.Todo{release, deploy} {
+		path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/dependencies"
+		if code := tc.do(http.MethodPost, path, url.Values{"blocker_id": {strconv.FormatInt(review.ID, 10)}}).Code; code != http.StatusOK {
+			t.Fatalf("block %s: status = %d", todo.Title, code)
+		}
+	}
+	completed := func(todo *models.Todo) bool {
+		t.Helper()
+		got, err := models.FindTodo(ctx, db, todo.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got.Completed
+	}
+
+	// 一括操作（「すべて完了にする」も同じ）ではブロック中のTodoが1件でもあれば何も完了にしない
+	rec := tc.do(http.MethodPost, "/todos/bulk", bulkForm(bulkComplete, release))
+	if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "review") {
+		t.Errorf("bulk complete blocked todo: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if completed(release) {
+		t.Error("bulk complete completed a blocked todo")
+	}
+	// ブロックしているTodoも一緒に完了にするなら完了にできる
+	if code := tc.do(http.MethodPost, "/todos/bulk", bulkForm(bulkComplete, release, review)).Code; code != http.StatusOK {
+		t.Errorf("bulk complete with its blocker: status = %d", code)
+	}
+	if !completed(release) || !completed(review) {
+		t.Error("bulk complete with its blocker did not complete both todos")
+	}
+	if err := review.Update(ctx, db, &models.TodoSetter{Completed: omit.From(false)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// ボードで完了扱いの列へ移すときも同じ。force を付ければ完了にできる
+	statusPath := "/todos/" + strconv.FormatInt(deploy.ID, 10) + "/status"
+	form := url.Values{"status_id": {strconv.FormatInt(done.ID, 10)}}
+	if code := tc.do(http.MethodPost, statusPath, form).Code; code != http.StatusConflict {
+		t.Errorf("move blocked todo to done: status = %d, want %d", code, http.StatusConflict)
+	}
+	if completed(deploy) {
+		t.Error("moving to done completed a blocked todo")
+	}
+	form.Set("force", "1")
+	if code := tc.do(http.MethodPost, statusPath, form).Code; code != http.StatusOK {
+		t.Errorf("force move to done: status = %d", code)
+	}
+	if !completed(deploy) {
+		t.Error("forced move to done did not complete the todo")
+	}
+}
+
+func TestConcurrentDependenciesDoNotFormCycle(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	for range 5 {
+		a := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+		b := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+
+		// 互いを待つ依存を同時に追加しても、循環を確かめてから追加するまでに割り込まれず片方だけ通る
+		pairs := [][2]*models.Todo{{a, b}, {b, a}}
+		codes := make([]int, len(pairs))
+		clients := []*testClient{login(t, e, alice), login(t, e, alice)}
+		var wg sync.WaitGroup
+		for i, pair := range pairs {
+			wg.Go(func() {
+				path := "/todos/" + strconv.FormatInt(pair[0].ID, 10) + "/dependencies"
+				codes[i] = clients[i].do(http.MethodPost, path, url.Values{"blocker_id": {strconv.FormatInt(pair[1].ID, 10)}}).Code
+			})
+		}
+		wg.Wait()
+		slices.Sort(codes)
+		if want := []int{http.StatusOK, http.StatusBadRequest}; !slices.Equal(codes, want) {
+			t.Errorf("statuses = %v, want %v", codes, want)
+		}
+	}
+}
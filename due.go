@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// defaultTimezone はタイムゾーンが未設定のユーザーに使うタイムゾーン（users.timezone の既定値と同じ）
+const defaultTimezone = "Asia/Tokyo"
+
+// userLocation はIANAタイムゾーン名を読み込む
+// time.LoadLocation は "" をUTC、"Local" をサーバーのタイムゾーンとして受け付けるので、どちらもエラーにする
+func userLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("invalid timezone %q", name)
+	}
+	return time.LoadLocation(name)
+}
+
+// loadLocation はIANAタイムゾーン名を読み込む。不正な名前なら既定のタイムゾーンを返す
+func loadLocation(name string) *time.Location {
+	if loc, err := userLocation(name); err == nil {
+		return loc
+	}
+	loc, err := time.LoadLocation(defaultTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// startOfDay は loc におけるtの日の0時を返す
+// 夏時間の切り替えで0時が存在しない日は、その日の最初の時刻（切り替え直後）を返す
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	// time.Date は存在しない時刻を切り替え前の時差で解釈するため、前日になることがある
+	for start.Day() != d {
+		start = start.Add(15 * time.Minute)
+	}
+	return start
+}
+
+// parseDueDate は "2006-01-02" 形式の日付を loc におけるその日の0時（UTC）に変換する
+func parseDueDate(value string, loc *time.Location) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// 正午はどのタイムゾーンでもその日のうちにあるので、そこからその日の最初の時刻を求める
+	y, m, d := date.Date()
+	return startOfDay(time.Date(y, m, d, 12, 0, 0, 0, loc), loc).UTC(), nil
+}
+
+// groupByDue は期限付きのTodoを「期限切れ」「今日」「今週」「それ以降」に分類する
+// 日付の境界は loc で計算し、週は月曜始まりとする。todosは期限の昇順で渡すこと
+func groupByDue(todos []*models.Todo, now time.Time, loc *time.Location) []views.DueGroup {
+	today := startOfDay(now, loc)
+	tomorrow := today.AddDate(0, 0, 1)
+	daysUntilMonday := (8 - int(today.Weekday())) % 7
+	if daysUntilMonday == 0 {
+		daysUntilMonday = 7
+	}
+	nextWeek := today.AddDate(0, 0, daysUntilMonday)
+
+	groups := []views.DueGroup{
+		{Label: "期限切れ", Overdue: true},
+		{Label: "今日"},
+		{Label: "今週"},
+		{Label: "それ以降"},
+	}
+	for _, todo := range todos {
+		dueAt, ok := todo.DueAt.Get()
+		if !ok {
+			continue
+		}
+		var i int
+		switch {
+		case dueAt.Before(today):
+			i = 0
+		case dueAt.Before(tomorrow):
+			i = 1
+		case dueAt.Before(nextWeek):
+			i = 2
+		default:
+			i = 3
+		}
+		groups[i].Todos = append(groups[i].Todos, todo)
+	}
+	return groups
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestGroupByDue(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2026-10-14（水）23:30 JST。UTCではまだ14:30なので、UTCで計算すると結果が変わる
+	now := time.Date(2026, 10, 14, 23, 30, 0, 0, tokyo)
+
+	due := func(date string) *models.Todo {
+		dueAt, err := parseDueDate(date, tokyo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &models.Todo{Title: date, DueAt: null.From(dueAt)}
+	}
+	todos := []*models.Todo{
+		due("2026-10-13"), // 昨日
+		due("2026-10-14"), // 今日
+		due("2026-10-15"), // 明日（今週）
+		due("2026-10-18"), // 日曜（今週）
+		due("2026-10-19"), // 来週の月曜
+		{Title: "no due"},
+	}
+
+	groups := groupByDue(todos, now, tokyo)
+	want := [][]string{
+		{"2026-10-13"},
+		{"2026-10-14"},
+		{"2026-10-15", "2026-10-18"},
+		{"2026-10-19"},
+	}
+	for i, group := range groups {
+		var got []string
+		for _, todo := range group.Todos {
+			got = append(got, todo.Title)
+		}
+		if len(got) != len(want[i]) {
+			t.Errorf("%s = %v, want %v", group.Label, got, want[i])
+			continue
+		}
+		for j := range got {
+			if got[j] != want[i][j] {
+				t.Errorf("%s = %v, want %v", group.Label, got, want[i])
+			}
+		}
+	}
+}
+
+func TestGroupByDueOnSunday(t *testing.T) {
+	// 日曜日は「今週」が残っていないので、翌日以降はすべて「それ以降」になる
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	tomorrow := &models.Todo{DueAt: null.From(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))}
+
+	groups := groupByDue([]*models.Todo{tomorrow}, now, time.UTC)
+	if len(groups[2].Todos) != 0 || len(groups[3].Todos) != 1 {
+		t.Errorf("this week = %d, later = %d, want 0 and 1", len(groups[2].Todos), len(groups[3].Todos))
+	}
+}
+
+func TestSetDueDateUsesUserTimezone(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com", factory.UserMods.Timezone("America/New_York"))
+	todo := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/due"
+	if rec := tc.do(http.MethodPost, path, url.Values{"due_on": {"2026-03-08"}}); rec.Code != http.StatusOK {
+		t.Fatalf("POST %s: status = %d", path, rec.Code)
+	}
+	if err := todo.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	// ニューヨークの0時はUTCの5時（2026-03-08はDST開始日だが0時時点ではまだEST）
+	want := time.Date(2026, 3, 8, 5, 0, 0, 0, time.UTC)
+	if got := todo.DueAt.GetOrZero(); !got.Equal(want) {
+		t.Errorf("due_at = %v, want %v", got, want)
+	}
+
+	if rec := tc.do(http.MethodPost, path, url.Values{"due_on": {"2026-03-08"}, "clear": {"1"}}); rec.Code != http.StatusOK {
+		t.Fatalf("POST %s (clear): status = %d", path, rec.Code)
+	}
+	if err := todo.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if todo.DueAt.IsValue() {
+		t.Errorf("due_at = %v, want NULL", todo.DueAt)
+	}
+}
+
+func TestTodosSortedByDue(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	for _, tt := range []struct {
+		title string
+		due   null.Val[time.Time]
+	}{
+		{"no-due", null.Val[time.Time]{}},
+		{"due-later", null.From(time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC))},
+		{"due-sooner", null.From(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))},
+	} {
+		f.NewTodoWithContext(ctx,
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.Title(tt.title),
+			factory.TodoMods.Completed(false),
+			factory.TodoMods.DueAt(tt.due),
+		).CreateOrFail(ctx, t, db)
+	}
+	tc := login(t, e, alice)
+
+	rec := tc.do(http.MethodGet, "/todos?sort=due", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /todos?sort=due: status = %d", rec.Code)
+	}
+	assertOrder(t, rec.Body.String(), "due-sooner", "due-later", "no-due")
+
+	rec = tc.do(http.MethodGet, "/todos/upcoming", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /todos/upcoming: status = %d", rec.Code)
+	}
+	assertOrder(t, rec.Body.String(), "due-sooner", "due-later")
+}
+
+func TestParseDueDateWithoutMidnight(t *testing.T) {
+	// サンティアゴは2026-09-06の0時に夏時間が始まり、その日の0時が存在しない
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dueAt, err := parseDueDate("2026-09-06", santiago)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := dueAt.In(santiago).Format(time.DateOnly); got != "2026-09-06" {
+		t.Errorf("parseDueDate = %s in Santiago, want 2026-09-06", got)
+	}
+	if !startOfDay(dueAt, santiago).Equal(dueAt) {
+		t.Errorf("startOfDay(%s) = %s, want the same instant", dueAt, startOfDay(dueAt, santiago))
+	}
+}
+
+func TestSaveTimezone(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	tc := login(t, e, alice)
+
+	// "" と "Local" は time.LoadLocation が受け付けるが、ユーザーのタイムゾーンとしては使えない
+	for _, timezone := range []string{"", "Local", "Nowhere/City"} {
+		if rec := tc.do(http.MethodPost, "/settings", url.Values{"timezone": {timezone}}); rec.Code != http.StatusBadRequest {
+			t.Errorf("timezone %q: status = %d, want %d", timezone, rec.Code, http.StatusBadRequest)
+		}
+	}
+	if rec := tc.do(http.MethodPost, "/settings", url.Values{"timezone": {"Europe/London"}}); rec.Code != http.StatusFound {
+		t.Fatalf("timezone Europe/London: status = %d, want %d", rec.Code, http.StatusFound)
+	}
+	after, err := models.FindUser(ctx, db, alice.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Timezone != "Europe/London" {
+		t.Errorf("timezone = %q, want Europe/London", after.Timezone)
+	}
+
+	// 保存済みの "Local" はサーバーのタイムゾーンではなく既定のタイムゾーンとして扱う
+	if got := loadLocation("Local").String(); got != defaultTimezone {
+		t.Errorf("loadLocation(Local) = %s, want %s", got, defaultTimezone)
+	}
+}
@@ -0,0 +1,338 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// Todoの変更履歴の種類（todo_events.kind の値）
+const (
+	todoEventCreated    = "created"
+	todoEventTitle      = "title"
+	todoEventNotes      = "notes"
+	todoEventCompleted  = "completed"
+	todoEventPriority   = "priority"
+	todoEventDue        = "due"
+	todoEventRecurrence = "recurrence"
+	todoEventMoved      = "moved" // 値は移動前後のリスト名。受信箱は NULL
+	todoEventDeleted    = "deleted"
+	todoEventRestored   = "restored"
+	todoEventTagAdded   = "tag_added"   // 値は付けたタグの名前
+	todoEventTagRemoved = "tag_removed" // 値は外したタグの名前
+	todoEventStatus     = "status"      // 値は変更後の状態の名前。完了の切り替えなどで状態が外れたときは記録しない
+	todoEventAssignee   = "assignee"    // 値は変更前後の担当者のメールアドレス。未割り当ては NULL
+)
+
+// revertibleTodoEvents は変更前の値に戻せる履歴の種類
+var revertibleTodoEvents = map[string]bool{
+	todoEventTitle:      true,
+	todoEventNotes:      true,
+	todoEventPriority:   true,
+	todoEventDue:        true,
+	todoEventRecurrence: true,
+}
+
+// Todoの変更はハンドラーごとではなく bob のフックでまとめて履歴に残す
+// models.Todos の作成・更新と models.TodoTags の付け外しは、どこから実行しても記録される
+// sqlite.Insert などで直接書いた行や外部キーの CASCADE で消えた行はフックを通らないので、必ず models を使う
+// 操作したユーザーは requireAuth がContextに入れたログイン中のユーザー（ゴミ箱の自動削除などでは空）
+func init() {
+	models.Todos.AfterInsertHooks.AppendHooks(recordTodosCreated)
+	models.Todos.UpdateQueryHooks.AppendHooks(recordTodoChanges)
+	models.TodoTags.BeforeInsertHooks.AppendHooks(recordTagAdded)
+	models.TodoTags.DeleteQueryHooks.AppendHooks(recordTagsRemoved)
+}
+
+// insertTodoEvents は履歴を追加する。操作したユーザーはContextから読む
+func insertTodoEvents(ctx context.Context, exec bob.Executor, events ...*models.TodoEventSetter) error {
+	if len(events) == 0 {
+		return nil
+	}
+	var actor omitnull.Val[int64]
+	if userID := views.UserIDFromContext(ctx); userID != 0 {
+		actor = omitnull.From(userID)
+	}
+	for _, event := range events {
+		event.UserID = actor
+	}
+	_, err := models.TodoEvents.Insert(bob.ToMods(events...)).Exec(ctx, exec)
+	return err
+}
+
+// todoEvent は1件の履歴。値のない側は NULL にする
+func todoEvent(todoID int64, kind string, oldValue, newValue null.Val[string]) *models.TodoEventSetter {
+	return &models.TodoEventSetter{
+		TodoID:   omit.From(todoID),
+		Kind:     omit.From(kind),
+		OldValue: omitnull.FromNull(oldValue),
+		NewValue: omitnull.FromNull(newValue),
+	}
+}
+
+func recordTodosCreated(ctx context.Context, exec bob.Executor, todos models.TodoSlice) (context.Context, error) {
+	events := make([]*models.TodoEventSetter, len(todos))
+	for i, todo := range todos {
+		events[i] = todoEvent(todo.ID, todoEventCreated, null.Val[string]{}, null.From(todo.Title))
+	}
+	return ctx, insertTodoEvents(ctx, exec, events...)
+}
+
+// recordTodoChanges は更新の前に対象のTodoを読んでおき、更新の後に読み直して変わった項目を履歴にする
+// 更新後の読み直しは、クエリの実行後に呼ばれるローダーとして登録する
+func recordTodoChanges(ctx context.Context, exec bob.Executor, q *dialect.UpdateQuery) (context.Context, error) {
+	sameRows := bob.ModFunc[*dialect.SelectQuery](func(s *dialect.SelectQuery) {
+		s.AppendWhere(q.Where.Conditions...)
+	})
+	before, err := models.Todos.Query(sameRows).All(withDeletedTodos(ctx), exec)
+	if err != nil || len(before) == 0 {
+		return ctx, err
+	}
+	ids := make([]int64, len(before))
+	for i, todo := range before {
+		ids[i] = todo.ID
+	}
+
+	q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, _ any) error {
+		after, err := models.Todos.Query(models.SelectWhere.Todos.ID.In(ids...)).All(withDeletedTodos(ctx), exec)
+		if err != nil {
+			return err
+		}
+		updated := make(map[int64]*models.Todo, len(after))
+		for _, todo := range after {
+			updated[todo.ID] = todo
+		}
+		var events []*models.TodoEventSetter
+		for _, old := range before {
+			if todo, ok := updated[old.ID]; ok {
+				changes, err := todoChanges(ctx, exec, old, todo)
+				if err != nil {
+					return err
+				}
+				events = append(events, changes...)
+			}
+		}
+		return insertTodoEvents(ctx, exec, events...)
+	}))
+	return ctx, nil
+}
+
+// todoChanges は更新前後のTodoの違いを履歴にする。並び順と更新日時の変化は記録しない
+func todoChanges(ctx context.Context, exec bob.Executor, old, todo *models.Todo) ([]*models.TodoEventSetter, error) {
+	var events []*models.TodoEventSetter
+	changed := func(kind string, oldValue, newValue null.Val[string]) {
+		if oldValue.IsValue() == newValue.IsValue() && oldValue.GetOrZero() == newValue.GetOrZero() {
+			return
+		}
+		events = append(events, todoEvent(todo.ID, kind, oldValue, newValue))
+	}
+
+	changed(todoEventTitle, null.From(old.Title), null.From(todo.Title))
+	changed(todoEventNotes, null.From(old.Notes), null.From(todo.Notes))
+	changed(todoEventCompleted, null.From(strconv.FormatBool(old.Completed)), null.From(strconv.FormatBool(todo.Completed)))
+	changed(todoEventPriority, null.From(strconv.FormatInt(old.Priority, 10)), null.From(strconv.FormatInt(todo.Priority, 10)))
+	changed(todoEventDue, dueEventValue(old.DueAt), dueEventValue(todo.DueAt))
+	changed(todoEventRecurrence, old.Recurrence, todo.Recurrence)
+
+	if old.ListID != todo.ListID {
+		from, err := listEventValue(ctx, exec, old.ListID)
+		if err != nil {
+			return nil, err
+		}
+		to, err := listEventValue(ctx, exec, todo.ListID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, todoEvent(todo.ID, todoEventMoved, from, to))
+	}
+
+	if old.AssigneeID != todo.AssigneeID {
+		from, err := assigneeEventValue(ctx, exec, old.AssigneeID)
+		if err != nil {
+			return nil, err
+		}
+		to, err := assigneeEventValue(ctx, exec, todo.AssigneeID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, todoEvent(todo.ID, todoEventAssignee, from, to))
+	}
+
+	if statusID, ok := todo.StatusID.Get(); ok && old.StatusID != todo.StatusID {
+		status, err := models.FindListStatus(ctx, exec, statusID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, todoEvent(todo.ID, todoEventStatus, null.Val[string]{}, null.From(status.Name)))
+	}
+
+	switch {
+	case old.DeletedAt.IsNull() && todo.DeletedAt.IsValue():
+		events = append(events, todoEvent(todo.ID, todoEventDeleted, null.Val[string]{}, null.Val[string]{}))
+	case old.DeletedAt.IsValue() && todo.DeletedAt.IsNull():
+		events = append(events, todoEvent(todo.ID, todoEventRestored, null.Val[string]{}, null.Val[string]{}))
+	}
+	return events, nil
+}
+
+// dueEventValue は期限を履歴に残す文字列にする（UTCのRFC3339）
+func dueEventValue(due null.Val[time.Time]) null.Val[string] {
+	if t, ok := due.Get(); ok {
+		return null.From(t.UTC().Format(time.RFC3339))
+	}
+	return null.Val[string]{}
+}
+
+// listEventValue はリストを履歴に残す文字列（リスト名）にする。受信箱は NULL
+func listEventValue(ctx context.Context, exec bob.Executor, listID null.Val[int64]) (null.Val[string], error) {
+	id, ok := listID.Get()
+	if !ok {
+		return null.Val[string]{}, nil
+	}
+	list, err := models.FindList(ctx, exec, id)
+	if err != nil {
+		return null.Val[string]{}, err
+	}
+	return null.From(list.Name), nil
+}
+
+// assigneeEventValue は担当者を履歴に残す文字列（メールアドレス）にする。未割り当ては NULL
+func assigneeEventValue(ctx context.Context, exec bob.Executor, assigneeID null.Val[int64]) (null.Val[string], error) {
+	id, ok := assigneeID.Get()
+	if !ok {
+		return null.Val[string]{}, nil
+	}
+	user, err := models.FindUser(ctx, exec, id)
+	if err != nil {
+		return null.Val[string]{}, err
+	}
+	return null.From(user.Email), nil
+}
+
+// recordTagAdded はまだ付いていないタグを付けるときだけ履歴にする（付いていれば挿入は ON CONFLICT で何もしない）
+func recordTagAdded(ctx context.Context, exec bob.Executor, s *models.TodoTagSetter) (context.Context, error) {
+	todoID, tagID := s.TodoID.GetOrZero(), s.TagID.GetOrZero()
+	exists, err := models.TodoTagExists(ctx, exec, todoID, tagID)
+	if err != nil || exists {
+		return ctx, err
+	}
+	tag, err := models.FindTag(ctx, exec, tagID)
+	if err != nil {
+		return ctx, err
+	}
+	return ctx, insertTodoEvents(ctx, exec, todoEvent(todoID, todoEventTagAdded, null.Val[string]{}, null.From(tag.Name)))
+}
+
+// recordTagsRemoved は削除の前に外すタグを読んでおき、削除の後に履歴にする
+func recordTagsRemoved(ctx context.Context, exec bob.Executor, q *dialect.DeleteQuery) (context.Context, error) {
+	sameRows := bob.ModFunc[*dialect.SelectQuery](func(s *dialect.SelectQuery) {
+		s.AppendWhere(q.Where.Conditions...)
+	})
+	todoTags, err := models.TodoTags.Query(sameRows, models.SelectThenLoad.TodoTag.Tag()).All(ctx, exec)
+	if err != nil || len(todoTags) == 0 {
+		return ctx, err
+	}
+
+	q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, _ any) error {
+		events := make([]*models.TodoEventSetter, len(todoTags))
+		for i, todoTag := range todoTags {
+			events[i] = todoEvent(todoTag.TodoID, todoEventTagRemoved, null.From(todoTag.R.Tag.Name), null.Val[string]{})
+		}
+		return insertTodoEvents(ctx, exec, events...)
+	}))
+	return ctx, nil
+}
+
+// registerTodoEventRoutes はTodoの変更履歴のルートを登録する。履歴は詳細ペインに表示する
+func registerTodoEventRoutes(g *echo.Group, db bob.DB) {
+	// 履歴の変更前の値に戻す。戻す操作も更新として履歴に残る
+	// 詳細ペインを描き直し、一覧の行も out-of-band で更新する
+	g.POST("/:id/events/:event_id/revert", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		eventID, err := strconv.ParseInt(c.Param("event_id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		event, err := models.TodoEvents.Query(
+			models.SelectWhere.TodoEvents.ID.EQ(eventID),
+			models.SelectWhere.TodoEvents.TodoID.EQ(todo.ID),
+		).One(ctx, db)
+		if err != nil {
+			return notFoundIfNoRows(err)
+		}
+		setter, err := revertSetter(event)
+		if err != nil {
+			return err
+		}
+		setter.UpdatedAt = omit.From(time.Now().UTC())
+		if err := todo.Update(ctx, db, setter); err != nil {
+			return err
+		}
+
+		detail, err := todoDetail(c, db, todo)
+		if err != nil {
+			return err
+		}
+		row := todo
+		if parent, err := subtaskParent(ctx, db, todo); err != nil {
+			return err
+		} else if parent != nil {
+			row = parent
+		}
+		if err := loadTodoItem(ctx, db, row); err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, templ.Join(detail, views.TodoItemOOB(row, c.Get("csrf").(string))))
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// revertSetter は履歴の変更前の値に戻す更新を作る
+func revertSetter(event *models.TodoEvent) (*models.TodoSetter, error) {
+	if !revertibleTodoEvents[event.Kind] {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "この変更は元に戻せません")
+	}
+	invalid := echo.NewHTTPError(http.StatusBadRequest, "変更前の値が正しくありません")
+	old, ok := event.OldValue.Get()
+	setter := &models.TodoSetter{}
+	switch event.Kind {
+	case todoEventTitle:
+		if !ok || old == "" {
+			return nil, invalid
+		}
+		setter.Title = omit.From(old)
+	case todoEventNotes:
+		setter.Notes = omit.From(old)
+	case todoEventPriority:
+		priority, err := strconv.ParseInt(old, 10, 64)
+		if err != nil {
+			return nil, invalid
+		}
+		setter.Priority = omit.From(priority)
+	case todoEventDue:
+		if !ok {
+			setter.DueAt.Null()
+			break
+		}
+		due, err := time.Parse(time.RFC3339, old)
+		if err != nil {
+			return nil, invalid
+		}
+		setter.DueAt = omitnull.From(due)
+	case todoEventRecurrence:
+		setter.Recurrence = omitnull.FromNull(event.OldValue)
+	}
+	return setter, nil
+}
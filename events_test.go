@@ -0,0 +1,151 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestTodoEventsRecordChanges(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice), factory.ListMods.Name("events-list")).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	if rec := tc.do(http.MethodPost, "/todos", url.Values{"title": {"before"}}); rec.Code != http.StatusOK {
+		t.Fatalf("create: status = %d", rec.Code)
+	}
+	todo, err := models.Todos.Query(models.SelectWhere.Todos.Title.EQ("before")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10)
+	for _, req := range []struct {
+		path string
+		form url.Values
+	}{
+		{path, url.Values{"title": {"after"}}},
+		{path + "/toggle", url.Values{}},
+		{path + "/tags", url.Values{"name": {"events-tag"}}},
+		{path + "/tags", url.Values{"name": {"events-tag"}}}, // 付いているタグは記録しない
+		{path + "/move", url.Values{"list_id": {strconv.FormatInt(list.ID, 10)}}},
+		{path + "/delete", url.Values{}},
+		{"/trash/" + strconv.FormatInt(todo.ID, 10) + "/restore", url.Values{}},
+	} {
+		if rec := tc.do(http.MethodPost, req.path, req.form); rec.Code >= http.StatusBadRequest {
+			t.Fatalf("POST %s: status = %d", req.path, rec.Code)
+		}
+	}
+	tag, err := models.Tags.Query(models.SelectWhere.Tags.Name.EQ("events-tag")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := tc.do(http.MethodPost, path+"/tags/"+strconv.FormatInt(tag.ID, 10)+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("remove tag: status = %d", rec.Code)
+	}
+
+	events, err := todo.TodoEvents(sm.OrderBy(models.TodoEvents.Columns.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []string
+	for _, event := range events {
+		kinds = append(kinds, event.Kind)
+		if event.UserID.GetOrZero() != alice.ID {
+			t.Errorf("%s: user_id = %v, want %d", event.Kind, event.UserID, alice.ID)
+		}
+	}
+	want := []string{
+		todoEventCreated, todoEventTitle, todoEventCompleted, todoEventTagAdded,
+		todoEventMoved, todoEventDeleted, todoEventRestored, todoEventTagRemoved,
+	}
+	if !slices.Equal(kinds, want) {
+		t.Fatalf("kinds = %v, want %v", kinds, want)
+	}
+	if title := events[1]; title.OldValue.GetOrZero() != "before" || title.NewValue.GetOrZero() != "after" {
+		t.Errorf("title event = %v -> %v", title.OldValue, title.NewValue)
+	}
+	if moved := events[4]; moved.OldValue.IsValue() || moved.NewValue.GetOrZero() != "events-list" {
+		t.Errorf("moved event = %v -> %v", moved.OldValue, moved.NewValue)
+	}
+
+	// 詳細ペインに履歴を表示する
+	body := tc.do(http.MethodGet, path+"/notes", nil).Body.String()
+	for _, s := range []string{"変更履歴 (8)", "タイトルを「before」から「after」に変更しました", "「受信箱」から「events-list」へ移動しました"} {
+		if !strings.Contains(body, s) {
+			t.Errorf("detail does not contain %q", s)
+		}
+	}
+
+	// 履歴は書き換えられない
+	if _, err := models.TodoEvents.Update(
+		models.TodoEventSetter{Kind: omit.From("forged")}.UpdateMod(),
+		models.UpdateWhere.TodoEvents.ID.EQ(events[0].ID),
+	).Exec(ctx, db); err == nil {
+		t.Error("todo_events was updated")
+	}
+}
+
+func TestRevertTodoEvent(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title("original"), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10)
+	tc.do(http.MethodPost, path, url.Values{"title": {"renamed"}})
+	tc.do(http.MethodPost, path+"/toggle", url.Values{})
+
+	eventOf := func(kind string) *models.TodoEvent {
+		t.Helper()
+		event, err := todo.TodoEvents(
+			models.SelectWhere.TodoEvents.Kind.EQ(kind),
+			sm.OrderBy(models.TodoEvents.Columns.ID).Desc(),
+		).One(ctx, db)
+		if err != nil {
+			t.Fatalf("%s event: %v", kind, err)
+		}
+		return event
+	}
+	revertPath := func(event *models.TodoEvent) string {
+		return path + "/events/" + strconv.FormatInt(event.ID, 10) + "/revert"
+	}
+
+	title := eventOf(todoEventTitle)
+	rec := tc.do(http.MethodPost, revertPath(title), url.Values{})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("revert title: status = %d", rec.Code)
+	}
+	if err := todo.Reload(ctx, db); err != nil || todo.Title != "original" {
+		t.Errorf("title = %q, %v; want original", todo.Title, err)
+	}
+	if !strings.Contains(rec.Body.String(), `hx-swap-oob="true"`) {
+		t.Error("response does not update the row")
+	}
+	// 戻す操作も履歴に残る
+	if latest := eventOf(todoEventTitle); latest.ID == title.ID || latest.NewValue.GetOrZero() != "original" {
+		t.Errorf("revert is not recorded: %+v", latest)
+	}
+
+	if rec := tc.do(http.MethodPost, revertPath(eventOf(todoEventCompleted)), url.Values{}); rec.Code != http.StatusBadRequest {
+		t.Errorf("revert completed: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	other := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(other.ID, 10)+"/events/"+strconv.FormatInt(title.ID, 10)+"/revert", url.Values{}); rec.Code != http.StatusNotFound {
+		t.Errorf("revert another todo's event: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,730 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type AttachmentMod interface {
+	Apply(context.Context, *AttachmentTemplate)
+}
+
+type AttachmentModFunc func(context.Context, *AttachmentTemplate)
+
+func (f AttachmentModFunc) Apply(ctx context.Context, n *AttachmentTemplate) {
+	f(ctx, n)
+}
+
+type AttachmentModSlice []AttachmentMod
+
+func (mods AttachmentModSlice) Apply(ctx context.Context, n *AttachmentTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// AttachmentTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type AttachmentTemplate struct {
+	ID           func() int64
+	TodoID       func() int64
+	UserID       func() int64
+	Filename     func() string
+	ContentType  func() string
+	Size         func() int64
+	Sha256       func() string
+	HasThumbnail func() bool
+	CreatedAt    func() time.Time
+
+	r attachmentR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type attachmentR struct {
+	User *attachmentRUserR
+	Todo *attachmentRTodoR
+}
+
+type attachmentRUserR struct {
+	o *UserTemplate
+}
+type attachmentRTodoR struct {
+	o *TodoTemplate
+}
+
+// Apply mods to the AttachmentTemplate
+func (o *AttachmentTemplate) Apply(ctx context.Context, mods ...AttachmentMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Attachment
+// according to the relationships in the template. Nothing is inserted into the db
+func (t AttachmentTemplate) setModelRels(o *models.Attachment) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Attachments = append(rel.R.Attachments, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.Todo != nil {
+		rel := t.r.Todo.o.Build()
+		rel.R.Attachments = append(rel.R.Attachments, o)
+		o.TodoID = rel.ID // h2
+		o.R.Todo = rel
+	}
+}
+
+// BuildSetter returns an *models.AttachmentSetter
+// this does nothing with the relationship templates
+func (o AttachmentTemplate) BuildSetter() *models.AttachmentSetter {
+	m := &models.AttachmentSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.TodoID != nil {
+		val := o.TodoID()
+		m.TodoID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Filename != nil {
+		val := o.Filename()
+		m.Filename = omit.From(val)
+	}
+	if o.ContentType != nil {
+		val := o.ContentType()
+		m.ContentType = omit.From(val)
+	}
+	if o.Size != nil {
+		val := o.Size()
+		m.Size = omit.From(val)
+	}
+	if o.Sha256 != nil {
+		val := o.Sha256()
+		m.Sha256 = omit.From(val)
+	}
+	if o.HasThumbnail != nil {
+		val := o.HasThumbnail()
+		m.HasThumbnail = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.AttachmentSetter
+// this does nothing with the relationship templates
+func (o AttachmentTemplate) BuildManySetter(number int) []*models.AttachmentSetter {
+	m := make([]*models.AttachmentSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Attachment
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AttachmentTemplate.Create
+func (o AttachmentTemplate) Build() *models.Attachment {
+	m := &models.Attachment{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.TodoID != nil {
+		m.TodoID = o.TodoID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Filename != nil {
+		m.Filename = o.Filename()
+	}
+	if o.ContentType != nil {
+		m.ContentType = o.ContentType()
+	}
+	if o.Size != nil {
+		m.Size = o.Size()
+	}
+	if o.Sha256 != nil {
+		m.Sha256 = o.Sha256()
+	}
+	if o.HasThumbnail != nil {
+		m.HasThumbnail = o.HasThumbnail()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.AttachmentSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AttachmentTemplate.CreateMany
+func (o AttachmentTemplate) BuildMany(number int) models.AttachmentSlice {
+	m := make(models.AttachmentSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableAttachment(m *models.AttachmentSetter) {
+	if !(m.TodoID.IsValue()) {
+		val := random_int64(nil)
+		m.TodoID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Filename.IsValue()) {
+		val := random_string(nil)
+		m.Filename = omit.From(val)
+	}
+	if !(m.ContentType.IsValue()) {
+		val := random_string(nil)
+		m.ContentType = omit.From(val)
+	}
+	if !(m.Size.IsValue()) {
+		val := random_int64(nil)
+		m.Size = omit.From(val)
+	}
+	if !(m.Sha256.IsValue()) {
+		val := random_string(nil)
+		m.Sha256 = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Attachment
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *AttachmentTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Attachment) error {
+	var err error
+
+	return err
+}
+
+// Create builds a attachment and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *AttachmentTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Attachment, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableAttachment(opt)
+
+	if o.r.User == nil {
+		AttachmentMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	if o.r.Todo == nil {
+		AttachmentMods.WithNewTodo().Apply(ctx, o)
+	}
+
+	var rel1 *models.Todo
+
+	if o.r.Todo.o.alreadyPersisted {
+		rel1 = o.r.Todo.o.Build()
+	} else {
+		rel1, err = o.r.Todo.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TodoID = omit.From(rel1.ID)
+
+	m, err := models.Attachments.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+	m.R.Todo = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a attachment and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *AttachmentTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Attachment {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a attachment and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *AttachmentTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Attachment {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple attachments and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o AttachmentTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.AttachmentSlice, error) {
+	var err error
+	m := make(models.AttachmentSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple attachments and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o AttachmentTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.AttachmentSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple attachments and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o AttachmentTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.AttachmentSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Attachment has methods that act as mods for the AttachmentTemplate
+var AttachmentMods attachmentMods
+
+type attachmentMods struct{}
+
+func (m attachmentMods) RandomizeAllColumns(f *faker.Faker) AttachmentMod {
+	return AttachmentModSlice{
+		AttachmentMods.RandomID(f),
+		AttachmentMods.RandomTodoID(f),
+		AttachmentMods.RandomUserID(f),
+		AttachmentMods.RandomFilename(f),
+		AttachmentMods.RandomContentType(f),
+		AttachmentMods.RandomSize(f),
+		AttachmentMods.RandomSha256(f),
+		AttachmentMods.RandomHasThumbnail(f),
+		AttachmentMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m attachmentMods) ID(val int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) IDFunc(f func() int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetID() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomID(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) TodoID(val int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.TodoID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) TodoIDFunc(f func() int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.TodoID = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetTodoID() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.TodoID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomTodoID(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.TodoID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) UserID(val int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) UserIDFunc(f func() int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetUserID() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomUserID(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) Filename(val string) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Filename = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) FilenameFunc(f func() string) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Filename = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetFilename() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Filename = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomFilename(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Filename = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) ContentType(val string) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ContentType = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) ContentTypeFunc(f func() string) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ContentType = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetContentType() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ContentType = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomContentType(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.ContentType = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) Size(val int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Size = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) SizeFunc(f func() int64) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Size = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetSize() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Size = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomSize(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Size = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) Sha256(val string) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Sha256 = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) Sha256Func(f func() string) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Sha256 = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetSha256() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Sha256 = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomSha256(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.Sha256 = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) HasThumbnail(val bool) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.HasThumbnail = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) HasThumbnailFunc(f func() bool) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.HasThumbnail = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetHasThumbnail() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.HasThumbnail = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomHasThumbnail(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.HasThumbnail = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m attachmentMods) CreatedAt(val time.Time) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m attachmentMods) CreatedAtFunc(f func() time.Time) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m attachmentMods) UnsetCreatedAt() AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m attachmentMods) RandomCreatedAt(f *faker.Faker) AttachmentMod {
+	return AttachmentModFunc(func(_ context.Context, o *AttachmentTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m attachmentMods) WithParentsCascading() AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		if isDone, _ := attachmentWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = attachmentWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithTodo(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m attachmentMods) WithUser(rel *UserTemplate) AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		o.r.User = &attachmentRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m attachmentMods) WithNewUser(mods ...UserMod) AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m attachmentMods) WithExistingUser(em *models.User) AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		o.r.User = &attachmentRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m attachmentMods) WithoutUser() AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m attachmentMods) WithTodo(rel *TodoTemplate) AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		o.r.Todo = &attachmentRTodoR{
+			o: rel,
+		}
+	})
+}
+
+func (m attachmentMods) WithNewTodo(mods ...TodoMod) AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithTodo(related).Apply(ctx, o)
+	})
+}
+
+func (m attachmentMods) WithExistingTodo(em *models.Todo) AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		o.r.Todo = &attachmentRTodoR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m attachmentMods) WithoutTodo() AttachmentMod {
+	return AttachmentModFunc(func(ctx context.Context, o *AttachmentTemplate) {
+		o.r.Todo = nil
+	})
+}
@@ -8,17 +8,114 @@ import "context"
 type contextKey string
 
 var (
+	// Relationship Contexts for attachments
+	attachmentWithParentsCascadingCtx = newContextual[bool]("attachmentWithParentsCascading")
+	attachmentRelUserCtx              = newContextual[bool]("attachments.users.fk_attachments_0")
+	attachmentRelTodoCtx              = newContextual[bool]("attachments.todos.fk_attachments_1")
+
+	// Relationship Contexts for checklist_template_items
+	checklistTemplateItemWithParentsCascadingCtx = newContextual[bool]("checklistTemplateItemWithParentsCascading")
+	checklistTemplateItemRelTemplateCtx          = newContextual[bool]("checklist_template_items.checklist_templates.fk_checklist_template_items_0")
+
+	// Relationship Contexts for checklist_templates
+	checklistTemplateWithParentsCascadingCtx = newContextual[bool]("checklistTemplateWithParentsCascading")
+	checklistTemplateRelItemsCtx             = newContextual[bool]("checklist_template_items.checklist_templates.fk_checklist_template_items_0")
+	checklistTemplateRelUserCtx              = newContextual[bool]("checklist_templates.users.fk_checklist_templates_0")
+
+	// Relationship Contexts for comments
+	commentWithParentsCascadingCtx = newContextual[bool]("commentWithParentsCascading")
+	commentRelParentCtx            = newContextual[bool]("comments.comments.fk_comments_0")
+	commentRelRepliesCtx           = newContextual[bool]("comments.comments.fk_comments_0")
+	commentRelUserCtx              = newContextual[bool]("comments.users.fk_comments_1")
+	commentRelTodoCtx              = newContextual[bool]("comments.todos.fk_comments_2")
+	commentRelNotificationsCtx     = newContextual[bool]("comments.notifications.fk_notifications_0")
+
 	// Relationship Contexts for goose_db_version
 	gooseDBVersionWithParentsCascadingCtx = newContextual[bool]("gooseDBVersionWithParentsCascading")
 
+	// Relationship Contexts for list_members
+	listMemberWithParentsCascadingCtx = newContextual[bool]("listMemberWithParentsCascading")
+	listMemberRelUserCtx              = newContextual[bool]("list_members.users.fk_list_members_0")
+	listMemberRelListCtx              = newContextual[bool]("list_members.lists.fk_list_members_1")
+
+	// Relationship Contexts for list_statuses
+	listStatusWithParentsCascadingCtx = newContextual[bool]("listStatusWithParentsCascading")
+	listStatusRelListCtx              = newContextual[bool]("list_statuses.lists.fk_list_statuses_0")
+	listStatusRelTodosCtx             = newContextual[bool]("list_statuses.todos.fk_todos_1")
+
+	// Relationship Contexts for lists
+	listWithParentsCascadingCtx = newContextual[bool]("listWithParentsCascading")
+	listRelListMembersCtx       = newContextual[bool]("list_members.lists.fk_list_members_1")
+	listRelListStatusesCtx      = newContextual[bool]("list_statuses.lists.fk_list_statuses_0")
+	listRelUserCtx              = newContextual[bool]("lists.users.fk_lists_0")
+	listRelTodosCtx             = newContextual[bool]("lists.todos.fk_todos_3")
+
+	// Relationship Contexts for notifications
+	notificationWithParentsCascadingCtx = newContextual[bool]("notificationWithParentsCascading")
+	notificationRelCommentCtx           = newContextual[bool]("comments.notifications.fk_notifications_0")
+	notificationRelTodoCtx              = newContextual[bool]("notifications.todos.fk_notifications_1")
+	notificationRelActorCtx             = newContextual[bool]("notifications.users.fk_notifications_2")
+	notificationRelUserCtx              = newContextual[bool]("notifications.users.fk_notifications_3")
+
 	// Relationship Contexts for sessions
 	sessionWithParentsCascadingCtx = newContextual[bool]("sessionWithParentsCascading")
 
+	// Relationship Contexts for tags
+	tagWithParentsCascadingCtx = newContextual[bool]("tagWithParentsCascading")
+	tagRelUserCtx              = newContextual[bool]("tags.users.fk_tags_0")
+	tagRelTodosCtx             = newContextual[bool]("tags.todos.fk_todo_tags_0fk_todo_tags_1")
+
+	// Relationship Contexts for time_entries
+	timeEntryWithParentsCascadingCtx = newContextual[bool]("timeEntryWithParentsCascading")
+	timeEntryRelUserCtx              = newContextual[bool]("time_entries.users.fk_time_entries_0")
+	timeEntryRelTodoCtx              = newContextual[bool]("time_entries.todos.fk_time_entries_1")
+
+	// Relationship Contexts for todo_dependencies
+	todoDependencyWithParentsCascadingCtx = newContextual[bool]("todoDependencyWithParentsCascading")
+	todoDependencyRelBlockerCtx           = newContextual[bool]("todo_dependencies.todos.fk_todo_dependencies_0")
+	todoDependencyRelTodoCtx              = newContextual[bool]("todo_dependencies.todos.fk_todo_dependencies_1")
+
+	// Relationship Contexts for todo_events
+	todoEventWithParentsCascadingCtx = newContextual[bool]("todoEventWithParentsCascading")
+	todoEventRelUserCtx              = newContextual[bool]("todo_events.users.fk_todo_events_0")
+	todoEventRelTodoCtx              = newContextual[bool]("todo_events.todos.fk_todo_events_1")
+
+	// Relationship Contexts for todo_tags
+	todoTagWithParentsCascadingCtx = newContextual[bool]("todoTagWithParentsCascading")
+	todoTagRelTagCtx               = newContextual[bool]("tags.todo_tags.fk_todo_tags_0")
+	todoTagRelTodoCtx              = newContextual[bool]("todo_tags.todos.fk_todo_tags_1")
+
 	// Relationship Contexts for todos
 	todoWithParentsCascadingCtx = newContextual[bool]("todoWithParentsCascading")
+	todoRelAttachmentsCtx       = newContextual[bool]("attachments.todos.fk_attachments_1")
+	todoRelCommentsCtx          = newContextual[bool]("comments.todos.fk_comments_2")
+	todoRelNotificationsCtx     = newContextual[bool]("notifications.todos.fk_notifications_1")
+	todoRelTimeEntriesCtx       = newContextual[bool]("time_entries.todos.fk_time_entries_1")
+	todoRelDependentsCtx        = newContextual[bool]("todo_dependencies.todos.fk_todo_dependencies_0")
+	todoRelDependenciesCtx      = newContextual[bool]("todo_dependencies.todos.fk_todo_dependencies_1")
+	todoRelTodoEventsCtx        = newContextual[bool]("todo_events.todos.fk_todo_events_1")
+	todoRelTagsCtx              = newContextual[bool]("tags.todos.fk_todo_tags_0fk_todo_tags_1")
+	todoRelAssigneeCtx          = newContextual[bool]("todos.users.fk_todos_0")
+	todoRelStatusCtx            = newContextual[bool]("list_statuses.todos.fk_todos_1")
+	todoRelParentCtx            = newContextual[bool]("todos.todos.fk_todos_2")
+	todoRelChildrenCtx          = newContextual[bool]("todos.todos.fk_todos_2")
+	todoRelListCtx              = newContextual[bool]("lists.todos.fk_todos_3")
+	todoRelUserCtx              = newContextual[bool]("todos.users.fk_todos_4")
 
 	// Relationship Contexts for users
-	userWithParentsCascadingCtx = newContextual[bool]("userWithParentsCascading")
+	userWithParentsCascadingCtx  = newContextual[bool]("userWithParentsCascading")
+	userRelAttachmentsCtx        = newContextual[bool]("attachments.users.fk_attachments_0")
+	userRelChecklistTemplatesCtx = newContextual[bool]("checklist_templates.users.fk_checklist_templates_0")
+	userRelCommentsCtx           = newContextual[bool]("comments.users.fk_comments_1")
+	userRelListMembersCtx        = newContextual[bool]("list_members.users.fk_list_members_0")
+	userRelListsCtx              = newContextual[bool]("lists.users.fk_lists_0")
+	userRelActorNotificationsCtx = newContextual[bool]("notifications.users.fk_notifications_2")
+	userRelNotificationsCtx      = newContextual[bool]("notifications.users.fk_notifications_3")
+	userRelTagsCtx               = newContextual[bool]("tags.users.fk_tags_0")
+	userRelTimeEntriesCtx        = newContextual[bool]("time_entries.users.fk_time_entries_0")
+	userRelTodoEventsCtx         = newContextual[bool]("todo_events.users.fk_todo_events_0")
+	userRelAssignedTodosCtx      = newContextual[bool]("todos.users.fk_todos_0")
+	userRelTodosCtx              = newContextual[bool]("todos.users.fk_todos_4")
 )
 
 // Contextual is a convienience wrapper around context.WithValue and context.Value
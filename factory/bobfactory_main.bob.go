@@ -12,16 +12,184 @@ import (
 )
 
 type Factory struct {
-	baseGooseDBVersionMods GooseDBVersionModSlice
-	baseSessionMods        SessionModSlice
-	baseTodoMods           TodoModSlice
-	baseUserMods           UserModSlice
+	baseAttachmentMods            AttachmentModSlice
+	baseChecklistTemplateItemMods ChecklistTemplateItemModSlice
+	baseChecklistTemplateMods     ChecklistTemplateModSlice
+	baseCommentMods               CommentModSlice
+	baseGooseDBVersionMods        GooseDBVersionModSlice
+	baseListMemberMods            ListMemberModSlice
+	baseListStatusMods            ListStatusModSlice
+	baseListMods                  ListModSlice
+	baseNotificationMods          NotificationModSlice
+	baseSessionMods               SessionModSlice
+	baseTagMods                   TagModSlice
+	baseTimeEntryMods             TimeEntryModSlice
+	baseTodoDependencyMods        TodoDependencyModSlice
+	baseTodoEventMods             TodoEventModSlice
+	baseTodoTagMods               TodoTagModSlice
+	baseTodoMods                  TodoModSlice
+	baseUserMods                  UserModSlice
 }
 
 func New() *Factory {
 	return &Factory{}
 }
 
+func (f *Factory) NewAttachment(mods ...AttachmentMod) *AttachmentTemplate {
+	return f.NewAttachmentWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAttachmentWithContext(ctx context.Context, mods ...AttachmentMod) *AttachmentTemplate {
+	o := &AttachmentTemplate{f: f}
+
+	if f != nil {
+		f.baseAttachmentMods.Apply(ctx, o)
+	}
+
+	AttachmentModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAttachment(m *models.Attachment) *AttachmentTemplate {
+	o := &AttachmentTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TodoID = func() int64 { return m.TodoID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Filename = func() string { return m.Filename }
+	o.ContentType = func() string { return m.ContentType }
+	o.Size = func() int64 { return m.Size }
+	o.Sha256 = func() string { return m.Sha256 }
+	o.HasThumbnail = func() bool { return m.HasThumbnail }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		AttachmentMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.Todo != nil {
+		AttachmentMods.WithExistingTodo(m.R.Todo).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewChecklistTemplateItem(mods ...ChecklistTemplateItemMod) *ChecklistTemplateItemTemplate {
+	return f.NewChecklistTemplateItemWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewChecklistTemplateItemWithContext(ctx context.Context, mods ...ChecklistTemplateItemMod) *ChecklistTemplateItemTemplate {
+	o := &ChecklistTemplateItemTemplate{f: f}
+
+	if f != nil {
+		f.baseChecklistTemplateItemMods.Apply(ctx, o)
+	}
+
+	ChecklistTemplateItemModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingChecklistTemplateItem(m *models.ChecklistTemplateItem) *ChecklistTemplateItemTemplate {
+	o := &ChecklistTemplateItemTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TemplateID = func() int64 { return m.TemplateID }
+	o.Title = func() string { return m.Title }
+	o.DueDays = func() null.Val[int64] { return m.DueDays }
+	o.Position = func() int64 { return m.Position }
+
+	ctx := context.Background()
+	if m.R.Template != nil {
+		ChecklistTemplateItemMods.WithExistingTemplate(m.R.Template).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewChecklistTemplate(mods ...ChecklistTemplateMod) *ChecklistTemplateTemplate {
+	return f.NewChecklistTemplateWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewChecklistTemplateWithContext(ctx context.Context, mods ...ChecklistTemplateMod) *ChecklistTemplateTemplate {
+	o := &ChecklistTemplateTemplate{f: f}
+
+	if f != nil {
+		f.baseChecklistTemplateMods.Apply(ctx, o)
+	}
+
+	ChecklistTemplateModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingChecklistTemplate(m *models.ChecklistTemplate) *ChecklistTemplateTemplate {
+	o := &ChecklistTemplateTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if len(m.R.Items) > 0 {
+		ChecklistTemplateMods.AddExistingItems(m.R.Items...).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		ChecklistTemplateMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewComment(mods ...CommentMod) *CommentTemplate {
+	return f.NewCommentWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewCommentWithContext(ctx context.Context, mods ...CommentMod) *CommentTemplate {
+	o := &CommentTemplate{f: f}
+
+	if f != nil {
+		f.baseCommentMods.Apply(ctx, o)
+	}
+
+	CommentModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingComment(m *models.Comment) *CommentTemplate {
+	o := &CommentTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TodoID = func() int64 { return m.TodoID }
+	o.UserID = func() int64 { return m.UserID }
+	o.ParentID = func() null.Val[int64] { return m.ParentID }
+	o.Body = func() string { return m.Body }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Parent != nil {
+		CommentMods.WithExistingParent(m.R.Parent).Apply(ctx, o)
+	}
+	if len(m.R.Replies) > 0 {
+		CommentMods.AddExistingReplies(m.R.Replies...).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		CommentMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.Todo != nil {
+		CommentMods.WithExistingTodo(m.R.Todo).Apply(ctx, o)
+	}
+	if len(m.R.Notifications) > 0 {
+		CommentMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewGooseDBVersion(mods ...GooseDBVersionMod) *GooseDBVersionTemplate {
 	return f.NewGooseDBVersionWithContext(context.Background(), mods...)
 }
@@ -49,6 +217,166 @@ func (f *Factory) FromExistingGooseDBVersion(m *models.GooseDBVersion) *GooseDBV
 	return o
 }
 
+func (f *Factory) NewListMember(mods ...ListMemberMod) *ListMemberTemplate {
+	return f.NewListMemberWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewListMemberWithContext(ctx context.Context, mods ...ListMemberMod) *ListMemberTemplate {
+	o := &ListMemberTemplate{f: f}
+
+	if f != nil {
+		f.baseListMemberMods.Apply(ctx, o)
+	}
+
+	ListMemberModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingListMember(m *models.ListMember) *ListMemberTemplate {
+	o := &ListMemberTemplate{f: f, alreadyPersisted: true}
+
+	o.ListID = func() int64 { return m.ListID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Role = func() string { return m.Role }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		ListMemberMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.List != nil {
+		ListMemberMods.WithExistingList(m.R.List).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewListStatus(mods ...ListStatusMod) *ListStatusTemplate {
+	return f.NewListStatusWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewListStatusWithContext(ctx context.Context, mods ...ListStatusMod) *ListStatusTemplate {
+	o := &ListStatusTemplate{f: f}
+
+	if f != nil {
+		f.baseListStatusMods.Apply(ctx, o)
+	}
+
+	ListStatusModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingListStatus(m *models.ListStatus) *ListStatusTemplate {
+	o := &ListStatusTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.ListID = func() int64 { return m.ListID }
+	o.Name = func() string { return m.Name }
+	o.Done = func() bool { return m.Done }
+	o.Position = func() int64 { return m.Position }
+
+	ctx := context.Background()
+	if m.R.List != nil {
+		ListStatusMods.WithExistingList(m.R.List).Apply(ctx, o)
+	}
+	if len(m.R.Todos) > 0 {
+		ListStatusMods.AddExistingTodos(m.R.Todos...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewList(mods ...ListMod) *ListTemplate {
+	return f.NewListWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewListWithContext(ctx context.Context, mods ...ListMod) *ListTemplate {
+	o := &ListTemplate{f: f}
+
+	if f != nil {
+		f.baseListMods.Apply(ctx, o)
+	}
+
+	ListModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingList(m *models.List) *ListTemplate {
+	o := &ListTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.Archived = func() bool { return m.Archived }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if len(m.R.ListMembers) > 0 {
+		ListMods.AddExistingListMembers(m.R.ListMembers...).Apply(ctx, o)
+	}
+	if len(m.R.ListStatuses) > 0 {
+		ListMods.AddExistingListStatuses(m.R.ListStatuses...).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		ListMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if len(m.R.Todos) > 0 {
+		ListMods.AddExistingTodos(m.R.Todos...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewNotification(mods ...NotificationMod) *NotificationTemplate {
+	return f.NewNotificationWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewNotificationWithContext(ctx context.Context, mods ...NotificationMod) *NotificationTemplate {
+	o := &NotificationTemplate{f: f}
+
+	if f != nil {
+		f.baseNotificationMods.Apply(ctx, o)
+	}
+
+	NotificationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingNotification(m *models.Notification) *NotificationTemplate {
+	o := &NotificationTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.ActorID = func() int64 { return m.ActorID }
+	o.Kind = func() string { return m.Kind }
+	o.TodoID = func() int64 { return m.TodoID }
+	o.CommentID = func() null.Val[int64] { return m.CommentID }
+	o.ReadAt = func() null.Val[time.Time] { return m.ReadAt }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Comment != nil {
+		NotificationMods.WithExistingComment(m.R.Comment).Apply(ctx, o)
+	}
+	if m.R.Todo != nil {
+		NotificationMods.WithExistingTodo(m.R.Todo).Apply(ctx, o)
+	}
+	if m.R.Actor != nil {
+		NotificationMods.WithExistingActor(m.R.Actor).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		NotificationMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewSession(mods ...SessionMod) *SessionTemplate {
 	return f.NewSessionWithContext(context.Background(), mods...)
 }
@@ -75,6 +403,184 @@ func (f *Factory) FromExistingSession(m *models.Session) *SessionTemplate {
 	return o
 }
 
+func (f *Factory) NewTag(mods ...TagMod) *TagTemplate {
+	return f.NewTagWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTagWithContext(ctx context.Context, mods ...TagMod) *TagTemplate {
+	o := &TagTemplate{f: f}
+
+	if f != nil {
+		f.baseTagMods.Apply(ctx, o)
+	}
+
+	TagModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTag(m *models.Tag) *TagTemplate {
+	o := &TagTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.Color = func() string { return m.Color }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		TagMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if len(m.R.Todos) > 0 {
+		TagMods.AddExistingTodos(m.R.Todos...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTimeEntry(mods ...TimeEntryMod) *TimeEntryTemplate {
+	return f.NewTimeEntryWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTimeEntryWithContext(ctx context.Context, mods ...TimeEntryMod) *TimeEntryTemplate {
+	o := &TimeEntryTemplate{f: f}
+
+	if f != nil {
+		f.baseTimeEntryMods.Apply(ctx, o)
+	}
+
+	TimeEntryModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTimeEntry(m *models.TimeEntry) *TimeEntryTemplate {
+	o := &TimeEntryTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TodoID = func() int64 { return m.TodoID }
+	o.UserID = func() int64 { return m.UserID }
+	o.StartedAt = func() time.Time { return m.StartedAt }
+	o.EndedAt = func() null.Val[time.Time] { return m.EndedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		TimeEntryMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.Todo != nil {
+		TimeEntryMods.WithExistingTodo(m.R.Todo).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTodoDependency(mods ...TodoDependencyMod) *TodoDependencyTemplate {
+	return f.NewTodoDependencyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTodoDependencyWithContext(ctx context.Context, mods ...TodoDependencyMod) *TodoDependencyTemplate {
+	o := &TodoDependencyTemplate{f: f}
+
+	if f != nil {
+		f.baseTodoDependencyMods.Apply(ctx, o)
+	}
+
+	TodoDependencyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTodoDependency(m *models.TodoDependency) *TodoDependencyTemplate {
+	o := &TodoDependencyTemplate{f: f, alreadyPersisted: true}
+
+	o.TodoID = func() int64 { return m.TodoID }
+	o.BlockerID = func() int64 { return m.BlockerID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Blocker != nil {
+		TodoDependencyMods.WithExistingBlocker(m.R.Blocker).Apply(ctx, o)
+	}
+	if m.R.Todo != nil {
+		TodoDependencyMods.WithExistingTodo(m.R.Todo).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTodoEvent(mods ...TodoEventMod) *TodoEventTemplate {
+	return f.NewTodoEventWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTodoEventWithContext(ctx context.Context, mods ...TodoEventMod) *TodoEventTemplate {
+	o := &TodoEventTemplate{f: f}
+
+	if f != nil {
+		f.baseTodoEventMods.Apply(ctx, o)
+	}
+
+	TodoEventModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTodoEvent(m *models.TodoEvent) *TodoEventTemplate {
+	o := &TodoEventTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.TodoID = func() int64 { return m.TodoID }
+	o.UserID = func() null.Val[int64] { return m.UserID }
+	o.Kind = func() string { return m.Kind }
+	o.OldValue = func() null.Val[string] { return m.OldValue }
+	o.NewValue = func() null.Val[string] { return m.NewValue }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		TodoEventMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if m.R.Todo != nil {
+		TodoEventMods.WithExistingTodo(m.R.Todo).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTodoTag(mods ...TodoTagMod) *TodoTagTemplate {
+	return f.NewTodoTagWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTodoTagWithContext(ctx context.Context, mods ...TodoTagMod) *TodoTagTemplate {
+	o := &TodoTagTemplate{f: f}
+
+	if f != nil {
+		f.baseTodoTagMods.Apply(ctx, o)
+	}
+
+	TodoTagModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTodoTag(m *models.TodoTag) *TodoTagTemplate {
+	o := &TodoTagTemplate{f: f, alreadyPersisted: true}
+
+	o.TodoID = func() int64 { return m.TodoID }
+	o.TagID = func() int64 { return m.TagID }
+
+	ctx := context.Background()
+	if m.R.Tag != nil {
+		TodoTagMods.WithExistingTag(m.R.Tag).Apply(ctx, o)
+	}
+	if m.R.Todo != nil {
+		TodoTagMods.WithExistingTodo(m.R.Todo).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewTodo(mods ...TodoMod) *TodoTemplate {
 	return f.NewTodoWithContext(context.Background(), mods...)
 }
@@ -95,10 +601,66 @@ func (f *Factory) FromExistingTodo(m *models.Todo) *TodoTemplate {
 	o := &TodoTemplate{f: f, alreadyPersisted: true}
 
 	o.ID = func() int64 { return m.ID }
+	o.UserID = func() int64 { return m.UserID }
 	o.Title = func() string { return m.Title }
 	o.Completed = func() bool { return m.Completed }
 	o.CreatedAt = func() time.Time { return m.CreatedAt }
 	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+	o.ListID = func() null.Val[int64] { return m.ListID }
+	o.DueAt = func() null.Val[time.Time] { return m.DueAt }
+	o.Priority = func() int64 { return m.Priority }
+	o.ParentID = func() null.Val[int64] { return m.ParentID }
+	o.Recurrence = func() null.Val[string] { return m.Recurrence }
+	o.Notes = func() string { return m.Notes }
+	o.Position = func() string { return m.Position }
+	o.DeletedAt = func() null.Val[time.Time] { return m.DeletedAt }
+	o.StatusID = func() null.Val[int64] { return m.StatusID }
+	o.AssigneeID = func() null.Val[int64] { return m.AssigneeID }
+	o.CompletedAt = func() null.Val[time.Time] { return m.CompletedAt }
+
+	ctx := context.Background()
+	if len(m.R.Attachments) > 0 {
+		TodoMods.AddExistingAttachments(m.R.Attachments...).Apply(ctx, o)
+	}
+	if len(m.R.Comments) > 0 {
+		TodoMods.AddExistingComments(m.R.Comments...).Apply(ctx, o)
+	}
+	if len(m.R.Notifications) > 0 {
+		TodoMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
+	if len(m.R.TimeEntries) > 0 {
+		TodoMods.AddExistingTimeEntries(m.R.TimeEntries...).Apply(ctx, o)
+	}
+	if len(m.R.Dependents) > 0 {
+		TodoMods.AddExistingDependents(m.R.Dependents...).Apply(ctx, o)
+	}
+	if len(m.R.Dependencies) > 0 {
+		TodoMods.AddExistingDependencies(m.R.Dependencies...).Apply(ctx, o)
+	}
+	if len(m.R.TodoEvents) > 0 {
+		TodoMods.AddExistingTodoEvents(m.R.TodoEvents...).Apply(ctx, o)
+	}
+	if len(m.R.Tags) > 0 {
+		TodoMods.AddExistingTags(m.R.Tags...).Apply(ctx, o)
+	}
+	if m.R.Assignee != nil {
+		TodoMods.WithExistingAssignee(m.R.Assignee).Apply(ctx, o)
+	}
+	if m.R.Status != nil {
+		TodoMods.WithExistingStatus(m.R.Status).Apply(ctx, o)
+	}
+	if m.R.Parent != nil {
+		TodoMods.WithExistingParent(m.R.Parent).Apply(ctx, o)
+	}
+	if len(m.R.Children) > 0 {
+		TodoMods.AddExistingChildren(m.R.Children...).Apply(ctx, o)
+	}
+	if m.R.List != nil {
+		TodoMods.WithExistingList(m.R.List).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		TodoMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
 
 	return o
 }
@@ -127,10 +689,83 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	o.Password = func() string { return m.Password }
 	o.CreatedAt = func() time.Time { return m.CreatedAt }
 	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+	o.Timezone = func() string { return m.Timezone }
+	o.TodoSort = func() string { return m.TodoSort }
+	o.TodoSortDir = func() string { return m.TodoSortDir }
+
+	ctx := context.Background()
+	if len(m.R.Attachments) > 0 {
+		UserMods.AddExistingAttachments(m.R.Attachments...).Apply(ctx, o)
+	}
+	if len(m.R.ChecklistTemplates) > 0 {
+		UserMods.AddExistingChecklistTemplates(m.R.ChecklistTemplates...).Apply(ctx, o)
+	}
+	if len(m.R.Comments) > 0 {
+		UserMods.AddExistingComments(m.R.Comments...).Apply(ctx, o)
+	}
+	if len(m.R.ListMembers) > 0 {
+		UserMods.AddExistingListMembers(m.R.ListMembers...).Apply(ctx, o)
+	}
+	if len(m.R.Lists) > 0 {
+		UserMods.AddExistingLists(m.R.Lists...).Apply(ctx, o)
+	}
+	if len(m.R.ActorNotifications) > 0 {
+		UserMods.AddExistingActorNotifications(m.R.ActorNotifications...).Apply(ctx, o)
+	}
+	if len(m.R.Notifications) > 0 {
+		UserMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
+	if len(m.R.Tags) > 0 {
+		UserMods.AddExistingTags(m.R.Tags...).Apply(ctx, o)
+	}
+	if len(m.R.TimeEntries) > 0 {
+		UserMods.AddExistingTimeEntries(m.R.TimeEntries...).Apply(ctx, o)
+	}
+	if len(m.R.TodoEvents) > 0 {
+		UserMods.AddExistingTodoEvents(m.R.TodoEvents...).Apply(ctx, o)
+	}
+	if len(m.R.AssignedTodos) > 0 {
+		UserMods.AddExistingAssignedTodos(m.R.AssignedTodos...).Apply(ctx, o)
+	}
+	if len(m.R.Todos) > 0 {
+		UserMods.AddExistingTodos(m.R.Todos...).Apply(ctx, o)
+	}
 
 	return o
 }
 
+func (f *Factory) ClearBaseAttachmentMods() {
+	f.baseAttachmentMods = nil
+}
+
+func (f *Factory) AddBaseAttachmentMod(mods ...AttachmentMod) {
+	f.baseAttachmentMods = append(f.baseAttachmentMods, mods...)
+}
+
+func (f *Factory) ClearBaseChecklistTemplateItemMods() {
+	f.baseChecklistTemplateItemMods = nil
+}
+
+func (f *Factory) AddBaseChecklistTemplateItemMod(mods ...ChecklistTemplateItemMod) {
+	f.baseChecklistTemplateItemMods = append(f.baseChecklistTemplateItemMods, mods...)
+}
+
+func (f *Factory) ClearBaseChecklistTemplateMods() {
+	f.baseChecklistTemplateMods = nil
+}
+
+func (f *Factory) AddBaseChecklistTemplateMod(mods ...ChecklistTemplateMod) {
+	f.baseChecklistTemplateMods = append(f.baseChecklistTemplateMods, mods...)
+}
+
+func (f *Factory) ClearBaseCommentMods() {
+	f.baseCommentMods = nil
+}
+
+func (f *Factory) AddBaseCommentMod(mods ...CommentMod) {
+	f.baseCommentMods = append(f.baseCommentMods, mods...)
+}
+
 func (f *Factory) ClearBaseGooseDBVersionMods() {
 	f.baseGooseDBVersionMods = nil
 }
@@ -139,6 +774,38 @@ func (f *Factory) AddBaseGooseDBVersionMod(mods ...GooseDBVersionMod) {
 	f.baseGooseDBVersionMods = append(f.baseGooseDBVersionMods, mods...)
 }
 
+func (f *Factory) ClearBaseListMemberMods() {
+	f.baseListMemberMods = nil
+}
+
+func (f *Factory) AddBaseListMemberMod(mods ...ListMemberMod) {
+	f.baseListMemberMods = append(f.baseListMemberMods, mods...)
+}
+
+func (f *Factory) ClearBaseListStatusMods() {
+	f.baseListStatusMods = nil
+}
+
+func (f *Factory) AddBaseListStatusMod(mods ...ListStatusMod) {
+	f.baseListStatusMods = append(f.baseListStatusMods, mods...)
+}
+
+func (f *Factory) ClearBaseListMods() {
+	f.baseListMods = nil
+}
+
+func (f *Factory) AddBaseListMod(mods ...ListMod) {
+	f.baseListMods = append(f.baseListMods, mods...)
+}
+
+func (f *Factory) ClearBaseNotificationMods() {
+	f.baseNotificationMods = nil
+}
+
+func (f *Factory) AddBaseNotificationMod(mods ...NotificationMod) {
+	f.baseNotificationMods = append(f.baseNotificationMods, mods...)
+}
+
 func (f *Factory) ClearBaseSessionMods() {
 	f.baseSessionMods = nil
 }
@@ -147,6 +814,46 @@ func (f *Factory) AddBaseSessionMod(mods ...SessionMod) {
 	f.baseSessionMods = append(f.baseSessionMods, mods...)
 }
 
+func (f *Factory) ClearBaseTagMods() {
+	f.baseTagMods = nil
+}
+
+func (f *Factory) AddBaseTagMod(mods ...TagMod) {
+	f.baseTagMods = append(f.baseTagMods, mods...)
+}
+
+func (f *Factory) ClearBaseTimeEntryMods() {
+	f.baseTimeEntryMods = nil
+}
+
+func (f *Factory) AddBaseTimeEntryMod(mods ...TimeEntryMod) {
+	f.baseTimeEntryMods = append(f.baseTimeEntryMods, mods...)
+}
+
+func (f *Factory) ClearBaseTodoDependencyMods() {
+	f.baseTodoDependencyMods = nil
+}
+
+func (f *Factory) AddBaseTodoDependencyMod(mods ...TodoDependencyMod) {
+	f.baseTodoDependencyMods = append(f.baseTodoDependencyMods, mods...)
+}
+
+func (f *Factory) ClearBaseTodoEventMods() {
+	f.baseTodoEventMods = nil
+}
+
+func (f *Factory) AddBaseTodoEventMod(mods ...TodoEventMod) {
+	f.baseTodoEventMods = append(f.baseTodoEventMods, mods...)
+}
+
+func (f *Factory) ClearBaseTodoTagMods() {
+	f.baseTodoTagMods = nil
+}
+
+func (f *Factory) AddBaseTodoTagMod(mods ...TodoTagMod) {
+	f.baseTodoTagMods = append(f.baseTodoTagMods, mods...)
+}
+
 func (f *Factory) ClearBaseTodoMods() {
 	f.baseTodoMods = nil
 }
@@ -0,0 +1,513 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type ChecklistTemplateItemMod interface {
+	Apply(context.Context, *ChecklistTemplateItemTemplate)
+}
+
+type ChecklistTemplateItemModFunc func(context.Context, *ChecklistTemplateItemTemplate)
+
+func (f ChecklistTemplateItemModFunc) Apply(ctx context.Context, n *ChecklistTemplateItemTemplate) {
+	f(ctx, n)
+}
+
+type ChecklistTemplateItemModSlice []ChecklistTemplateItemMod
+
+func (mods ChecklistTemplateItemModSlice) Apply(ctx context.Context, n *ChecklistTemplateItemTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ChecklistTemplateItemTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ChecklistTemplateItemTemplate struct {
+	ID         func() int64
+	TemplateID func() int64
+	Title      func() string
+	DueDays    func() null.Val[int64]
+	Position   func() int64
+
+	r checklistTemplateItemR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type checklistTemplateItemR struct {
+	Template *checklistTemplateItemRTemplateR
+}
+
+type checklistTemplateItemRTemplateR struct {
+	o *ChecklistTemplateTemplate
+}
+
+// Apply mods to the ChecklistTemplateItemTemplate
+func (o *ChecklistTemplateItemTemplate) Apply(ctx context.Context, mods ...ChecklistTemplateItemMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.ChecklistTemplateItem
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ChecklistTemplateItemTemplate) setModelRels(o *models.ChecklistTemplateItem) {
+	if t.r.Template != nil {
+		rel := t.r.Template.o.Build()
+		rel.R.Items = append(rel.R.Items, o)
+		o.TemplateID = rel.ID // h2
+		o.R.Template = rel
+	}
+}
+
+// BuildSetter returns an *models.ChecklistTemplateItemSetter
+// this does nothing with the relationship templates
+func (o ChecklistTemplateItemTemplate) BuildSetter() *models.ChecklistTemplateItemSetter {
+	m := &models.ChecklistTemplateItemSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.TemplateID != nil {
+		val := o.TemplateID()
+		m.TemplateID = omit.From(val)
+	}
+	if o.Title != nil {
+		val := o.Title()
+		m.Title = omit.From(val)
+	}
+	if o.DueDays != nil {
+		val := o.DueDays()
+		m.DueDays = omitnull.FromNull(val)
+	}
+	if o.Position != nil {
+		val := o.Position()
+		m.Position = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ChecklistTemplateItemSetter
+// this does nothing with the relationship templates
+func (o ChecklistTemplateItemTemplate) BuildManySetter(number int) []*models.ChecklistTemplateItemSetter {
+	m := make([]*models.ChecklistTemplateItemSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.ChecklistTemplateItem
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ChecklistTemplateItemTemplate.Create
+func (o ChecklistTemplateItemTemplate) Build() *models.ChecklistTemplateItem {
+	m := &models.ChecklistTemplateItem{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.TemplateID != nil {
+		m.TemplateID = o.TemplateID()
+	}
+	if o.Title != nil {
+		m.Title = o.Title()
+	}
+	if o.DueDays != nil {
+		m.DueDays = o.DueDays()
+	}
+	if o.Position != nil {
+		m.Position = o.Position()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ChecklistTemplateItemSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ChecklistTemplateItemTemplate.CreateMany
+func (o ChecklistTemplateItemTemplate) BuildMany(number int) models.ChecklistTemplateItemSlice {
+	m := make(models.ChecklistTemplateItemSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableChecklistTemplateItem(m *models.ChecklistTemplateItemSetter) {
+	if !(m.TemplateID.IsValue()) {
+		val := random_int64(nil)
+		m.TemplateID = omit.From(val)
+	}
+	if !(m.Title.IsValue()) {
+		val := random_string(nil)
+		m.Title = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.ChecklistTemplateItem
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ChecklistTemplateItemTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.ChecklistTemplateItem) error {
+	var err error
+
+	return err
+}
+
+// Create builds a checklistTemplateItem and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ChecklistTemplateItemTemplate) Create(ctx context.Context, exec bob.Executor) (*models.ChecklistTemplateItem, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableChecklistTemplateItem(opt)
+
+	if o.r.Template == nil {
+		ChecklistTemplateItemMods.WithNewTemplate().Apply(ctx, o)
+	}
+
+	var rel0 *models.ChecklistTemplate
+
+	if o.r.Template.o.alreadyPersisted {
+		rel0 = o.r.Template.o.Build()
+	} else {
+		rel0, err = o.r.Template.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TemplateID = omit.From(rel0.ID)
+
+	m, err := models.ChecklistTemplateItems.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Template = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a checklistTemplateItem and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ChecklistTemplateItemTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.ChecklistTemplateItem {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a checklistTemplateItem and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ChecklistTemplateItemTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.ChecklistTemplateItem {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple checklistTemplateItems and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ChecklistTemplateItemTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ChecklistTemplateItemSlice, error) {
+	var err error
+	m := make(models.ChecklistTemplateItemSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple checklistTemplateItems and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ChecklistTemplateItemTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ChecklistTemplateItemSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple checklistTemplateItems and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ChecklistTemplateItemTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ChecklistTemplateItemSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// ChecklistTemplateItem has methods that act as mods for the ChecklistTemplateItemTemplate
+var ChecklistTemplateItemMods checklistTemplateItemMods
+
+type checklistTemplateItemMods struct{}
+
+func (m checklistTemplateItemMods) RandomizeAllColumns(f *faker.Faker) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModSlice{
+		ChecklistTemplateItemMods.RandomID(f),
+		ChecklistTemplateItemMods.RandomTemplateID(f),
+		ChecklistTemplateItemMods.RandomTitle(f),
+		ChecklistTemplateItemMods.RandomDueDays(f),
+		ChecklistTemplateItemMods.RandomPosition(f),
+	}
+}
+
+// Set the model columns to this value
+func (m checklistTemplateItemMods) ID(val int64) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateItemMods) IDFunc(f func() int64) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateItemMods) UnsetID() ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateItemMods) RandomID(f *faker.Faker) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateItemMods) TemplateID(val int64) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.TemplateID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateItemMods) TemplateIDFunc(f func() int64) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.TemplateID = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateItemMods) UnsetTemplateID() ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.TemplateID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateItemMods) RandomTemplateID(f *faker.Faker) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.TemplateID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateItemMods) Title(val string) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Title = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateItemMods) TitleFunc(f func() string) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Title = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateItemMods) UnsetTitle() ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Title = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateItemMods) RandomTitle(f *faker.Faker) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Title = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateItemMods) DueDays(val null.Val[int64]) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.DueDays = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateItemMods) DueDaysFunc(f func() null.Val[int64]) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.DueDays = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateItemMods) UnsetDueDays() ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.DueDays = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m checklistTemplateItemMods) RandomDueDays(f *faker.Faker) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.DueDays = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m checklistTemplateItemMods) RandomDueDaysNotNull(f *faker.Faker) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.DueDays = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateItemMods) Position(val int64) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Position = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateItemMods) PositionFunc(f func() int64) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Position = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateItemMods) UnsetPosition() ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Position = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateItemMods) RandomPosition(f *faker.Faker) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(_ context.Context, o *ChecklistTemplateItemTemplate) {
+		o.Position = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+func (m checklistTemplateItemMods) WithParentsCascading() ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(ctx context.Context, o *ChecklistTemplateItemTemplate) {
+		if isDone, _ := checklistTemplateItemWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = checklistTemplateItemWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewChecklistTemplateWithContext(ctx, ChecklistTemplateMods.WithParentsCascading())
+			m.WithTemplate(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m checklistTemplateItemMods) WithTemplate(rel *ChecklistTemplateTemplate) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(ctx context.Context, o *ChecklistTemplateItemTemplate) {
+		o.r.Template = &checklistTemplateItemRTemplateR{
+			o: rel,
+		}
+	})
+}
+
+func (m checklistTemplateItemMods) WithNewTemplate(mods ...ChecklistTemplateMod) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(ctx context.Context, o *ChecklistTemplateItemTemplate) {
+		related := o.f.NewChecklistTemplateWithContext(ctx, mods...)
+
+		m.WithTemplate(related).Apply(ctx, o)
+	})
+}
+
+func (m checklistTemplateItemMods) WithExistingTemplate(em *models.ChecklistTemplate) ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(ctx context.Context, o *ChecklistTemplateItemTemplate) {
+		o.r.Template = &checklistTemplateItemRTemplateR{
+			o: o.f.FromExistingChecklistTemplate(em),
+		}
+	})
+}
+
+func (m checklistTemplateItemMods) WithoutTemplate() ChecklistTemplateItemMod {
+	return ChecklistTemplateItemModFunc(func(ctx context.Context, o *ChecklistTemplateItemTemplate) {
+		o.r.Template = nil
+	})
+}
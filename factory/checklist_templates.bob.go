@@ -0,0 +1,576 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type ChecklistTemplateMod interface {
+	Apply(context.Context, *ChecklistTemplateTemplate)
+}
+
+type ChecklistTemplateModFunc func(context.Context, *ChecklistTemplateTemplate)
+
+func (f ChecklistTemplateModFunc) Apply(ctx context.Context, n *ChecklistTemplateTemplate) {
+	f(ctx, n)
+}
+
+type ChecklistTemplateModSlice []ChecklistTemplateMod
+
+func (mods ChecklistTemplateModSlice) Apply(ctx context.Context, n *ChecklistTemplateTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ChecklistTemplateTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ChecklistTemplateTemplate struct {
+	ID        func() int64
+	UserID    func() int64
+	Name      func() string
+	CreatedAt func() time.Time
+	UpdatedAt func() time.Time
+
+	r checklistTemplateR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type checklistTemplateR struct {
+	Items []*checklistTemplateRItemsR
+	User  *checklistTemplateRUserR
+}
+
+type checklistTemplateRItemsR struct {
+	number int
+	o      *ChecklistTemplateItemTemplate
+}
+type checklistTemplateRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the ChecklistTemplateTemplate
+func (o *ChecklistTemplateTemplate) Apply(ctx context.Context, mods ...ChecklistTemplateMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.ChecklistTemplate
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ChecklistTemplateTemplate) setModelRels(o *models.ChecklistTemplate) {
+	if t.r.Items != nil {
+		rel := models.ChecklistTemplateItemSlice{}
+		for _, r := range t.r.Items {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TemplateID = o.ID // h2
+				rel.R.Template = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Items = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.ChecklistTemplates = append(rel.R.ChecklistTemplates, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.ChecklistTemplateSetter
+// this does nothing with the relationship templates
+func (o ChecklistTemplateTemplate) BuildSetter() *models.ChecklistTemplateSetter {
+	m := &models.ChecklistTemplateSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ChecklistTemplateSetter
+// this does nothing with the relationship templates
+func (o ChecklistTemplateTemplate) BuildManySetter(number int) []*models.ChecklistTemplateSetter {
+	m := make([]*models.ChecklistTemplateSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.ChecklistTemplate
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ChecklistTemplateTemplate.Create
+func (o ChecklistTemplateTemplate) Build() *models.ChecklistTemplate {
+	m := &models.ChecklistTemplate{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ChecklistTemplateSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ChecklistTemplateTemplate.CreateMany
+func (o ChecklistTemplateTemplate) BuildMany(number int) models.ChecklistTemplateSlice {
+	m := make(models.ChecklistTemplateSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableChecklistTemplate(m *models.ChecklistTemplateSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil)
+		m.Name = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.ChecklistTemplate
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ChecklistTemplateTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.ChecklistTemplate) error {
+	var err error
+
+	isItemsDone, _ := checklistTemplateRelItemsCtx.Value(ctx)
+	if !isItemsDone && o.r.Items != nil {
+		ctx = checklistTemplateRelItemsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Items {
+			if r.o.alreadyPersisted {
+				m.R.Items = append(m.R.Items, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachItems(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a checklistTemplate and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ChecklistTemplateTemplate) Create(ctx context.Context, exec bob.Executor) (*models.ChecklistTemplate, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableChecklistTemplate(opt)
+
+	if o.r.User == nil {
+		ChecklistTemplateMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.ChecklistTemplates.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a checklistTemplate and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ChecklistTemplateTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.ChecklistTemplate {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a checklistTemplate and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ChecklistTemplateTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.ChecklistTemplate {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple checklistTemplates and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ChecklistTemplateTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ChecklistTemplateSlice, error) {
+	var err error
+	m := make(models.ChecklistTemplateSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple checklistTemplates and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ChecklistTemplateTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ChecklistTemplateSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple checklistTemplates and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ChecklistTemplateTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ChecklistTemplateSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// ChecklistTemplate has methods that act as mods for the ChecklistTemplateTemplate
+var ChecklistTemplateMods checklistTemplateMods
+
+type checklistTemplateMods struct{}
+
+func (m checklistTemplateMods) RandomizeAllColumns(f *faker.Faker) ChecklistTemplateMod {
+	return ChecklistTemplateModSlice{
+		ChecklistTemplateMods.RandomID(f),
+		ChecklistTemplateMods.RandomUserID(f),
+		ChecklistTemplateMods.RandomName(f),
+		ChecklistTemplateMods.RandomCreatedAt(f),
+		ChecklistTemplateMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m checklistTemplateMods) ID(val int64) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateMods) IDFunc(f func() int64) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateMods) UnsetID() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateMods) RandomID(f *faker.Faker) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateMods) UserID(val int64) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateMods) UserIDFunc(f func() int64) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateMods) UnsetUserID() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateMods) RandomUserID(f *faker.Faker) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateMods) Name(val string) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateMods) NameFunc(f func() string) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateMods) UnsetName() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateMods) RandomName(f *faker.Faker) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.Name = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateMods) CreatedAt(val time.Time) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateMods) CreatedAtFunc(f func() time.Time) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateMods) UnsetCreatedAt() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateMods) RandomCreatedAt(f *faker.Faker) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m checklistTemplateMods) UpdatedAt(val time.Time) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m checklistTemplateMods) UpdatedAtFunc(f func() time.Time) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m checklistTemplateMods) UnsetUpdatedAt() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m checklistTemplateMods) RandomUpdatedAt(f *faker.Faker) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(_ context.Context, o *ChecklistTemplateTemplate) {
+		o.UpdatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m checklistTemplateMods) WithParentsCascading() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		if isDone, _ := checklistTemplateWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = checklistTemplateWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m checklistTemplateMods) WithUser(rel *UserTemplate) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		o.r.User = &checklistTemplateRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m checklistTemplateMods) WithNewUser(mods ...UserMod) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m checklistTemplateMods) WithExistingUser(em *models.User) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		o.r.User = &checklistTemplateRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m checklistTemplateMods) WithoutUser() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m checklistTemplateMods) WithItems(number int, related *ChecklistTemplateItemTemplate) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		o.r.Items = []*checklistTemplateRItemsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m checklistTemplateMods) WithNewItems(number int, mods ...ChecklistTemplateItemMod) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		related := o.f.NewChecklistTemplateItemWithContext(ctx, mods...)
+		m.WithItems(number, related).Apply(ctx, o)
+	})
+}
+
+func (m checklistTemplateMods) AddItems(number int, related *ChecklistTemplateItemTemplate) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		o.r.Items = append(o.r.Items, &checklistTemplateRItemsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m checklistTemplateMods) AddNewItems(number int, mods ...ChecklistTemplateItemMod) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		related := o.f.NewChecklistTemplateItemWithContext(ctx, mods...)
+		m.AddItems(number, related).Apply(ctx, o)
+	})
+}
+
+func (m checklistTemplateMods) AddExistingItems(existingModels ...*models.ChecklistTemplateItem) ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		for _, em := range existingModels {
+			o.r.Items = append(o.r.Items, &checklistTemplateRItemsR{
+				o: o.f.FromExistingChecklistTemplateItem(em),
+			})
+		}
+	})
+}
+
+func (m checklistTemplateMods) WithoutItems() ChecklistTemplateMod {
+	return ChecklistTemplateModFunc(func(ctx context.Context, o *ChecklistTemplateTemplate) {
+		o.r.Items = nil
+	})
+}
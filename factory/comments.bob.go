@@ -0,0 +1,859 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type CommentMod interface {
+	Apply(context.Context, *CommentTemplate)
+}
+
+type CommentModFunc func(context.Context, *CommentTemplate)
+
+func (f CommentModFunc) Apply(ctx context.Context, n *CommentTemplate) {
+	f(ctx, n)
+}
+
+type CommentModSlice []CommentMod
+
+func (mods CommentModSlice) Apply(ctx context.Context, n *CommentTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// CommentTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type CommentTemplate struct {
+	ID        func() int64
+	TodoID    func() int64
+	UserID    func() int64
+	ParentID  func() null.Val[int64]
+	Body      func() string
+	CreatedAt func() time.Time
+
+	r commentR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type commentR struct {
+	Parent        *commentRParentR
+	Replies       []*commentRRepliesR
+	User          *commentRUserR
+	Todo          *commentRTodoR
+	Notifications []*commentRNotificationsR
+}
+
+type commentRParentR struct {
+	o *CommentTemplate
+}
+type commentRRepliesR struct {
+	number int
+	o      *CommentTemplate
+}
+type commentRUserR struct {
+	o *UserTemplate
+}
+type commentRTodoR struct {
+	o *TodoTemplate
+}
+type commentRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+
+// Apply mods to the CommentTemplate
+func (o *CommentTemplate) Apply(ctx context.Context, mods ...CommentMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Comment
+// according to the relationships in the template. Nothing is inserted into the db
+func (t CommentTemplate) setModelRels(o *models.Comment) {
+	if t.r.Parent != nil {
+		rel := t.r.Parent.o.Build()
+		rel.R.Parent = o
+		o.ParentID = null.From(rel.ID) // h2
+		o.R.Parent = rel
+	}
+
+	if t.r.Replies != nil {
+		rel := models.CommentSlice{}
+		for _, r := range t.r.Replies {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ParentID = null.From(o.ID) // h2
+				rel.R.Replies = append(rel.R.Replies, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Replies = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Comments = append(rel.R.Comments, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.Todo != nil {
+		rel := t.r.Todo.o.Build()
+		rel.R.Comments = append(rel.R.Comments, o)
+		o.TodoID = rel.ID // h2
+		o.R.Todo = rel
+	}
+
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.CommentID = null.From(o.ID) // h2
+				rel.R.Comment = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+}
+
+// BuildSetter returns an *models.CommentSetter
+// this does nothing with the relationship templates
+func (o CommentTemplate) BuildSetter() *models.CommentSetter {
+	m := &models.CommentSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.TodoID != nil {
+		val := o.TodoID()
+		m.TodoID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.ParentID != nil {
+		val := o.ParentID()
+		m.ParentID = omitnull.FromNull(val)
+	}
+	if o.Body != nil {
+		val := o.Body()
+		m.Body = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.CommentSetter
+// this does nothing with the relationship templates
+func (o CommentTemplate) BuildManySetter(number int) []*models.CommentSetter {
+	m := make([]*models.CommentSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Comment
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use CommentTemplate.Create
+func (o CommentTemplate) Build() *models.Comment {
+	m := &models.Comment{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.TodoID != nil {
+		m.TodoID = o.TodoID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.ParentID != nil {
+		m.ParentID = o.ParentID()
+	}
+	if o.Body != nil {
+		m.Body = o.Body()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.CommentSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use CommentTemplate.CreateMany
+func (o CommentTemplate) BuildMany(number int) models.CommentSlice {
+	m := make(models.CommentSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableComment(m *models.CommentSetter) {
+	if !(m.TodoID.IsValue()) {
+		val := random_int64(nil)
+		m.TodoID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Body.IsValue()) {
+		val := random_string(nil)
+		m.Body = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Comment
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *CommentTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Comment) error {
+	var err error
+
+	isParentDone, _ := commentRelParentCtx.Value(ctx)
+	if !isParentDone && o.r.Parent != nil {
+		ctx = commentRelParentCtx.WithValue(ctx, true)
+		if o.r.Parent.o.alreadyPersisted {
+			m.R.Parent = o.r.Parent.o.Build()
+		} else {
+			var rel0 *models.Comment
+			rel0, err = o.r.Parent.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachParent(ctx, exec, rel0)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isRepliesDone, _ := commentRelRepliesCtx.Value(ctx)
+	if !isRepliesDone && o.r.Replies != nil {
+		ctx = commentRelRepliesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Replies {
+			if r.o.alreadyPersisted {
+				m.R.Replies = append(m.R.Replies, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachReplies(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationsDone, _ := commentRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = commentRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
+			if r.o.alreadyPersisted {
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotifications(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a comment and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *CommentTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Comment, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableComment(opt)
+
+	if o.r.User == nil {
+		CommentMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel2 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel2 = o.r.User.o.Build()
+	} else {
+		rel2, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel2.ID)
+
+	if o.r.Todo == nil {
+		CommentMods.WithNewTodo().Apply(ctx, o)
+	}
+
+	var rel3 *models.Todo
+
+	if o.r.Todo.o.alreadyPersisted {
+		rel3 = o.r.Todo.o.Build()
+	} else {
+		rel3, err = o.r.Todo.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TodoID = omit.From(rel3.ID)
+
+	m, err := models.Comments.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel2
+	m.R.Todo = rel3
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a comment and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *CommentTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Comment {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a comment and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *CommentTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Comment {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple comments and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o CommentTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.CommentSlice, error) {
+	var err error
+	m := make(models.CommentSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple comments and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o CommentTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.CommentSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple comments and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o CommentTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.CommentSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Comment has methods that act as mods for the CommentTemplate
+var CommentMods commentMods
+
+type commentMods struct{}
+
+func (m commentMods) RandomizeAllColumns(f *faker.Faker) CommentMod {
+	return CommentModSlice{
+		CommentMods.RandomID(f),
+		CommentMods.RandomTodoID(f),
+		CommentMods.RandomUserID(f),
+		CommentMods.RandomParentID(f),
+		CommentMods.RandomBody(f),
+		CommentMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m commentMods) ID(val int64) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m commentMods) IDFunc(f func() int64) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m commentMods) UnsetID() CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m commentMods) RandomID(f *faker.Faker) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m commentMods) TodoID(val int64) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.TodoID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m commentMods) TodoIDFunc(f func() int64) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.TodoID = f
+	})
+}
+
+// Clear any values for the column
+func (m commentMods) UnsetTodoID() CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.TodoID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m commentMods) RandomTodoID(f *faker.Faker) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.TodoID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m commentMods) UserID(val int64) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m commentMods) UserIDFunc(f func() int64) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m commentMods) UnsetUserID() CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m commentMods) RandomUserID(f *faker.Faker) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m commentMods) ParentID(val null.Val[int64]) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ParentID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m commentMods) ParentIDFunc(f func() null.Val[int64]) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ParentID = f
+	})
+}
+
+// Clear any values for the column
+func (m commentMods) UnsetParentID() CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ParentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m commentMods) RandomParentID(f *faker.Faker) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ParentID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m commentMods) RandomParentIDNotNull(f *faker.Faker) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.ParentID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m commentMods) Body(val string) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.Body = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m commentMods) BodyFunc(f func() string) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.Body = f
+	})
+}
+
+// Clear any values for the column
+func (m commentMods) UnsetBody() CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.Body = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m commentMods) RandomBody(f *faker.Faker) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.Body = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m commentMods) CreatedAt(val time.Time) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m commentMods) CreatedAtFunc(f func() time.Time) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m commentMods) UnsetCreatedAt() CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m commentMods) RandomCreatedAt(f *faker.Faker) CommentMod {
+	return CommentModFunc(func(_ context.Context, o *CommentTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m commentMods) WithParentsCascading() CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		if isDone, _ := commentWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = commentWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewCommentWithContext(ctx, CommentMods.WithParentsCascading())
+			m.WithParent(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithTodo(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m commentMods) WithParent(rel *CommentTemplate) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Parent = &commentRParentR{
+			o: rel,
+		}
+	})
+}
+
+func (m commentMods) WithNewParent(mods ...CommentMod) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+
+		m.WithParent(related).Apply(ctx, o)
+	})
+}
+
+func (m commentMods) WithExistingParent(em *models.Comment) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Parent = &commentRParentR{
+			o: o.f.FromExistingComment(em),
+		}
+	})
+}
+
+func (m commentMods) WithoutParent() CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Parent = nil
+	})
+}
+
+func (m commentMods) WithUser(rel *UserTemplate) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.User = &commentRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m commentMods) WithNewUser(mods ...UserMod) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m commentMods) WithExistingUser(em *models.User) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.User = &commentRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m commentMods) WithoutUser() CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m commentMods) WithTodo(rel *TodoTemplate) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Todo = &commentRTodoR{
+			o: rel,
+		}
+	})
+}
+
+func (m commentMods) WithNewTodo(mods ...TodoMod) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithTodo(related).Apply(ctx, o)
+	})
+}
+
+func (m commentMods) WithExistingTodo(em *models.Todo) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Todo = &commentRTodoR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m commentMods) WithoutTodo() CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Todo = nil
+	})
+}
+
+func (m commentMods) WithReplies(number int, related *CommentTemplate) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Replies = []*commentRRepliesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m commentMods) WithNewReplies(number int, mods ...CommentMod) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.WithReplies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m commentMods) AddReplies(number int, related *CommentTemplate) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Replies = append(o.r.Replies, &commentRRepliesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m commentMods) AddNewReplies(number int, mods ...CommentMod) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.AddReplies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m commentMods) AddExistingReplies(existingModels ...*models.Comment) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		for _, em := range existingModels {
+			o.r.Replies = append(o.r.Replies, &commentRRepliesR{
+				o: o.f.FromExistingComment(em),
+			})
+		}
+	})
+}
+
+func (m commentMods) WithoutReplies() CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Replies = nil
+	})
+}
+
+func (m commentMods) WithNotifications(number int, related *NotificationTemplate) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Notifications = []*commentRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m commentMods) WithNewNotifications(number int, mods ...NotificationMod) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m commentMods) AddNotifications(number int, related *NotificationTemplate) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &commentRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m commentMods) AddNewNotifications(number int, mods ...NotificationMod) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m commentMods) AddExistingNotifications(existingModels ...*models.Notification) CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &commentRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m commentMods) WithoutNotifications() CommentMod {
+	return CommentModFunc(func(ctx context.Context, o *CommentTemplate) {
+		o.r.Notifications = nil
+	})
+}
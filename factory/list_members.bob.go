@@ -0,0 +1,558 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type ListMemberMod interface {
+	Apply(context.Context, *ListMemberTemplate)
+}
+
+type ListMemberModFunc func(context.Context, *ListMemberTemplate)
+
+func (f ListMemberModFunc) Apply(ctx context.Context, n *ListMemberTemplate) {
+	f(ctx, n)
+}
+
+type ListMemberModSlice []ListMemberMod
+
+func (mods ListMemberModSlice) Apply(ctx context.Context, n *ListMemberTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ListMemberTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ListMemberTemplate struct {
+	ListID    func() int64
+	UserID    func() int64
+	Role      func() string
+	CreatedAt func() time.Time
+	UpdatedAt func() time.Time
+
+	r listMemberR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type listMemberR struct {
+	User *listMemberRUserR
+	List *listMemberRListR
+}
+
+type listMemberRUserR struct {
+	o *UserTemplate
+}
+type listMemberRListR struct {
+	o *ListTemplate
+}
+
+// Apply mods to the ListMemberTemplate
+func (o *ListMemberTemplate) Apply(ctx context.Context, mods ...ListMemberMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.ListMember
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ListMemberTemplate) setModelRels(o *models.ListMember) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.ListMembers = append(rel.R.ListMembers, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.List != nil {
+		rel := t.r.List.o.Build()
+		rel.R.ListMembers = append(rel.R.ListMembers, o)
+		o.ListID = rel.ID // h2
+		o.R.List = rel
+	}
+}
+
+// BuildSetter returns an *models.ListMemberSetter
+// this does nothing with the relationship templates
+func (o ListMemberTemplate) BuildSetter() *models.ListMemberSetter {
+	m := &models.ListMemberSetter{}
+
+	if o.ListID != nil {
+		val := o.ListID()
+		m.ListID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Role != nil {
+		val := o.Role()
+		m.Role = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ListMemberSetter
+// this does nothing with the relationship templates
+func (o ListMemberTemplate) BuildManySetter(number int) []*models.ListMemberSetter {
+	m := make([]*models.ListMemberSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.ListMember
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ListMemberTemplate.Create
+func (o ListMemberTemplate) Build() *models.ListMember {
+	m := &models.ListMember{}
+
+	if o.ListID != nil {
+		m.ListID = o.ListID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Role != nil {
+		m.Role = o.Role()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ListMemberSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ListMemberTemplate.CreateMany
+func (o ListMemberTemplate) BuildMany(number int) models.ListMemberSlice {
+	m := make(models.ListMemberSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableListMember(m *models.ListMemberSetter) {
+	if !(m.ListID.IsValue()) {
+		val := random_int64(nil)
+		m.ListID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Role.IsValue()) {
+		val := random_string(nil)
+		m.Role = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.ListMember
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ListMemberTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.ListMember) error {
+	var err error
+
+	return err
+}
+
+// Create builds a listMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ListMemberTemplate) Create(ctx context.Context, exec bob.Executor) (*models.ListMember, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableListMember(opt)
+
+	if o.r.User == nil {
+		ListMemberMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	if o.r.List == nil {
+		ListMemberMods.WithNewList().Apply(ctx, o)
+	}
+
+	var rel1 *models.List
+
+	if o.r.List.o.alreadyPersisted {
+		rel1 = o.r.List.o.Build()
+	} else {
+		rel1, err = o.r.List.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ListID = omit.From(rel1.ID)
+
+	m, err := models.ListMembers.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+	m.R.List = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a listMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ListMemberTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.ListMember {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a listMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ListMemberTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.ListMember {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple listMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ListMemberTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ListMemberSlice, error) {
+	var err error
+	m := make(models.ListMemberSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple listMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ListMemberTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ListMemberSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple listMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ListMemberTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ListMemberSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// ListMember has methods that act as mods for the ListMemberTemplate
+var ListMemberMods listMemberMods
+
+type listMemberMods struct{}
+
+func (m listMemberMods) RandomizeAllColumns(f *faker.Faker) ListMemberMod {
+	return ListMemberModSlice{
+		ListMemberMods.RandomListID(f),
+		ListMemberMods.RandomUserID(f),
+		ListMemberMods.RandomRole(f),
+		ListMemberMods.RandomCreatedAt(f),
+		ListMemberMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m listMemberMods) ListID(val int64) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.ListID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMemberMods) ListIDFunc(f func() int64) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.ListID = f
+	})
+}
+
+// Clear any values for the column
+func (m listMemberMods) UnsetListID() ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.ListID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMemberMods) RandomListID(f *faker.Faker) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.ListID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMemberMods) UserID(val int64) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMemberMods) UserIDFunc(f func() int64) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m listMemberMods) UnsetUserID() ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMemberMods) RandomUserID(f *faker.Faker) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMemberMods) Role(val string) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.Role = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMemberMods) RoleFunc(f func() string) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.Role = f
+	})
+}
+
+// Clear any values for the column
+func (m listMemberMods) UnsetRole() ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.Role = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMemberMods) RandomRole(f *faker.Faker) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.Role = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMemberMods) CreatedAt(val time.Time) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMemberMods) CreatedAtFunc(f func() time.Time) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m listMemberMods) UnsetCreatedAt() ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMemberMods) RandomCreatedAt(f *faker.Faker) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMemberMods) UpdatedAt(val time.Time) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMemberMods) UpdatedAtFunc(f func() time.Time) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m listMemberMods) UnsetUpdatedAt() ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMemberMods) RandomUpdatedAt(f *faker.Faker) ListMemberMod {
+	return ListMemberModFunc(func(_ context.Context, o *ListMemberTemplate) {
+		o.UpdatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m listMemberMods) WithParentsCascading() ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		if isDone, _ := listMemberWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = listMemberWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewListWithContext(ctx, ListMods.WithParentsCascading())
+			m.WithList(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m listMemberMods) WithUser(rel *UserTemplate) ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		o.r.User = &listMemberRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m listMemberMods) WithNewUser(mods ...UserMod) ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m listMemberMods) WithExistingUser(em *models.User) ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		o.r.User = &listMemberRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m listMemberMods) WithoutUser() ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m listMemberMods) WithList(rel *ListTemplate) ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		o.r.List = &listMemberRListR{
+			o: rel,
+		}
+	})
+}
+
+func (m listMemberMods) WithNewList(mods ...ListMod) ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		related := o.f.NewListWithContext(ctx, mods...)
+
+		m.WithList(related).Apply(ctx, o)
+	})
+}
+
+func (m listMemberMods) WithExistingList(em *models.List) ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		o.r.List = &listMemberRListR{
+			o: o.f.FromExistingList(em),
+		}
+	})
+}
+
+func (m listMemberMods) WithoutList() ListMemberMod {
+	return ListMemberModFunc(func(ctx context.Context, o *ListMemberTemplate) {
+		o.r.List = nil
+	})
+}
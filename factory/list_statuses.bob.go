@@ -0,0 +1,576 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type ListStatusMod interface {
+	Apply(context.Context, *ListStatusTemplate)
+}
+
+type ListStatusModFunc func(context.Context, *ListStatusTemplate)
+
+func (f ListStatusModFunc) Apply(ctx context.Context, n *ListStatusTemplate) {
+	f(ctx, n)
+}
+
+type ListStatusModSlice []ListStatusMod
+
+func (mods ListStatusModSlice) Apply(ctx context.Context, n *ListStatusTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ListStatusTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ListStatusTemplate struct {
+	ID       func() int64
+	ListID   func() int64
+	Name     func() string
+	Done     func() bool
+	Position func() int64
+
+	r listStatusR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type listStatusR struct {
+	List  *listStatusRListR
+	Todos []*listStatusRTodosR
+}
+
+type listStatusRListR struct {
+	o *ListTemplate
+}
+type listStatusRTodosR struct {
+	number int
+	o      *TodoTemplate
+}
+
+// Apply mods to the ListStatusTemplate
+func (o *ListStatusTemplate) Apply(ctx context.Context, mods ...ListStatusMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.ListStatus
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ListStatusTemplate) setModelRels(o *models.ListStatus) {
+	if t.r.List != nil {
+		rel := t.r.List.o.Build()
+		rel.R.ListStatuses = append(rel.R.ListStatuses, o)
+		o.ListID = rel.ID // h2
+		o.R.List = rel
+	}
+
+	if t.r.Todos != nil {
+		rel := models.TodoSlice{}
+		for _, r := range t.r.Todos {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.StatusID = null.From(o.ID) // h2
+				rel.R.Status = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Todos = rel
+	}
+}
+
+// BuildSetter returns an *models.ListStatusSetter
+// this does nothing with the relationship templates
+func (o ListStatusTemplate) BuildSetter() *models.ListStatusSetter {
+	m := &models.ListStatusSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.ListID != nil {
+		val := o.ListID()
+		m.ListID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Done != nil {
+		val := o.Done()
+		m.Done = omit.From(val)
+	}
+	if o.Position != nil {
+		val := o.Position()
+		m.Position = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ListStatusSetter
+// this does nothing with the relationship templates
+func (o ListStatusTemplate) BuildManySetter(number int) []*models.ListStatusSetter {
+	m := make([]*models.ListStatusSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.ListStatus
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ListStatusTemplate.Create
+func (o ListStatusTemplate) Build() *models.ListStatus {
+	m := &models.ListStatus{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.ListID != nil {
+		m.ListID = o.ListID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Done != nil {
+		m.Done = o.Done()
+	}
+	if o.Position != nil {
+		m.Position = o.Position()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ListStatusSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ListStatusTemplate.CreateMany
+func (o ListStatusTemplate) BuildMany(number int) models.ListStatusSlice {
+	m := make(models.ListStatusSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableListStatus(m *models.ListStatusSetter) {
+	if !(m.ListID.IsValue()) {
+		val := random_int64(nil)
+		m.ListID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil)
+		m.Name = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.ListStatus
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ListStatusTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.ListStatus) error {
+	var err error
+
+	isTodosDone, _ := listStatusRelTodosCtx.Value(ctx)
+	if !isTodosDone && o.r.Todos != nil {
+		ctx = listStatusRelTodosCtx.WithValue(ctx, true)
+		for _, r := range o.r.Todos {
+			if r.o.alreadyPersisted {
+				m.R.Todos = append(m.R.Todos, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTodos(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a listStatus and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ListStatusTemplate) Create(ctx context.Context, exec bob.Executor) (*models.ListStatus, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableListStatus(opt)
+
+	if o.r.List == nil {
+		ListStatusMods.WithNewList().Apply(ctx, o)
+	}
+
+	var rel0 *models.List
+
+	if o.r.List.o.alreadyPersisted {
+		rel0 = o.r.List.o.Build()
+	} else {
+		rel0, err = o.r.List.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ListID = omit.From(rel0.ID)
+
+	m, err := models.ListStatuses.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.List = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a listStatus and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ListStatusTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.ListStatus {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a listStatus and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ListStatusTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.ListStatus {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple listStatuses and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ListStatusTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ListStatusSlice, error) {
+	var err error
+	m := make(models.ListStatusSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple listStatuses and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ListStatusTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ListStatusSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple listStatuses and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ListStatusTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ListStatusSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// ListStatus has methods that act as mods for the ListStatusTemplate
+var ListStatusMods listStatusMods
+
+type listStatusMods struct{}
+
+func (m listStatusMods) RandomizeAllColumns(f *faker.Faker) ListStatusMod {
+	return ListStatusModSlice{
+		ListStatusMods.RandomID(f),
+		ListStatusMods.RandomListID(f),
+		ListStatusMods.RandomName(f),
+		ListStatusMods.RandomDone(f),
+		ListStatusMods.RandomPosition(f),
+	}
+}
+
+// Set the model columns to this value
+func (m listStatusMods) ID(val int64) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listStatusMods) IDFunc(f func() int64) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m listStatusMods) UnsetID() ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listStatusMods) RandomID(f *faker.Faker) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listStatusMods) ListID(val int64) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ListID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listStatusMods) ListIDFunc(f func() int64) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ListID = f
+	})
+}
+
+// Clear any values for the column
+func (m listStatusMods) UnsetListID() ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ListID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listStatusMods) RandomListID(f *faker.Faker) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.ListID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listStatusMods) Name(val string) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listStatusMods) NameFunc(f func() string) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m listStatusMods) UnsetName() ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listStatusMods) RandomName(f *faker.Faker) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Name = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listStatusMods) Done(val bool) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Done = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listStatusMods) DoneFunc(f func() bool) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Done = f
+	})
+}
+
+// Clear any values for the column
+func (m listStatusMods) UnsetDone() ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Done = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listStatusMods) RandomDone(f *faker.Faker) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Done = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listStatusMods) Position(val int64) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Position = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listStatusMods) PositionFunc(f func() int64) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Position = f
+	})
+}
+
+// Clear any values for the column
+func (m listStatusMods) UnsetPosition() ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Position = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listStatusMods) RandomPosition(f *faker.Faker) ListStatusMod {
+	return ListStatusModFunc(func(_ context.Context, o *ListStatusTemplate) {
+		o.Position = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+func (m listStatusMods) WithParentsCascading() ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		if isDone, _ := listStatusWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = listStatusWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewListWithContext(ctx, ListMods.WithParentsCascading())
+			m.WithList(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m listStatusMods) WithList(rel *ListTemplate) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		o.r.List = &listStatusRListR{
+			o: rel,
+		}
+	})
+}
+
+func (m listStatusMods) WithNewList(mods ...ListMod) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		related := o.f.NewListWithContext(ctx, mods...)
+
+		m.WithList(related).Apply(ctx, o)
+	})
+}
+
+func (m listStatusMods) WithExistingList(em *models.List) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		o.r.List = &listStatusRListR{
+			o: o.f.FromExistingList(em),
+		}
+	})
+}
+
+func (m listStatusMods) WithoutList() ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		o.r.List = nil
+	})
+}
+
+func (m listStatusMods) WithTodos(number int, related *TodoTemplate) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		o.r.Todos = []*listStatusRTodosR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m listStatusMods) WithNewTodos(number int, mods ...TodoMod) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.WithTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listStatusMods) AddTodos(number int, related *TodoTemplate) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		o.r.Todos = append(o.r.Todos, &listStatusRTodosR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m listStatusMods) AddNewTodos(number int, mods ...TodoMod) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.AddTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listStatusMods) AddExistingTodos(existingModels ...*models.Todo) ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		for _, em := range existingModels {
+			o.r.Todos = append(o.r.Todos, &listStatusRTodosR{
+				o: o.f.FromExistingTodo(em),
+			})
+		}
+	})
+}
+
+func (m listStatusMods) WithoutTodos() ListStatusMod {
+	return ListStatusModFunc(func(ctx context.Context, o *ListStatusTemplate) {
+		o.r.Todos = nil
+	})
+}
@@ -0,0 +1,789 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type ListMod interface {
+	Apply(context.Context, *ListTemplate)
+}
+
+type ListModFunc func(context.Context, *ListTemplate)
+
+func (f ListModFunc) Apply(ctx context.Context, n *ListTemplate) {
+	f(ctx, n)
+}
+
+type ListModSlice []ListMod
+
+func (mods ListModSlice) Apply(ctx context.Context, n *ListTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ListTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ListTemplate struct {
+	ID        func() int64
+	UserID    func() int64
+	Name      func() string
+	Archived  func() bool
+	CreatedAt func() time.Time
+	UpdatedAt func() time.Time
+
+	r listR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type listR struct {
+	ListMembers  []*listRListMembersR
+	ListStatuses []*listRListStatusesR
+	User         *listRUserR
+	Todos        []*listRTodosR
+}
+
+type listRListMembersR struct {
+	number int
+	o      *ListMemberTemplate
+}
+type listRListStatusesR struct {
+	number int
+	o      *ListStatusTemplate
+}
+type listRUserR struct {
+	o *UserTemplate
+}
+type listRTodosR struct {
+	number int
+	o      *TodoTemplate
+}
+
+// Apply mods to the ListTemplate
+func (o *ListTemplate) Apply(ctx context.Context, mods ...ListMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.List
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ListTemplate) setModelRels(o *models.List) {
+	if t.r.ListMembers != nil {
+		rel := models.ListMemberSlice{}
+		for _, r := range t.r.ListMembers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ListID = o.ID // h2
+				rel.R.List = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ListMembers = rel
+	}
+
+	if t.r.ListStatuses != nil {
+		rel := models.ListStatusSlice{}
+		for _, r := range t.r.ListStatuses {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ListID = o.ID // h2
+				rel.R.List = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ListStatuses = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Lists = append(rel.R.Lists, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.Todos != nil {
+		rel := models.TodoSlice{}
+		for _, r := range t.r.Todos {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ListID = null.From(o.ID) // h2
+				rel.R.List = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Todos = rel
+	}
+}
+
+// BuildSetter returns an *models.ListSetter
+// this does nothing with the relationship templates
+func (o ListTemplate) BuildSetter() *models.ListSetter {
+	m := &models.ListSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Archived != nil {
+		val := o.Archived()
+		m.Archived = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ListSetter
+// this does nothing with the relationship templates
+func (o ListTemplate) BuildManySetter(number int) []*models.ListSetter {
+	m := make([]*models.ListSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.List
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ListTemplate.Create
+func (o ListTemplate) Build() *models.List {
+	m := &models.List{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Archived != nil {
+		m.Archived = o.Archived()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ListSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ListTemplate.CreateMany
+func (o ListTemplate) BuildMany(number int) models.ListSlice {
+	m := make(models.ListSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableList(m *models.ListSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil)
+		m.Name = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.List
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ListTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.List) error {
+	var err error
+
+	isListMembersDone, _ := listRelListMembersCtx.Value(ctx)
+	if !isListMembersDone && o.r.ListMembers != nil {
+		ctx = listRelListMembersCtx.WithValue(ctx, true)
+		for _, r := range o.r.ListMembers {
+			if r.o.alreadyPersisted {
+				m.R.ListMembers = append(m.R.ListMembers, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachListMembers(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isListStatusesDone, _ := listRelListStatusesCtx.Value(ctx)
+	if !isListStatusesDone && o.r.ListStatuses != nil {
+		ctx = listRelListStatusesCtx.WithValue(ctx, true)
+		for _, r := range o.r.ListStatuses {
+			if r.o.alreadyPersisted {
+				m.R.ListStatuses = append(m.R.ListStatuses, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachListStatuses(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTodosDone, _ := listRelTodosCtx.Value(ctx)
+	if !isTodosDone && o.r.Todos != nil {
+		ctx = listRelTodosCtx.WithValue(ctx, true)
+		for _, r := range o.r.Todos {
+			if r.o.alreadyPersisted {
+				m.R.Todos = append(m.R.Todos, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTodos(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a list and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ListTemplate) Create(ctx context.Context, exec bob.Executor) (*models.List, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableList(opt)
+
+	if o.r.User == nil {
+		ListMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel2 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel2 = o.r.User.o.Build()
+	} else {
+		rel2, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel2.ID)
+
+	m, err := models.Lists.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel2
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a list and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ListTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.List {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a list and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ListTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.List {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple lists and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ListTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ListSlice, error) {
+	var err error
+	m := make(models.ListSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple lists and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ListTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ListSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple lists and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ListTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ListSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// List has methods that act as mods for the ListTemplate
+var ListMods listMods
+
+type listMods struct{}
+
+func (m listMods) RandomizeAllColumns(f *faker.Faker) ListMod {
+	return ListModSlice{
+		ListMods.RandomID(f),
+		ListMods.RandomUserID(f),
+		ListMods.RandomName(f),
+		ListMods.RandomArchived(f),
+		ListMods.RandomCreatedAt(f),
+		ListMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m listMods) ID(val int64) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMods) IDFunc(f func() int64) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m listMods) UnsetID() ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMods) RandomID(f *faker.Faker) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMods) UserID(val int64) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMods) UserIDFunc(f func() int64) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m listMods) UnsetUserID() ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMods) RandomUserID(f *faker.Faker) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMods) Name(val string) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMods) NameFunc(f func() string) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m listMods) UnsetName() ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMods) RandomName(f *faker.Faker) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Name = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMods) Archived(val bool) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Archived = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMods) ArchivedFunc(f func() bool) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Archived = f
+	})
+}
+
+// Clear any values for the column
+func (m listMods) UnsetArchived() ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Archived = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMods) RandomArchived(f *faker.Faker) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.Archived = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMods) CreatedAt(val time.Time) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMods) CreatedAtFunc(f func() time.Time) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m listMods) UnsetCreatedAt() ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMods) RandomCreatedAt(f *faker.Faker) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m listMods) UpdatedAt(val time.Time) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m listMods) UpdatedAtFunc(f func() time.Time) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m listMods) UnsetUpdatedAt() ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m listMods) RandomUpdatedAt(f *faker.Faker) ListMod {
+	return ListModFunc(func(_ context.Context, o *ListTemplate) {
+		o.UpdatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m listMods) WithParentsCascading() ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		if isDone, _ := listWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = listWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m listMods) WithUser(rel *UserTemplate) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.User = &listRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m listMods) WithNewUser(mods ...UserMod) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m listMods) WithExistingUser(em *models.User) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.User = &listRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m listMods) WithoutUser() ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m listMods) WithListMembers(number int, related *ListMemberTemplate) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.ListMembers = []*listRListMembersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m listMods) WithNewListMembers(number int, mods ...ListMemberMod) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		related := o.f.NewListMemberWithContext(ctx, mods...)
+		m.WithListMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listMods) AddListMembers(number int, related *ListMemberTemplate) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.ListMembers = append(o.r.ListMembers, &listRListMembersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m listMods) AddNewListMembers(number int, mods ...ListMemberMod) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		related := o.f.NewListMemberWithContext(ctx, mods...)
+		m.AddListMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listMods) AddExistingListMembers(existingModels ...*models.ListMember) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		for _, em := range existingModels {
+			o.r.ListMembers = append(o.r.ListMembers, &listRListMembersR{
+				o: o.f.FromExistingListMember(em),
+			})
+		}
+	})
+}
+
+func (m listMods) WithoutListMembers() ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.ListMembers = nil
+	})
+}
+
+func (m listMods) WithListStatuses(number int, related *ListStatusTemplate) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.ListStatuses = []*listRListStatusesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m listMods) WithNewListStatuses(number int, mods ...ListStatusMod) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		related := o.f.NewListStatusWithContext(ctx, mods...)
+		m.WithListStatuses(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listMods) AddListStatuses(number int, related *ListStatusTemplate) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.ListStatuses = append(o.r.ListStatuses, &listRListStatusesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m listMods) AddNewListStatuses(number int, mods ...ListStatusMod) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		related := o.f.NewListStatusWithContext(ctx, mods...)
+		m.AddListStatuses(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listMods) AddExistingListStatuses(existingModels ...*models.ListStatus) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		for _, em := range existingModels {
+			o.r.ListStatuses = append(o.r.ListStatuses, &listRListStatusesR{
+				o: o.f.FromExistingListStatus(em),
+			})
+		}
+	})
+}
+
+func (m listMods) WithoutListStatuses() ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.ListStatuses = nil
+	})
+}
+
+func (m listMods) WithTodos(number int, related *TodoTemplate) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.Todos = []*listRTodosR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m listMods) WithNewTodos(number int, mods ...TodoMod) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.WithTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listMods) AddTodos(number int, related *TodoTemplate) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.Todos = append(o.r.Todos, &listRTodosR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m listMods) AddNewTodos(number int, mods ...TodoMod) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.AddTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m listMods) AddExistingTodos(existingModels ...*models.Todo) ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		for _, em := range existingModels {
+			o.r.Todos = append(o.r.Todos, &listRTodosR{
+				o: o.f.FromExistingTodo(em),
+			})
+		}
+	})
+}
+
+func (m listMods) WithoutTodos() ListMod {
+	return ListModFunc(func(ctx context.Context, o *ListTemplate) {
+		o.r.Todos = nil
+	})
+}
@@ -0,0 +1,857 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type NotificationMod interface {
+	Apply(context.Context, *NotificationTemplate)
+}
+
+type NotificationModFunc func(context.Context, *NotificationTemplate)
+
+func (f NotificationModFunc) Apply(ctx context.Context, n *NotificationTemplate) {
+	f(ctx, n)
+}
+
+type NotificationModSlice []NotificationMod
+
+func (mods NotificationModSlice) Apply(ctx context.Context, n *NotificationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// NotificationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type NotificationTemplate struct {
+	ID        func() int64
+	UserID    func() int64
+	ActorID   func() int64
+	Kind      func() string
+	TodoID    func() int64
+	CommentID func() null.Val[int64]
+	ReadAt    func() null.Val[time.Time]
+	CreatedAt func() time.Time
+
+	r notificationR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type notificationR struct {
+	Comment *notificationRCommentR
+	Todo    *notificationRTodoR
+	Actor   *notificationRActorR
+	User    *notificationRUserR
+}
+
+type notificationRCommentR struct {
+	o *CommentTemplate
+}
+type notificationRTodoR struct {
+	o *TodoTemplate
+}
+type notificationRActorR struct {
+	o *UserTemplate
+}
+type notificationRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the NotificationTemplate
+func (o *NotificationTemplate) Apply(ctx context.Context, mods ...NotificationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Notification
+// according to the relationships in the template. Nothing is inserted into the db
+func (t NotificationTemplate) setModelRels(o *models.Notification) {
+	if t.r.Comment != nil {
+		rel := t.r.Comment.o.Build()
+		rel.R.Notifications = append(rel.R.Notifications, o)
+		o.CommentID = null.From(rel.ID) // h2
+		o.R.Comment = rel
+	}
+
+	if t.r.Todo != nil {
+		rel := t.r.Todo.o.Build()
+		rel.R.Notifications = append(rel.R.Notifications, o)
+		o.TodoID = rel.ID // h2
+		o.R.Todo = rel
+	}
+
+	if t.r.Actor != nil {
+		rel := t.r.Actor.o.Build()
+		rel.R.ActorNotifications = append(rel.R.ActorNotifications, o)
+		o.ActorID = rel.ID // h2
+		o.R.Actor = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Notifications = append(rel.R.Notifications, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.NotificationSetter
+// this does nothing with the relationship templates
+func (o NotificationTemplate) BuildSetter() *models.NotificationSetter {
+	m := &models.NotificationSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.ActorID != nil {
+		val := o.ActorID()
+		m.ActorID = omit.From(val)
+	}
+	if o.Kind != nil {
+		val := o.Kind()
+		m.Kind = omit.From(val)
+	}
+	if o.TodoID != nil {
+		val := o.TodoID()
+		m.TodoID = omit.From(val)
+	}
+	if o.CommentID != nil {
+		val := o.CommentID()
+		m.CommentID = omitnull.FromNull(val)
+	}
+	if o.ReadAt != nil {
+		val := o.ReadAt()
+		m.ReadAt = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.NotificationSetter
+// this does nothing with the relationship templates
+func (o NotificationTemplate) BuildManySetter(number int) []*models.NotificationSetter {
+	m := make([]*models.NotificationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Notification
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use NotificationTemplate.Create
+func (o NotificationTemplate) Build() *models.Notification {
+	m := &models.Notification{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.ActorID != nil {
+		m.ActorID = o.ActorID()
+	}
+	if o.Kind != nil {
+		m.Kind = o.Kind()
+	}
+	if o.TodoID != nil {
+		m.TodoID = o.TodoID()
+	}
+	if o.CommentID != nil {
+		m.CommentID = o.CommentID()
+	}
+	if o.ReadAt != nil {
+		m.ReadAt = o.ReadAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.NotificationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use NotificationTemplate.CreateMany
+func (o NotificationTemplate) BuildMany(number int) models.NotificationSlice {
+	m := make(models.NotificationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableNotification(m *models.NotificationSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.ActorID.IsValue()) {
+		val := random_int64(nil)
+		m.ActorID = omit.From(val)
+	}
+	if !(m.Kind.IsValue()) {
+		val := random_string(nil)
+		m.Kind = omit.From(val)
+	}
+	if !(m.TodoID.IsValue()) {
+		val := random_int64(nil)
+		m.TodoID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Notification
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *NotificationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Notification) error {
+	var err error
+
+	isCommentDone, _ := notificationRelCommentCtx.Value(ctx)
+	if !isCommentDone && o.r.Comment != nil {
+		ctx = notificationRelCommentCtx.WithValue(ctx, true)
+		if o.r.Comment.o.alreadyPersisted {
+			m.R.Comment = o.r.Comment.o.Build()
+		} else {
+			var rel0 *models.Comment
+			rel0, err = o.r.Comment.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachComment(ctx, exec, rel0)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a notification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *NotificationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Notification, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableNotification(opt)
+
+	if o.r.Todo == nil {
+		NotificationMods.WithNewTodo().Apply(ctx, o)
+	}
+
+	var rel1 *models.Todo
+
+	if o.r.Todo.o.alreadyPersisted {
+		rel1 = o.r.Todo.o.Build()
+	} else {
+		rel1, err = o.r.Todo.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TodoID = omit.From(rel1.ID)
+
+	if o.r.Actor == nil {
+		NotificationMods.WithNewActor().Apply(ctx, o)
+	}
+
+	var rel2 *models.User
+
+	if o.r.Actor.o.alreadyPersisted {
+		rel2 = o.r.Actor.o.Build()
+	} else {
+		rel2, err = o.r.Actor.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ActorID = omit.From(rel2.ID)
+
+	if o.r.User == nil {
+		NotificationMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel3 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel3 = o.r.User.o.Build()
+	} else {
+		rel3, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel3.ID)
+
+	m, err := models.Notifications.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Todo = rel1
+	m.R.Actor = rel2
+	m.R.User = rel3
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a notification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *NotificationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Notification {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a notification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *NotificationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Notification {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple notifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o NotificationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.NotificationSlice, error) {
+	var err error
+	m := make(models.NotificationSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple notifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o NotificationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.NotificationSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple notifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o NotificationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.NotificationSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Notification has methods that act as mods for the NotificationTemplate
+var NotificationMods notificationMods
+
+type notificationMods struct{}
+
+func (m notificationMods) RandomizeAllColumns(f *faker.Faker) NotificationMod {
+	return NotificationModSlice{
+		NotificationMods.RandomID(f),
+		NotificationMods.RandomUserID(f),
+		NotificationMods.RandomActorID(f),
+		NotificationMods.RandomKind(f),
+		NotificationMods.RandomTodoID(f),
+		NotificationMods.RandomCommentID(f),
+		NotificationMods.RandomReadAt(f),
+		NotificationMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m notificationMods) ID(val int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) IDFunc(f func() int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) UserID(val int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) UserIDFunc(f func() int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetUserID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomUserID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) ActorID(val int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) ActorIDFunc(f func() int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetActorID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomActorID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) Kind(val string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Kind = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) KindFunc(f func() string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Kind = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetKind() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Kind = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomKind(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Kind = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) TodoID(val int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TodoID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) TodoIDFunc(f func() int64) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TodoID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetTodoID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TodoID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomTodoID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TodoID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) CommentID(val null.Val[int64]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CommentID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) CommentIDFunc(f func() null.Val[int64]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CommentID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetCommentID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CommentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m notificationMods) RandomCommentID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CommentID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m notificationMods) RandomCommentIDNotNull(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CommentID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) ReadAt(val null.Val[time.Time]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ReadAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) ReadAtFunc(f func() null.Val[time.Time]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ReadAt = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetReadAt() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ReadAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m notificationMods) RandomReadAt(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ReadAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m notificationMods) RandomReadAtNotNull(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ReadAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) CreatedAt(val time.Time) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) CreatedAtFunc(f func() time.Time) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetCreatedAt() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomCreatedAt(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m notificationMods) WithParentsCascading() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		if isDone, _ := notificationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = notificationWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewCommentWithContext(ctx, CommentMods.WithParentsCascading())
+			m.WithComment(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithTodo(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithActor(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m notificationMods) WithComment(rel *CommentTemplate) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Comment = &notificationRCommentR{
+			o: rel,
+		}
+	})
+}
+
+func (m notificationMods) WithNewComment(mods ...CommentMod) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+
+		m.WithComment(related).Apply(ctx, o)
+	})
+}
+
+func (m notificationMods) WithExistingComment(em *models.Comment) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Comment = &notificationRCommentR{
+			o: o.f.FromExistingComment(em),
+		}
+	})
+}
+
+func (m notificationMods) WithoutComment() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Comment = nil
+	})
+}
+
+func (m notificationMods) WithTodo(rel *TodoTemplate) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Todo = &notificationRTodoR{
+			o: rel,
+		}
+	})
+}
+
+func (m notificationMods) WithNewTodo(mods ...TodoMod) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithTodo(related).Apply(ctx, o)
+	})
+}
+
+func (m notificationMods) WithExistingTodo(em *models.Todo) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Todo = &notificationRTodoR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m notificationMods) WithoutTodo() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Todo = nil
+	})
+}
+
+func (m notificationMods) WithActor(rel *UserTemplate) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Actor = &notificationRActorR{
+			o: rel,
+		}
+	})
+}
+
+func (m notificationMods) WithNewActor(mods ...UserMod) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithActor(related).Apply(ctx, o)
+	})
+}
+
+func (m notificationMods) WithExistingActor(em *models.User) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Actor = &notificationRActorR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m notificationMods) WithoutActor() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Actor = nil
+	})
+}
+
+func (m notificationMods) WithUser(rel *UserTemplate) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.User = &notificationRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m notificationMods) WithNewUser(mods ...UserMod) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m notificationMods) WithExistingUser(em *models.User) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.User = &notificationRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m notificationMods) WithoutUser() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.User = nil
+	})
+}
@@ -0,0 +1,619 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type TagMod interface {
+	Apply(context.Context, *TagTemplate)
+}
+
+type TagModFunc func(context.Context, *TagTemplate)
+
+func (f TagModFunc) Apply(ctx context.Context, n *TagTemplate) {
+	f(ctx, n)
+}
+
+type TagModSlice []TagMod
+
+func (mods TagModSlice) Apply(ctx context.Context, n *TagTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// TagTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type TagTemplate struct {
+	ID        func() int64
+	UserID    func() int64
+	Name      func() string
+	Color     func() string
+	CreatedAt func() time.Time
+	UpdatedAt func() time.Time
+
+	r tagR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type tagR struct {
+	User  *tagRUserR
+	Todos []*tagRTodosR
+}
+
+type tagRUserR struct {
+	o *UserTemplate
+}
+type tagRTodosR struct {
+	number int
+	o      *TodoTemplate
+}
+
+// Apply mods to the TagTemplate
+func (o *TagTemplate) Apply(ctx context.Context, mods ...TagMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Tag
+// according to the relationships in the template. Nothing is inserted into the db
+func (t TagTemplate) setModelRels(o *models.Tag) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Tags = append(rel.R.Tags, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.Todos != nil {
+		rel := models.TodoSlice{}
+		for _, r := range t.r.Todos {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Tags = append(rel.R.Tags, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Todos = rel
+	}
+}
+
+// BuildSetter returns an *models.TagSetter
+// this does nothing with the relationship templates
+func (o TagTemplate) BuildSetter() *models.TagSetter {
+	m := &models.TagSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = omit.From(val)
+	}
+	if o.Color != nil {
+		val := o.Color()
+		m.Color = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.TagSetter
+// this does nothing with the relationship templates
+func (o TagTemplate) BuildManySetter(number int) []*models.TagSetter {
+	m := make([]*models.TagSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Tag
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TagTemplate.Create
+func (o TagTemplate) Build() *models.Tag {
+	m := &models.Tag{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Color != nil {
+		m.Color = o.Color()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.TagSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TagTemplate.CreateMany
+func (o TagTemplate) BuildMany(number int) models.TagSlice {
+	m := make(models.TagSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableTag(m *models.TagSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.Name.IsValue()) {
+		val := random_string(nil)
+		m.Name = omit.From(val)
+	}
+	if !(m.Color.IsValue()) {
+		val := random_string(nil)
+		m.Color = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Tag
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *TagTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Tag) error {
+	var err error
+
+	isTodosDone, _ := tagRelTodosCtx.Value(ctx)
+	if !isTodosDone && o.r.Todos != nil {
+		ctx = tagRelTodosCtx.WithValue(ctx, true)
+		for _, r := range o.r.Todos {
+			if r.o.alreadyPersisted {
+				m.R.Todos = append(m.R.Todos, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTodos(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a tag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *TagTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Tag, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableTag(opt)
+
+	if o.r.User == nil {
+		TagMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	m, err := models.Tags.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a tag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *TagTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Tag {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a tag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *TagTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Tag {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple tags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o TagTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.TagSlice, error) {
+	var err error
+	m := make(models.TagSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple tags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o TagTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.TagSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple tags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o TagTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.TagSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Tag has methods that act as mods for the TagTemplate
+var TagMods tagMods
+
+type tagMods struct{}
+
+func (m tagMods) RandomizeAllColumns(f *faker.Faker) TagMod {
+	return TagModSlice{
+		TagMods.RandomID(f),
+		TagMods.RandomUserID(f),
+		TagMods.RandomName(f),
+		TagMods.RandomColor(f),
+		TagMods.RandomCreatedAt(f),
+		TagMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m tagMods) ID(val int64) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tagMods) IDFunc(f func() int64) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m tagMods) UnsetID() TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tagMods) RandomID(f *faker.Faker) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tagMods) UserID(val int64) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tagMods) UserIDFunc(f func() int64) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m tagMods) UnsetUserID() TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tagMods) RandomUserID(f *faker.Faker) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tagMods) Name(val string) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tagMods) NameFunc(f func() string) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m tagMods) UnsetName() TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tagMods) RandomName(f *faker.Faker) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Name = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tagMods) Color(val string) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Color = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tagMods) ColorFunc(f func() string) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Color = f
+	})
+}
+
+// Clear any values for the column
+func (m tagMods) UnsetColor() TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Color = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tagMods) RandomColor(f *faker.Faker) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.Color = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tagMods) CreatedAt(val time.Time) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tagMods) CreatedAtFunc(f func() time.Time) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m tagMods) UnsetCreatedAt() TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tagMods) RandomCreatedAt(f *faker.Faker) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tagMods) UpdatedAt(val time.Time) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tagMods) UpdatedAtFunc(f func() time.Time) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m tagMods) UnsetUpdatedAt() TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tagMods) RandomUpdatedAt(f *faker.Faker) TagMod {
+	return TagModFunc(func(_ context.Context, o *TagTemplate) {
+		o.UpdatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m tagMods) WithParentsCascading() TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		if isDone, _ := tagWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = tagWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m tagMods) WithUser(rel *UserTemplate) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		o.r.User = &tagRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m tagMods) WithNewUser(mods ...UserMod) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m tagMods) WithExistingUser(em *models.User) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		o.r.User = &tagRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m tagMods) WithoutUser() TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m tagMods) WithTodos(number int, related *TodoTemplate) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		o.r.Todos = []*tagRTodosR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tagMods) WithNewTodos(number int, mods ...TodoMod) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.WithTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tagMods) AddTodos(number int, related *TodoTemplate) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		o.r.Todos = append(o.r.Todos, &tagRTodosR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tagMods) AddNewTodos(number int, mods ...TodoMod) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.AddTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tagMods) AddExistingTodos(existingModels ...*models.Todo) TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		for _, em := range existingModels {
+			o.r.Todos = append(o.r.Todos, &tagRTodosR{
+				o: o.f.FromExistingTodo(em),
+			})
+		}
+	})
+}
+
+func (m tagMods) WithoutTodos() TagMod {
+	return TagModFunc(func(ctx context.Context, o *TagTemplate) {
+		o.r.Todos = nil
+	})
+}
@@ -0,0 +1,582 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type TimeEntryMod interface {
+	Apply(context.Context, *TimeEntryTemplate)
+}
+
+type TimeEntryModFunc func(context.Context, *TimeEntryTemplate)
+
+func (f TimeEntryModFunc) Apply(ctx context.Context, n *TimeEntryTemplate) {
+	f(ctx, n)
+}
+
+type TimeEntryModSlice []TimeEntryMod
+
+func (mods TimeEntryModSlice) Apply(ctx context.Context, n *TimeEntryTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// TimeEntryTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type TimeEntryTemplate struct {
+	ID        func() int64
+	TodoID    func() int64
+	UserID    func() int64
+	StartedAt func() time.Time
+	EndedAt   func() null.Val[time.Time]
+
+	r timeEntryR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type timeEntryR struct {
+	User *timeEntryRUserR
+	Todo *timeEntryRTodoR
+}
+
+type timeEntryRUserR struct {
+	o *UserTemplate
+}
+type timeEntryRTodoR struct {
+	o *TodoTemplate
+}
+
+// Apply mods to the TimeEntryTemplate
+func (o *TimeEntryTemplate) Apply(ctx context.Context, mods ...TimeEntryMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.TimeEntry
+// according to the relationships in the template. Nothing is inserted into the db
+func (t TimeEntryTemplate) setModelRels(o *models.TimeEntry) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.TimeEntries = append(rel.R.TimeEntries, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+
+	if t.r.Todo != nil {
+		rel := t.r.Todo.o.Build()
+		rel.R.TimeEntries = append(rel.R.TimeEntries, o)
+		o.TodoID = rel.ID // h2
+		o.R.Todo = rel
+	}
+}
+
+// BuildSetter returns an *models.TimeEntrySetter
+// this does nothing with the relationship templates
+func (o TimeEntryTemplate) BuildSetter() *models.TimeEntrySetter {
+	m := &models.TimeEntrySetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.TodoID != nil {
+		val := o.TodoID()
+		m.TodoID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.StartedAt != nil {
+		val := o.StartedAt()
+		m.StartedAt = omit.From(val)
+	}
+	if o.EndedAt != nil {
+		val := o.EndedAt()
+		m.EndedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.TimeEntrySetter
+// this does nothing with the relationship templates
+func (o TimeEntryTemplate) BuildManySetter(number int) []*models.TimeEntrySetter {
+	m := make([]*models.TimeEntrySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.TimeEntry
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TimeEntryTemplate.Create
+func (o TimeEntryTemplate) Build() *models.TimeEntry {
+	m := &models.TimeEntry{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.TodoID != nil {
+		m.TodoID = o.TodoID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.StartedAt != nil {
+		m.StartedAt = o.StartedAt()
+	}
+	if o.EndedAt != nil {
+		m.EndedAt = o.EndedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.TimeEntrySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TimeEntryTemplate.CreateMany
+func (o TimeEntryTemplate) BuildMany(number int) models.TimeEntrySlice {
+	m := make(models.TimeEntrySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableTimeEntry(m *models.TimeEntrySetter) {
+	if !(m.TodoID.IsValue()) {
+		val := random_int64(nil)
+		m.TodoID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
+	if !(m.StartedAt.IsValue()) {
+		val := random_time_Time(nil)
+		m.StartedAt = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.TimeEntry
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *TimeEntryTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.TimeEntry) error {
+	var err error
+
+	return err
+}
+
+// Create builds a timeEntry and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *TimeEntryTemplate) Create(ctx context.Context, exec bob.Executor) (*models.TimeEntry, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableTimeEntry(opt)
+
+	if o.r.User == nil {
+		TimeEntryMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel0 = o.r.User.o.Build()
+	} else {
+		rel0, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel0.ID)
+
+	if o.r.Todo == nil {
+		TimeEntryMods.WithNewTodo().Apply(ctx, o)
+	}
+
+	var rel1 *models.Todo
+
+	if o.r.Todo.o.alreadyPersisted {
+		rel1 = o.r.Todo.o.Build()
+	} else {
+		rel1, err = o.r.Todo.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TodoID = omit.From(rel1.ID)
+
+	m, err := models.TimeEntries.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.User = rel0
+	m.R.Todo = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a timeEntry and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *TimeEntryTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.TimeEntry {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a timeEntry and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *TimeEntryTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.TimeEntry {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple timeEntries and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o TimeEntryTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.TimeEntrySlice, error) {
+	var err error
+	m := make(models.TimeEntrySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple timeEntries and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o TimeEntryTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.TimeEntrySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple timeEntries and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o TimeEntryTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.TimeEntrySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// TimeEntry has methods that act as mods for the TimeEntryTemplate
+var TimeEntryMods timeEntryMods
+
+type timeEntryMods struct{}
+
+func (m timeEntryMods) RandomizeAllColumns(f *faker.Faker) TimeEntryMod {
+	return TimeEntryModSlice{
+		TimeEntryMods.RandomID(f),
+		TimeEntryMods.RandomTodoID(f),
+		TimeEntryMods.RandomUserID(f),
+		TimeEntryMods.RandomStartedAt(f),
+		TimeEntryMods.RandomEndedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m timeEntryMods) ID(val int64) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m timeEntryMods) IDFunc(f func() int64) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m timeEntryMods) UnsetID() TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m timeEntryMods) RandomID(f *faker.Faker) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m timeEntryMods) TodoID(val int64) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.TodoID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m timeEntryMods) TodoIDFunc(f func() int64) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.TodoID = f
+	})
+}
+
+// Clear any values for the column
+func (m timeEntryMods) UnsetTodoID() TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.TodoID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m timeEntryMods) RandomTodoID(f *faker.Faker) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.TodoID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m timeEntryMods) UserID(val int64) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m timeEntryMods) UserIDFunc(f func() int64) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m timeEntryMods) UnsetUserID() TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m timeEntryMods) RandomUserID(f *faker.Faker) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m timeEntryMods) StartedAt(val time.Time) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.StartedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m timeEntryMods) StartedAtFunc(f func() time.Time) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.StartedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m timeEntryMods) UnsetStartedAt() TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.StartedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m timeEntryMods) RandomStartedAt(f *faker.Faker) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.StartedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m timeEntryMods) EndedAt(val null.Val[time.Time]) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.EndedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m timeEntryMods) EndedAtFunc(f func() null.Val[time.Time]) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.EndedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m timeEntryMods) UnsetEndedAt() TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.EndedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m timeEntryMods) RandomEndedAt(f *faker.Faker) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.EndedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m timeEntryMods) RandomEndedAtNotNull(f *faker.Faker) TimeEntryMod {
+	return TimeEntryModFunc(func(_ context.Context, o *TimeEntryTemplate) {
+		o.EndedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m timeEntryMods) WithParentsCascading() TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		if isDone, _ := timeEntryWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = timeEntryWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithTodo(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m timeEntryMods) WithUser(rel *UserTemplate) TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		o.r.User = &timeEntryRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m timeEntryMods) WithNewUser(mods ...UserMod) TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m timeEntryMods) WithExistingUser(em *models.User) TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		o.r.User = &timeEntryRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m timeEntryMods) WithoutUser() TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m timeEntryMods) WithTodo(rel *TodoTemplate) TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		o.r.Todo = &timeEntryRTodoR{
+			o: rel,
+		}
+	})
+}
+
+func (m timeEntryMods) WithNewTodo(mods ...TodoMod) TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithTodo(related).Apply(ctx, o)
+	})
+}
+
+func (m timeEntryMods) WithExistingTodo(em *models.Todo) TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		o.r.Todo = &timeEntryRTodoR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m timeEntryMods) WithoutTodo() TimeEntryMod {
+	return TimeEntryModFunc(func(ctx context.Context, o *TimeEntryTemplate) {
+		o.r.Todo = nil
+	})
+}
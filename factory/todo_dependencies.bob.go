@@ -0,0 +1,474 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type TodoDependencyMod interface {
+	Apply(context.Context, *TodoDependencyTemplate)
+}
+
+type TodoDependencyModFunc func(context.Context, *TodoDependencyTemplate)
+
+func (f TodoDependencyModFunc) Apply(ctx context.Context, n *TodoDependencyTemplate) {
+	f(ctx, n)
+}
+
+type TodoDependencyModSlice []TodoDependencyMod
+
+func (mods TodoDependencyModSlice) Apply(ctx context.Context, n *TodoDependencyTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// TodoDependencyTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type TodoDependencyTemplate struct {
+	TodoID    func() int64
+	BlockerID func() int64
+	CreatedAt func() time.Time
+
+	r todoDependencyR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type todoDependencyR struct {
+	Blocker *todoDependencyRBlockerR
+	Todo    *todoDependencyRTodoR
+}
+
+type todoDependencyRBlockerR struct {
+	o *TodoTemplate
+}
+type todoDependencyRTodoR struct {
+	o *TodoTemplate
+}
+
+// Apply mods to the TodoDependencyTemplate
+func (o *TodoDependencyTemplate) Apply(ctx context.Context, mods ...TodoDependencyMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.TodoDependency
+// according to the relationships in the template. Nothing is inserted into the db
+func (t TodoDependencyTemplate) setModelRels(o *models.TodoDependency) {
+	if t.r.Blocker != nil {
+		rel := t.r.Blocker.o.Build()
+		rel.R.Dependents = append(rel.R.Dependents, o)
+		o.BlockerID = rel.ID // h2
+		o.R.Blocker = rel
+	}
+
+	if t.r.Todo != nil {
+		rel := t.r.Todo.o.Build()
+		rel.R.Dependencies = append(rel.R.Dependencies, o)
+		o.TodoID = rel.ID // h2
+		o.R.Todo = rel
+	}
+}
+
+// BuildSetter returns an *models.TodoDependencySetter
+// this does nothing with the relationship templates
+func (o TodoDependencyTemplate) BuildSetter() *models.TodoDependencySetter {
+	m := &models.TodoDependencySetter{}
+
+	if o.TodoID != nil {
+		val := o.TodoID()
+		m.TodoID = omit.From(val)
+	}
+	if o.BlockerID != nil {
+		val := o.BlockerID()
+		m.BlockerID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.TodoDependencySetter
+// this does nothing with the relationship templates
+func (o TodoDependencyTemplate) BuildManySetter(number int) []*models.TodoDependencySetter {
+	m := make([]*models.TodoDependencySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.TodoDependency
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TodoDependencyTemplate.Create
+func (o TodoDependencyTemplate) Build() *models.TodoDependency {
+	m := &models.TodoDependency{}
+
+	if o.TodoID != nil {
+		m.TodoID = o.TodoID()
+	}
+	if o.BlockerID != nil {
+		m.BlockerID = o.BlockerID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.TodoDependencySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TodoDependencyTemplate.CreateMany
+func (o TodoDependencyTemplate) BuildMany(number int) models.TodoDependencySlice {
+	m := make(models.TodoDependencySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableTodoDependency(m *models.TodoDependencySetter) {
+	if !(m.TodoID.IsValue()) {
+		val := random_int64(nil)
+		m.TodoID = omit.From(val)
+	}
+	if !(m.BlockerID.IsValue()) {
+		val := random_int64(nil)
+		m.BlockerID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.TodoDependency
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *TodoDependencyTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.TodoDependency) error {
+	var err error
+
+	return err
+}
+
+// Create builds a todoDependency and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *TodoDependencyTemplate) Create(ctx context.Context, exec bob.Executor) (*models.TodoDependency, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableTodoDependency(opt)
+
+	if o.r.Blocker == nil {
+		TodoDependencyMods.WithNewBlocker().Apply(ctx, o)
+	}
+
+	var rel0 *models.Todo
+
+	if o.r.Blocker.o.alreadyPersisted {
+		rel0 = o.r.Blocker.o.Build()
+	} else {
+		rel0, err = o.r.Blocker.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.BlockerID = omit.From(rel0.ID)
+
+	if o.r.Todo == nil {
+		TodoDependencyMods.WithNewTodo().Apply(ctx, o)
+	}
+
+	var rel1 *models.Todo
+
+	if o.r.Todo.o.alreadyPersisted {
+		rel1 = o.r.Todo.o.Build()
+	} else {
+		rel1, err = o.r.Todo.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TodoID = omit.From(rel1.ID)
+
+	m, err := models.TodoDependencies.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Blocker = rel0
+	m.R.Todo = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a todoDependency and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *TodoDependencyTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.TodoDependency {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a todoDependency and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *TodoDependencyTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.TodoDependency {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple todoDependencies and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o TodoDependencyTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.TodoDependencySlice, error) {
+	var err error
+	m := make(models.TodoDependencySlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple todoDependencies and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o TodoDependencyTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.TodoDependencySlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple todoDependencies and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o TodoDependencyTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.TodoDependencySlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// TodoDependency has methods that act as mods for the TodoDependencyTemplate
+var TodoDependencyMods todoDependencyMods
+
+type todoDependencyMods struct{}
+
+func (m todoDependencyMods) RandomizeAllColumns(f *faker.Faker) TodoDependencyMod {
+	return TodoDependencyModSlice{
+		TodoDependencyMods.RandomTodoID(f),
+		TodoDependencyMods.RandomBlockerID(f),
+		TodoDependencyMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m todoDependencyMods) TodoID(val int64) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.TodoID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoDependencyMods) TodoIDFunc(f func() int64) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.TodoID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoDependencyMods) UnsetTodoID() TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.TodoID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoDependencyMods) RandomTodoID(f *faker.Faker) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.TodoID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoDependencyMods) BlockerID(val int64) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.BlockerID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoDependencyMods) BlockerIDFunc(f func() int64) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.BlockerID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoDependencyMods) UnsetBlockerID() TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.BlockerID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoDependencyMods) RandomBlockerID(f *faker.Faker) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.BlockerID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoDependencyMods) CreatedAt(val time.Time) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoDependencyMods) CreatedAtFunc(f func() time.Time) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m todoDependencyMods) UnsetCreatedAt() TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoDependencyMods) RandomCreatedAt(f *faker.Faker) TodoDependencyMod {
+	return TodoDependencyModFunc(func(_ context.Context, o *TodoDependencyTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m todoDependencyMods) WithParentsCascading() TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		if isDone, _ := todoDependencyWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = todoDependencyWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithBlocker(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithTodo(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m todoDependencyMods) WithBlocker(rel *TodoTemplate) TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		o.r.Blocker = &todoDependencyRBlockerR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoDependencyMods) WithNewBlocker(mods ...TodoMod) TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithBlocker(related).Apply(ctx, o)
+	})
+}
+
+func (m todoDependencyMods) WithExistingBlocker(em *models.Todo) TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		o.r.Blocker = &todoDependencyRBlockerR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m todoDependencyMods) WithoutBlocker() TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		o.r.Blocker = nil
+	})
+}
+
+func (m todoDependencyMods) WithTodo(rel *TodoTemplate) TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		o.r.Todo = &todoDependencyRTodoR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoDependencyMods) WithNewTodo(mods ...TodoMod) TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithTodo(related).Apply(ctx, o)
+	})
+}
+
+func (m todoDependencyMods) WithExistingTodo(em *models.Todo) TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		o.r.Todo = &todoDependencyRTodoR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m todoDependencyMods) WithoutTodo() TodoDependencyMod {
+	return TodoDependencyModFunc(func(ctx context.Context, o *TodoDependencyTemplate) {
+		o.r.Todo = nil
+	})
+}
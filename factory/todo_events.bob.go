@@ -0,0 +1,703 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type TodoEventMod interface {
+	Apply(context.Context, *TodoEventTemplate)
+}
+
+type TodoEventModFunc func(context.Context, *TodoEventTemplate)
+
+func (f TodoEventModFunc) Apply(ctx context.Context, n *TodoEventTemplate) {
+	f(ctx, n)
+}
+
+type TodoEventModSlice []TodoEventMod
+
+func (mods TodoEventModSlice) Apply(ctx context.Context, n *TodoEventTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// TodoEventTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type TodoEventTemplate struct {
+	ID        func() int64
+	TodoID    func() int64
+	UserID    func() null.Val[int64]
+	Kind      func() string
+	OldValue  func() null.Val[string]
+	NewValue  func() null.Val[string]
+	CreatedAt func() time.Time
+
+	r todoEventR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type todoEventR struct {
+	User *todoEventRUserR
+	Todo *todoEventRTodoR
+}
+
+type todoEventRUserR struct {
+	o *UserTemplate
+}
+type todoEventRTodoR struct {
+	o *TodoTemplate
+}
+
+// Apply mods to the TodoEventTemplate
+func (o *TodoEventTemplate) Apply(ctx context.Context, mods ...TodoEventMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.TodoEvent
+// according to the relationships in the template. Nothing is inserted into the db
+func (t TodoEventTemplate) setModelRels(o *models.TodoEvent) {
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.TodoEvents = append(rel.R.TodoEvents, o)
+		o.UserID = null.From(rel.ID) // h2
+		o.R.User = rel
+	}
+
+	if t.r.Todo != nil {
+		rel := t.r.Todo.o.Build()
+		rel.R.TodoEvents = append(rel.R.TodoEvents, o)
+		o.TodoID = rel.ID // h2
+		o.R.Todo = rel
+	}
+}
+
+// BuildSetter returns an *models.TodoEventSetter
+// this does nothing with the relationship templates
+func (o TodoEventTemplate) BuildSetter() *models.TodoEventSetter {
+	m := &models.TodoEventSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.TodoID != nil {
+		val := o.TodoID()
+		m.TodoID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omitnull.FromNull(val)
+	}
+	if o.Kind != nil {
+		val := o.Kind()
+		m.Kind = omit.From(val)
+	}
+	if o.OldValue != nil {
+		val := o.OldValue()
+		m.OldValue = omitnull.FromNull(val)
+	}
+	if o.NewValue != nil {
+		val := o.NewValue()
+		m.NewValue = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.TodoEventSetter
+// this does nothing with the relationship templates
+func (o TodoEventTemplate) BuildManySetter(number int) []*models.TodoEventSetter {
+	m := make([]*models.TodoEventSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.TodoEvent
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TodoEventTemplate.Create
+func (o TodoEventTemplate) Build() *models.TodoEvent {
+	m := &models.TodoEvent{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.TodoID != nil {
+		m.TodoID = o.TodoID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.Kind != nil {
+		m.Kind = o.Kind()
+	}
+	if o.OldValue != nil {
+		m.OldValue = o.OldValue()
+	}
+	if o.NewValue != nil {
+		m.NewValue = o.NewValue()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.TodoEventSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TodoEventTemplate.CreateMany
+func (o TodoEventTemplate) BuildMany(number int) models.TodoEventSlice {
+	m := make(models.TodoEventSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableTodoEvent(m *models.TodoEventSetter) {
+	if !(m.TodoID.IsValue()) {
+		val := random_int64(nil)
+		m.TodoID = omit.From(val)
+	}
+	if !(m.Kind.IsValue()) {
+		val := random_string(nil)
+		m.Kind = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.TodoEvent
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *TodoEventTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.TodoEvent) error {
+	var err error
+
+	isUserDone, _ := todoEventRelUserCtx.Value(ctx)
+	if !isUserDone && o.r.User != nil {
+		ctx = todoEventRelUserCtx.WithValue(ctx, true)
+		if o.r.User.o.alreadyPersisted {
+			m.R.User = o.r.User.o.Build()
+		} else {
+			var rel0 *models.User
+			rel0, err = o.r.User.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachUser(ctx, exec, rel0)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a todoEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *TodoEventTemplate) Create(ctx context.Context, exec bob.Executor) (*models.TodoEvent, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableTodoEvent(opt)
+
+	if o.r.Todo == nil {
+		TodoEventMods.WithNewTodo().Apply(ctx, o)
+	}
+
+	var rel1 *models.Todo
+
+	if o.r.Todo.o.alreadyPersisted {
+		rel1 = o.r.Todo.o.Build()
+	} else {
+		rel1, err = o.r.Todo.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TodoID = omit.From(rel1.ID)
+
+	m, err := models.TodoEvents.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Todo = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a todoEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *TodoEventTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.TodoEvent {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a todoEvent and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *TodoEventTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.TodoEvent {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple todoEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o TodoEventTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.TodoEventSlice, error) {
+	var err error
+	m := make(models.TodoEventSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple todoEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o TodoEventTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.TodoEventSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple todoEvents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o TodoEventTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.TodoEventSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// TodoEvent has methods that act as mods for the TodoEventTemplate
+var TodoEventMods todoEventMods
+
+type todoEventMods struct{}
+
+func (m todoEventMods) RandomizeAllColumns(f *faker.Faker) TodoEventMod {
+	return TodoEventModSlice{
+		TodoEventMods.RandomID(f),
+		TodoEventMods.RandomTodoID(f),
+		TodoEventMods.RandomUserID(f),
+		TodoEventMods.RandomKind(f),
+		TodoEventMods.RandomOldValue(f),
+		TodoEventMods.RandomNewValue(f),
+		TodoEventMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m todoEventMods) ID(val int64) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoEventMods) IDFunc(f func() int64) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoEventMods) UnsetID() TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoEventMods) RandomID(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoEventMods) TodoID(val int64) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.TodoID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoEventMods) TodoIDFunc(f func() int64) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.TodoID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoEventMods) UnsetTodoID() TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.TodoID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoEventMods) RandomTodoID(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.TodoID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoEventMods) UserID(val null.Val[int64]) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.UserID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoEventMods) UserIDFunc(f func() null.Val[int64]) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoEventMods) UnsetUserID() TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoEventMods) RandomUserID(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.UserID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoEventMods) RandomUserIDNotNull(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.UserID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoEventMods) Kind(val string) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.Kind = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoEventMods) KindFunc(f func() string) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.Kind = f
+	})
+}
+
+// Clear any values for the column
+func (m todoEventMods) UnsetKind() TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.Kind = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoEventMods) RandomKind(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.Kind = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoEventMods) OldValue(val null.Val[string]) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.OldValue = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoEventMods) OldValueFunc(f func() null.Val[string]) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.OldValue = f
+	})
+}
+
+// Clear any values for the column
+func (m todoEventMods) UnsetOldValue() TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.OldValue = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoEventMods) RandomOldValue(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.OldValue = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoEventMods) RandomOldValueNotNull(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.OldValue = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoEventMods) NewValue(val null.Val[string]) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.NewValue = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoEventMods) NewValueFunc(f func() null.Val[string]) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.NewValue = f
+	})
+}
+
+// Clear any values for the column
+func (m todoEventMods) UnsetNewValue() TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.NewValue = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoEventMods) RandomNewValue(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.NewValue = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoEventMods) RandomNewValueNotNull(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.NewValue = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoEventMods) CreatedAt(val time.Time) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoEventMods) CreatedAtFunc(f func() time.Time) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m todoEventMods) UnsetCreatedAt() TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoEventMods) RandomCreatedAt(f *faker.Faker) TodoEventMod {
+	return TodoEventModFunc(func(_ context.Context, o *TodoEventTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m todoEventMods) WithParentsCascading() TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		if isDone, _ := todoEventWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = todoEventWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithTodo(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m todoEventMods) WithUser(rel *UserTemplate) TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		o.r.User = &todoEventRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoEventMods) WithNewUser(mods ...UserMod) TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m todoEventMods) WithExistingUser(em *models.User) TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		o.r.User = &todoEventRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m todoEventMods) WithoutUser() TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m todoEventMods) WithTodo(rel *TodoTemplate) TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		o.r.Todo = &todoEventRTodoR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoEventMods) WithNewTodo(mods ...TodoMod) TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithTodo(related).Apply(ctx, o)
+	})
+}
+
+func (m todoEventMods) WithExistingTodo(em *models.Todo) TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		o.r.Todo = &todoEventRTodoR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m todoEventMods) WithoutTodo() TodoEventMod {
+	return TodoEventModFunc(func(ctx context.Context, o *TodoEventTemplate) {
+		o.r.Todo = nil
+	})
+}
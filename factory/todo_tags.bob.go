@@ -0,0 +1,431 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/stephenafamo/bob"
+)
+
+type TodoTagMod interface {
+	Apply(context.Context, *TodoTagTemplate)
+}
+
+type TodoTagModFunc func(context.Context, *TodoTagTemplate)
+
+func (f TodoTagModFunc) Apply(ctx context.Context, n *TodoTagTemplate) {
+	f(ctx, n)
+}
+
+type TodoTagModSlice []TodoTagMod
+
+func (mods TodoTagModSlice) Apply(ctx context.Context, n *TodoTagTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// TodoTagTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type TodoTagTemplate struct {
+	TodoID func() int64
+	TagID  func() int64
+
+	r todoTagR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type todoTagR struct {
+	Tag  *todoTagRTagR
+	Todo *todoTagRTodoR
+}
+
+type todoTagRTagR struct {
+	o *TagTemplate
+}
+type todoTagRTodoR struct {
+	o *TodoTemplate
+}
+
+// Apply mods to the TodoTagTemplate
+func (o *TodoTagTemplate) Apply(ctx context.Context, mods ...TodoTagMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.TodoTag
+// according to the relationships in the template. Nothing is inserted into the db
+func (t TodoTagTemplate) setModelRels(o *models.TodoTag) {
+	if t.r.Tag != nil {
+		rel := t.r.Tag.o.Build()
+		o.TagID = rel.ID // h2
+		o.R.Tag = rel
+	}
+
+	if t.r.Todo != nil {
+		rel := t.r.Todo.o.Build()
+		o.TodoID = rel.ID // h2
+		o.R.Todo = rel
+	}
+}
+
+// BuildSetter returns an *models.TodoTagSetter
+// this does nothing with the relationship templates
+func (o TodoTagTemplate) BuildSetter() *models.TodoTagSetter {
+	m := &models.TodoTagSetter{}
+
+	if o.TodoID != nil {
+		val := o.TodoID()
+		m.TodoID = omit.From(val)
+	}
+	if o.TagID != nil {
+		val := o.TagID()
+		m.TagID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.TodoTagSetter
+// this does nothing with the relationship templates
+func (o TodoTagTemplate) BuildManySetter(number int) []*models.TodoTagSetter {
+	m := make([]*models.TodoTagSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.TodoTag
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TodoTagTemplate.Create
+func (o TodoTagTemplate) Build() *models.TodoTag {
+	m := &models.TodoTag{}
+
+	if o.TodoID != nil {
+		m.TodoID = o.TodoID()
+	}
+	if o.TagID != nil {
+		m.TagID = o.TagID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.TodoTagSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TodoTagTemplate.CreateMany
+func (o TodoTagTemplate) BuildMany(number int) models.TodoTagSlice {
+	m := make(models.TodoTagSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableTodoTag(m *models.TodoTagSetter) {
+	if !(m.TodoID.IsValue()) {
+		val := random_int64(nil)
+		m.TodoID = omit.From(val)
+	}
+	if !(m.TagID.IsValue()) {
+		val := random_int64(nil)
+		m.TagID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.TodoTag
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *TodoTagTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.TodoTag) error {
+	var err error
+
+	return err
+}
+
+// Create builds a todoTag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *TodoTagTemplate) Create(ctx context.Context, exec bob.Executor) (*models.TodoTag, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableTodoTag(opt)
+
+	if o.r.Tag == nil {
+		TodoTagMods.WithNewTag().Apply(ctx, o)
+	}
+
+	var rel0 *models.Tag
+
+	if o.r.Tag.o.alreadyPersisted {
+		rel0 = o.r.Tag.o.Build()
+	} else {
+		rel0, err = o.r.Tag.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TagID = omit.From(rel0.ID)
+
+	if o.r.Todo == nil {
+		TodoTagMods.WithNewTodo().Apply(ctx, o)
+	}
+
+	var rel1 *models.Todo
+
+	if o.r.Todo.o.alreadyPersisted {
+		rel1 = o.r.Todo.o.Build()
+	} else {
+		rel1, err = o.r.Todo.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TodoID = omit.From(rel1.ID)
+
+	m, err := models.TodoTags.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Tag = rel0
+	m.R.Todo = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a todoTag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *TodoTagTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.TodoTag {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a todoTag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *TodoTagTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.TodoTag {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple todoTags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o TodoTagTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.TodoTagSlice, error) {
+	var err error
+	m := make(models.TodoTagSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple todoTags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o TodoTagTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.TodoTagSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple todoTags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o TodoTagTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.TodoTagSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// TodoTag has methods that act as mods for the TodoTagTemplate
+var TodoTagMods todoTagMods
+
+type todoTagMods struct{}
+
+func (m todoTagMods) RandomizeAllColumns(f *faker.Faker) TodoTagMod {
+	return TodoTagModSlice{
+		TodoTagMods.RandomTodoID(f),
+		TodoTagMods.RandomTagID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m todoTagMods) TodoID(val int64) TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TodoID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoTagMods) TodoIDFunc(f func() int64) TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TodoID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoTagMods) UnsetTodoID() TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TodoID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoTagMods) RandomTodoID(f *faker.Faker) TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TodoID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoTagMods) TagID(val int64) TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TagID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoTagMods) TagIDFunc(f func() int64) TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TagID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoTagMods) UnsetTagID() TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TagID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoTagMods) RandomTagID(f *faker.Faker) TodoTagMod {
+	return TodoTagModFunc(func(_ context.Context, o *TodoTagTemplate) {
+		o.TagID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+func (m todoTagMods) WithParentsCascading() TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		if isDone, _ := todoTagWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = todoTagWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewTagWithContext(ctx, TagMods.WithParentsCascading())
+			m.WithTag(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithTodo(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m todoTagMods) WithTag(rel *TagTemplate) TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		o.r.Tag = &todoTagRTagR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoTagMods) WithNewTag(mods ...TagMod) TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		related := o.f.NewTagWithContext(ctx, mods...)
+
+		m.WithTag(related).Apply(ctx, o)
+	})
+}
+
+func (m todoTagMods) WithExistingTag(em *models.Tag) TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		o.r.Tag = &todoTagRTagR{
+			o: o.f.FromExistingTag(em),
+		}
+	})
+}
+
+func (m todoTagMods) WithoutTag() TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		o.r.Tag = nil
+	})
+}
+
+func (m todoTagMods) WithTodo(rel *TodoTemplate) TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		o.r.Todo = &todoTagRTodoR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoTagMods) WithNewTodo(mods ...TodoMod) TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithTodo(related).Apply(ctx, o)
+	})
+}
+
+func (m todoTagMods) WithExistingTodo(em *models.Todo) TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		o.r.Todo = &todoTagRTodoR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m todoTagMods) WithoutTodo() TodoTagMod {
+	return TodoTagModFunc(func(ctx context.Context, o *TodoTagTemplate) {
+		o.r.Todo = nil
+	})
+}
@@ -8,7 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/jaswdr/faker/v2"
 	models "github.com/kimihito-sandbox/gostack-test/models"
 	"github.com/stephenafamo/bob"
@@ -35,17 +37,99 @@ func (mods TodoModSlice) Apply(ctx context.Context, n *TodoTemplate) {
 // TodoTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type TodoTemplate struct {
-	ID        func() int64
-	Title     func() string
-	Completed func() bool
-	CreatedAt func() time.Time
-	UpdatedAt func() time.Time
+	ID          func() int64
+	UserID      func() int64
+	Title       func() string
+	Completed   func() bool
+	CreatedAt   func() time.Time
+	UpdatedAt   func() time.Time
+	ListID      func() null.Val[int64]
+	DueAt       func() null.Val[time.Time]
+	Priority    func() int64
+	ParentID    func() null.Val[int64]
+	Recurrence  func() null.Val[string]
+	Notes       func() string
+	Position    func() string
+	DeletedAt   func() null.Val[time.Time]
+	StatusID    func() null.Val[int64]
+	AssigneeID  func() null.Val[int64]
+	CompletedAt func() null.Val[time.Time]
 
+	r todoR
 	f *Factory
 
 	alreadyPersisted bool
 }
 
+type todoR struct {
+	Attachments   []*todoRAttachmentsR
+	Comments      []*todoRCommentsR
+	Notifications []*todoRNotificationsR
+	TimeEntries   []*todoRTimeEntriesR
+	Dependents    []*todoRDependentsR
+	Dependencies  []*todoRDependenciesR
+	TodoEvents    []*todoRTodoEventsR
+	Tags          []*todoRTagsR
+	Assignee      *todoRAssigneeR
+	Status        *todoRStatusR
+	Parent        *todoRParentR
+	Children      []*todoRChildrenR
+	List          *todoRListR
+	User          *todoRUserR
+}
+
+type todoRAttachmentsR struct {
+	number int
+	o      *AttachmentTemplate
+}
+type todoRCommentsR struct {
+	number int
+	o      *CommentTemplate
+}
+type todoRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type todoRTimeEntriesR struct {
+	number int
+	o      *TimeEntryTemplate
+}
+type todoRDependentsR struct {
+	number int
+	o      *TodoDependencyTemplate
+}
+type todoRDependenciesR struct {
+	number int
+	o      *TodoDependencyTemplate
+}
+type todoRTodoEventsR struct {
+	number int
+	o      *TodoEventTemplate
+}
+type todoRTagsR struct {
+	number int
+	o      *TagTemplate
+}
+type todoRAssigneeR struct {
+	o *UserTemplate
+}
+type todoRStatusR struct {
+	o *ListStatusTemplate
+}
+type todoRParentR struct {
+	o *TodoTemplate
+}
+type todoRChildrenR struct {
+	number int
+	o      *TodoTemplate
+}
+type todoRListR struct {
+	o *ListTemplate
+}
+type todoRUserR struct {
+	o *UserTemplate
+}
+
 // Apply mods to the TodoTemplate
 func (o *TodoTemplate) Apply(ctx context.Context, mods ...TodoMod) {
 	for _, mod := range mods {
@@ -55,7 +139,158 @@ func (o *TodoTemplate) Apply(ctx context.Context, mods ...TodoMod) {
 
 // setModelRels creates and sets the relationships on *models.Todo
 // according to the relationships in the template. Nothing is inserted into the db
-func (t TodoTemplate) setModelRels(o *models.Todo) {}
+func (t TodoTemplate) setModelRels(o *models.Todo) {
+	if t.r.Attachments != nil {
+		rel := models.AttachmentSlice{}
+		for _, r := range t.r.Attachments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TodoID = o.ID // h2
+				rel.R.Todo = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Attachments = rel
+	}
+
+	if t.r.Comments != nil {
+		rel := models.CommentSlice{}
+		for _, r := range t.r.Comments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TodoID = o.ID // h2
+				rel.R.Todo = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Comments = rel
+	}
+
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TodoID = o.ID // h2
+				rel.R.Todo = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+
+	if t.r.TimeEntries != nil {
+		rel := models.TimeEntrySlice{}
+		for _, r := range t.r.TimeEntries {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TodoID = o.ID // h2
+				rel.R.Todo = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TimeEntries = rel
+	}
+
+	if t.r.Dependents != nil {
+		rel := models.TodoDependencySlice{}
+		for _, r := range t.r.Dependents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.BlockerID = o.ID // h2
+				rel.R.Blocker = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Dependents = rel
+	}
+
+	if t.r.Dependencies != nil {
+		rel := models.TodoDependencySlice{}
+		for _, r := range t.r.Dependencies {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TodoID = o.ID // h2
+				rel.R.Todo = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Dependencies = rel
+	}
+
+	if t.r.TodoEvents != nil {
+		rel := models.TodoEventSlice{}
+		for _, r := range t.r.TodoEvents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TodoID = o.ID // h2
+				rel.R.Todo = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TodoEvents = rel
+	}
+
+	if t.r.Tags != nil {
+		rel := models.TagSlice{}
+		for _, r := range t.r.Tags {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Todos = append(rel.R.Todos, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Tags = rel
+	}
+
+	if t.r.Assignee != nil {
+		rel := t.r.Assignee.o.Build()
+		rel.R.AssignedTodos = append(rel.R.AssignedTodos, o)
+		o.AssigneeID = null.From(rel.ID) // h2
+		o.R.Assignee = rel
+	}
+
+	if t.r.Status != nil {
+		rel := t.r.Status.o.Build()
+		rel.R.Todos = append(rel.R.Todos, o)
+		o.StatusID = null.From(rel.ID) // h2
+		o.R.Status = rel
+	}
+
+	if t.r.Parent != nil {
+		rel := t.r.Parent.o.Build()
+		rel.R.Parent = o
+		o.ParentID = null.From(rel.ID) // h2
+		o.R.Parent = rel
+	}
+
+	if t.r.Children != nil {
+		rel := models.TodoSlice{}
+		for _, r := range t.r.Children {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ParentID = null.From(o.ID) // h2
+				rel.R.Children = append(rel.R.Children, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Children = rel
+	}
+
+	if t.r.List != nil {
+		rel := t.r.List.o.Build()
+		rel.R.Todos = append(rel.R.Todos, o)
+		o.ListID = null.From(rel.ID) // h2
+		o.R.List = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Todos = append(rel.R.Todos, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
 
 // BuildSetter returns an *models.TodoSetter
 // this does nothing with the relationship templates
@@ -66,6 +301,10 @@ func (o TodoTemplate) BuildSetter() *models.TodoSetter {
 		val := o.ID()
 		m.ID = omit.From(val)
 	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
 	if o.Title != nil {
 		val := o.Title()
 		m.Title = omit.From(val)
@@ -82,6 +321,50 @@ func (o TodoTemplate) BuildSetter() *models.TodoSetter {
 		val := o.UpdatedAt()
 		m.UpdatedAt = omit.From(val)
 	}
+	if o.ListID != nil {
+		val := o.ListID()
+		m.ListID = omitnull.FromNull(val)
+	}
+	if o.DueAt != nil {
+		val := o.DueAt()
+		m.DueAt = omitnull.FromNull(val)
+	}
+	if o.Priority != nil {
+		val := o.Priority()
+		m.Priority = omit.From(val)
+	}
+	if o.ParentID != nil {
+		val := o.ParentID()
+		m.ParentID = omitnull.FromNull(val)
+	}
+	if o.Recurrence != nil {
+		val := o.Recurrence()
+		m.Recurrence = omitnull.FromNull(val)
+	}
+	if o.Notes != nil {
+		val := o.Notes()
+		m.Notes = omit.From(val)
+	}
+	if o.Position != nil {
+		val := o.Position()
+		m.Position = omit.From(val)
+	}
+	if o.DeletedAt != nil {
+		val := o.DeletedAt()
+		m.DeletedAt = omitnull.FromNull(val)
+	}
+	if o.StatusID != nil {
+		val := o.StatusID()
+		m.StatusID = omitnull.FromNull(val)
+	}
+	if o.AssigneeID != nil {
+		val := o.AssigneeID()
+		m.AssigneeID = omitnull.FromNull(val)
+	}
+	if o.CompletedAt != nil {
+		val := o.CompletedAt()
+		m.CompletedAt = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -107,6 +390,9 @@ func (o TodoTemplate) Build() *models.Todo {
 	if o.ID != nil {
 		m.ID = o.ID()
 	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
 	if o.Title != nil {
 		m.Title = o.Title()
 	}
@@ -119,6 +405,39 @@ func (o TodoTemplate) Build() *models.Todo {
 	if o.UpdatedAt != nil {
 		m.UpdatedAt = o.UpdatedAt()
 	}
+	if o.ListID != nil {
+		m.ListID = o.ListID()
+	}
+	if o.DueAt != nil {
+		m.DueAt = o.DueAt()
+	}
+	if o.Priority != nil {
+		m.Priority = o.Priority()
+	}
+	if o.ParentID != nil {
+		m.ParentID = o.ParentID()
+	}
+	if o.Recurrence != nil {
+		m.Recurrence = o.Recurrence()
+	}
+	if o.Notes != nil {
+		m.Notes = o.Notes()
+	}
+	if o.Position != nil {
+		m.Position = o.Position()
+	}
+	if o.DeletedAt != nil {
+		m.DeletedAt = o.DeletedAt()
+	}
+	if o.StatusID != nil {
+		m.StatusID = o.StatusID()
+	}
+	if o.AssigneeID != nil {
+		m.AssigneeID = o.AssigneeID()
+	}
+	if o.CompletedAt != nil {
+		m.CompletedAt = o.CompletedAt()
+	}
 
 	o.setModelRels(m)
 
@@ -139,6 +458,10 @@ func (o TodoTemplate) BuildMany(number int) models.TodoSlice {
 }
 
 func ensureCreatableTodo(m *models.TodoSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_int64(nil)
+		m.UserID = omit.From(val)
+	}
 	if !(m.Title.IsValue()) {
 		val := random_string(nil)
 		m.Title = omit.From(val)
@@ -151,6 +474,262 @@ func ensureCreatableTodo(m *models.TodoSetter) {
 func (o *TodoTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Todo) error {
 	var err error
 
+	isAttachmentsDone, _ := todoRelAttachmentsCtx.Value(ctx)
+	if !isAttachmentsDone && o.r.Attachments != nil {
+		ctx = todoRelAttachmentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Attachments {
+			if r.o.alreadyPersisted {
+				m.R.Attachments = append(m.R.Attachments, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAttachments(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isCommentsDone, _ := todoRelCommentsCtx.Value(ctx)
+	if !isCommentsDone && o.r.Comments != nil {
+		ctx = todoRelCommentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Comments {
+			if r.o.alreadyPersisted {
+				m.R.Comments = append(m.R.Comments, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachComments(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationsDone, _ := todoRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = todoRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
+			if r.o.alreadyPersisted {
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotifications(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTimeEntriesDone, _ := todoRelTimeEntriesCtx.Value(ctx)
+	if !isTimeEntriesDone && o.r.TimeEntries != nil {
+		ctx = todoRelTimeEntriesCtx.WithValue(ctx, true)
+		for _, r := range o.r.TimeEntries {
+			if r.o.alreadyPersisted {
+				m.R.TimeEntries = append(m.R.TimeEntries, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTimeEntries(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isDependentsDone, _ := todoRelDependentsCtx.Value(ctx)
+	if !isDependentsDone && o.r.Dependents != nil {
+		ctx = todoRelDependentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Dependents {
+			if r.o.alreadyPersisted {
+				m.R.Dependents = append(m.R.Dependents, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachDependents(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isDependenciesDone, _ := todoRelDependenciesCtx.Value(ctx)
+	if !isDependenciesDone && o.r.Dependencies != nil {
+		ctx = todoRelDependenciesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Dependencies {
+			if r.o.alreadyPersisted {
+				m.R.Dependencies = append(m.R.Dependencies, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachDependencies(ctx, exec, rel5...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTodoEventsDone, _ := todoRelTodoEventsCtx.Value(ctx)
+	if !isTodoEventsDone && o.r.TodoEvents != nil {
+		ctx = todoRelTodoEventsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TodoEvents {
+			if r.o.alreadyPersisted {
+				m.R.TodoEvents = append(m.R.TodoEvents, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTodoEvents(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTagsDone, _ := todoRelTagsCtx.Value(ctx)
+	if !isTagsDone && o.r.Tags != nil {
+		ctx = todoRelTagsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Tags {
+			if r.o.alreadyPersisted {
+				m.R.Tags = append(m.R.Tags, r.o.Build())
+			} else {
+				rel7, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTags(ctx, exec, rel7...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAssigneeDone, _ := todoRelAssigneeCtx.Value(ctx)
+	if !isAssigneeDone && o.r.Assignee != nil {
+		ctx = todoRelAssigneeCtx.WithValue(ctx, true)
+		if o.r.Assignee.o.alreadyPersisted {
+			m.R.Assignee = o.r.Assignee.o.Build()
+		} else {
+			var rel8 *models.User
+			rel8, err = o.r.Assignee.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachAssignee(ctx, exec, rel8)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isStatusDone, _ := todoRelStatusCtx.Value(ctx)
+	if !isStatusDone && o.r.Status != nil {
+		ctx = todoRelStatusCtx.WithValue(ctx, true)
+		if o.r.Status.o.alreadyPersisted {
+			m.R.Status = o.r.Status.o.Build()
+		} else {
+			var rel9 *models.ListStatus
+			rel9, err = o.r.Status.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachStatus(ctx, exec, rel9)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isParentDone, _ := todoRelParentCtx.Value(ctx)
+	if !isParentDone && o.r.Parent != nil {
+		ctx = todoRelParentCtx.WithValue(ctx, true)
+		if o.r.Parent.o.alreadyPersisted {
+			m.R.Parent = o.r.Parent.o.Build()
+		} else {
+			var rel10 *models.Todo
+			rel10, err = o.r.Parent.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachParent(ctx, exec, rel10)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isChildrenDone, _ := todoRelChildrenCtx.Value(ctx)
+	if !isChildrenDone && o.r.Children != nil {
+		ctx = todoRelChildrenCtx.WithValue(ctx, true)
+		for _, r := range o.r.Children {
+			if r.o.alreadyPersisted {
+				m.R.Children = append(m.R.Children, r.o.Build())
+			} else {
+				rel11, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachChildren(ctx, exec, rel11...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isListDone, _ := todoRelListCtx.Value(ctx)
+	if !isListDone && o.r.List != nil {
+		ctx = todoRelListCtx.WithValue(ctx, true)
+		if o.r.List.o.alreadyPersisted {
+			m.R.List = o.r.List.o.Build()
+		} else {
+			var rel12 *models.List
+			rel12, err = o.r.List.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachList(ctx, exec, rel12)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
 	return err
 }
 
@@ -161,11 +740,30 @@ func (o *TodoTemplate) Create(ctx context.Context, exec bob.Executor) (*models.T
 	opt := o.BuildSetter()
 	ensureCreatableTodo(opt)
 
+	if o.r.User == nil {
+		TodoMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel13 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel13 = o.r.User.o.Build()
+	} else {
+		rel13, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel13.ID)
+
 	m, err := models.Todos.Insert(opt).One(ctx, exec)
 	if err != nil {
 		return nil, err
 	}
 
+	m.R.User = rel13
+
 	if err := o.insertOptRels(ctx, exec, m); err != nil {
 		return nil, err
 	}
@@ -244,10 +842,22 @@ type todoMods struct{}
 func (m todoMods) RandomizeAllColumns(f *faker.Faker) TodoMod {
 	return TodoModSlice{
 		TodoMods.RandomID(f),
+		TodoMods.RandomUserID(f),
 		TodoMods.RandomTitle(f),
 		TodoMods.RandomCompleted(f),
 		TodoMods.RandomCreatedAt(f),
 		TodoMods.RandomUpdatedAt(f),
+		TodoMods.RandomListID(f),
+		TodoMods.RandomDueAt(f),
+		TodoMods.RandomPriority(f),
+		TodoMods.RandomParentID(f),
+		TodoMods.RandomRecurrence(f),
+		TodoMods.RandomNotes(f),
+		TodoMods.RandomPosition(f),
+		TodoMods.RandomDeletedAt(f),
+		TodoMods.RandomStatusID(f),
+		TodoMods.RandomAssigneeID(f),
+		TodoMods.RandomCompletedAt(f),
 	}
 }
 
@@ -282,6 +892,37 @@ func (m todoMods) RandomID(f *faker.Faker) TodoMod {
 	})
 }
 
+// Set the model columns to this value
+func (m todoMods) UserID(val int64) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.UserID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) UserIDFunc(f func() int64) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetUserID() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoMods) RandomUserID(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.UserID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
 // Set the model columns to this value
 func (m todoMods) Title(val string) TodoMod {
 	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
@@ -406,11 +1047,1135 @@ func (m todoMods) RandomUpdatedAt(f *faker.Faker) TodoMod {
 	})
 }
 
-func (m todoMods) WithParentsCascading() TodoMod {
-	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
-		if isDone, _ := todoWithParentsCascadingCtx.Value(ctx); isDone {
-			return
+// Set the model columns to this value
+func (m todoMods) ListID(val null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ListID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) ListIDFunc(f func() null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ListID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetListID() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ListID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomListID(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ListID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
 		}
-		ctx = todoWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomListIDNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ListID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) DueAt(val null.Val[time.Time]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DueAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) DueAtFunc(f func() null.Val[time.Time]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DueAt = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetDueAt() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DueAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomDueAt(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DueAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomDueAtNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DueAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) Priority(val int64) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Priority = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) PriorityFunc(f func() int64) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Priority = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetPriority() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Priority = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoMods) RandomPriority(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Priority = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) ParentID(val null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ParentID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) ParentIDFunc(f func() null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ParentID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetParentID() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ParentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomParentID(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ParentID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomParentIDNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.ParentID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) Recurrence(val null.Val[string]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Recurrence = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) RecurrenceFunc(f func() null.Val[string]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Recurrence = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetRecurrence() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Recurrence = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomRecurrence(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Recurrence = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomRecurrenceNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Recurrence = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) Notes(val string) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Notes = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) NotesFunc(f func() string) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Notes = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetNotes() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Notes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoMods) RandomNotes(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Notes = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) Position(val string) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Position = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) PositionFunc(f func() string) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Position = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetPosition() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Position = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m todoMods) RandomPosition(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.Position = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) DeletedAt(val null.Val[time.Time]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DeletedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) DeletedAtFunc(f func() null.Val[time.Time]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DeletedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetDeletedAt() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DeletedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomDeletedAt(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DeletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomDeletedAtNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.DeletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) StatusID(val null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.StatusID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) StatusIDFunc(f func() null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.StatusID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetStatusID() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.StatusID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomStatusID(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.StatusID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomStatusIDNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.StatusID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) AssigneeID(val null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.AssigneeID = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) AssigneeIDFunc(f func() null.Val[int64]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.AssigneeID = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetAssigneeID() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.AssigneeID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomAssigneeID(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.AssigneeID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomAssigneeIDNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.AssigneeID = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m todoMods) CompletedAt(val null.Val[time.Time]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.CompletedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m todoMods) CompletedAtFunc(f func() null.Val[time.Time]) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.CompletedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m todoMods) UnsetCompletedAt() TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.CompletedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m todoMods) RandomCompletedAt(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.CompletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m todoMods) RandomCompletedAtNotNull(f *faker.Faker) TodoMod {
+	return TodoModFunc(func(_ context.Context, o *TodoTemplate) {
+		o.CompletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m todoMods) WithParentsCascading() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		if isDone, _ := todoWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = todoWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithAssignee(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewListStatusWithContext(ctx, ListStatusMods.WithParentsCascading())
+			m.WithStatus(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTodoWithContext(ctx, TodoMods.WithParentsCascading())
+			m.WithParent(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewListWithContext(ctx, ListMods.WithParentsCascading())
+			m.WithList(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m todoMods) WithAssignee(rel *UserTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Assignee = &todoRAssigneeR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoMods) WithNewAssignee(mods ...UserMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithAssignee(related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) WithExistingAssignee(em *models.User) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Assignee = &todoRAssigneeR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m todoMods) WithoutAssignee() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Assignee = nil
+	})
+}
+
+func (m todoMods) WithStatus(rel *ListStatusTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Status = &todoRStatusR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoMods) WithNewStatus(mods ...ListStatusMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewListStatusWithContext(ctx, mods...)
+
+		m.WithStatus(related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) WithExistingStatus(em *models.ListStatus) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Status = &todoRStatusR{
+			o: o.f.FromExistingListStatus(em),
+		}
+	})
+}
+
+func (m todoMods) WithoutStatus() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Status = nil
+	})
+}
+
+func (m todoMods) WithParent(rel *TodoTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Parent = &todoRParentR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoMods) WithNewParent(mods ...TodoMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+
+		m.WithParent(related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) WithExistingParent(em *models.Todo) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Parent = &todoRParentR{
+			o: o.f.FromExistingTodo(em),
+		}
+	})
+}
+
+func (m todoMods) WithoutParent() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Parent = nil
+	})
+}
+
+func (m todoMods) WithList(rel *ListTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.List = &todoRListR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoMods) WithNewList(mods ...ListMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewListWithContext(ctx, mods...)
+
+		m.WithList(related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) WithExistingList(em *models.List) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.List = &todoRListR{
+			o: o.f.FromExistingList(em),
+		}
+	})
+}
+
+func (m todoMods) WithoutList() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.List = nil
+	})
+}
+
+func (m todoMods) WithUser(rel *UserTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.User = &todoRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m todoMods) WithNewUser(mods ...UserMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) WithExistingUser(em *models.User) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.User = &todoRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m todoMods) WithoutUser() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.User = nil
+	})
+}
+
+func (m todoMods) WithAttachments(number int, related *AttachmentTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Attachments = []*todoRAttachmentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewAttachments(number int, mods ...AttachmentMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewAttachmentWithContext(ctx, mods...)
+		m.WithAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddAttachments(number int, related *AttachmentTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Attachments = append(o.r.Attachments, &todoRAttachmentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewAttachments(number int, mods ...AttachmentMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewAttachmentWithContext(ctx, mods...)
+		m.AddAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingAttachments(existingModels ...*models.Attachment) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.Attachments = append(o.r.Attachments, &todoRAttachmentsR{
+				o: o.f.FromExistingAttachment(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutAttachments() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Attachments = nil
+	})
+}
+
+func (m todoMods) WithComments(number int, related *CommentTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Comments = []*todoRCommentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewComments(number int, mods ...CommentMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.WithComments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddComments(number int, related *CommentTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Comments = append(o.r.Comments, &todoRCommentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewComments(number int, mods ...CommentMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.AddComments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingComments(existingModels ...*models.Comment) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.Comments = append(o.r.Comments, &todoRCommentsR{
+				o: o.f.FromExistingComment(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutComments() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Comments = nil
+	})
+}
+
+func (m todoMods) WithNotifications(number int, related *NotificationTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Notifications = []*todoRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewNotifications(number int, mods ...NotificationMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddNotifications(number int, related *NotificationTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &todoRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewNotifications(number int, mods ...NotificationMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingNotifications(existingModels ...*models.Notification) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &todoRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutNotifications() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Notifications = nil
+	})
+}
+
+func (m todoMods) WithTimeEntries(number int, related *TimeEntryTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.TimeEntries = []*todoRTimeEntriesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewTimeEntries(number int, mods ...TimeEntryMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.WithTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddTimeEntries(number int, related *TimeEntryTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.TimeEntries = append(o.r.TimeEntries, &todoRTimeEntriesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewTimeEntries(number int, mods ...TimeEntryMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.AddTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingTimeEntries(existingModels ...*models.TimeEntry) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.TimeEntries = append(o.r.TimeEntries, &todoRTimeEntriesR{
+				o: o.f.FromExistingTimeEntry(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutTimeEntries() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.TimeEntries = nil
+	})
+}
+
+func (m todoMods) WithDependents(number int, related *TodoDependencyTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Dependents = []*todoRDependentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewDependents(number int, mods ...TodoDependencyMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoDependencyWithContext(ctx, mods...)
+		m.WithDependents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddDependents(number int, related *TodoDependencyTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Dependents = append(o.r.Dependents, &todoRDependentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewDependents(number int, mods ...TodoDependencyMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoDependencyWithContext(ctx, mods...)
+		m.AddDependents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingDependents(existingModels ...*models.TodoDependency) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.Dependents = append(o.r.Dependents, &todoRDependentsR{
+				o: o.f.FromExistingTodoDependency(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutDependents() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Dependents = nil
+	})
+}
+
+func (m todoMods) WithDependencies(number int, related *TodoDependencyTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Dependencies = []*todoRDependenciesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewDependencies(number int, mods ...TodoDependencyMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoDependencyWithContext(ctx, mods...)
+		m.WithDependencies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddDependencies(number int, related *TodoDependencyTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Dependencies = append(o.r.Dependencies, &todoRDependenciesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewDependencies(number int, mods ...TodoDependencyMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoDependencyWithContext(ctx, mods...)
+		m.AddDependencies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingDependencies(existingModels ...*models.TodoDependency) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.Dependencies = append(o.r.Dependencies, &todoRDependenciesR{
+				o: o.f.FromExistingTodoDependency(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutDependencies() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Dependencies = nil
+	})
+}
+
+func (m todoMods) WithTodoEvents(number int, related *TodoEventTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.TodoEvents = []*todoRTodoEventsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewTodoEvents(number int, mods ...TodoEventMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoEventWithContext(ctx, mods...)
+		m.WithTodoEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddTodoEvents(number int, related *TodoEventTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.TodoEvents = append(o.r.TodoEvents, &todoRTodoEventsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewTodoEvents(number int, mods ...TodoEventMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoEventWithContext(ctx, mods...)
+		m.AddTodoEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingTodoEvents(existingModels ...*models.TodoEvent) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.TodoEvents = append(o.r.TodoEvents, &todoRTodoEventsR{
+				o: o.f.FromExistingTodoEvent(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutTodoEvents() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.TodoEvents = nil
+	})
+}
+
+func (m todoMods) WithTags(number int, related *TagTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Tags = []*todoRTagsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewTags(number int, mods ...TagMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTagWithContext(ctx, mods...)
+		m.WithTags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddTags(number int, related *TagTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Tags = append(o.r.Tags, &todoRTagsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewTags(number int, mods ...TagMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTagWithContext(ctx, mods...)
+		m.AddTags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingTags(existingModels ...*models.Tag) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.Tags = append(o.r.Tags, &todoRTagsR{
+				o: o.f.FromExistingTag(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutTags() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Tags = nil
+	})
+}
+
+func (m todoMods) WithChildren(number int, related *TodoTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Children = []*todoRChildrenR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m todoMods) WithNewChildren(number int, mods ...TodoMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.WithChildren(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddChildren(number int, related *TodoTemplate) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Children = append(o.r.Children, &todoRChildrenR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m todoMods) AddNewChildren(number int, mods ...TodoMod) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.AddChildren(number, related).Apply(ctx, o)
+	})
+}
+
+func (m todoMods) AddExistingChildren(existingModels ...*models.Todo) TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		for _, em := range existingModels {
+			o.r.Children = append(o.r.Children, &todoRChildrenR{
+				o: o.f.FromExistingTodo(em),
+			})
+		}
+	})
+}
+
+func (m todoMods) WithoutChildren() TodoMod {
+	return TodoModFunc(func(ctx context.Context, o *TodoTemplate) {
+		o.r.Children = nil
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
 	"github.com/jaswdr/faker/v2"
 	models "github.com/kimihito-sandbox/gostack-test/models"
@@ -35,17 +36,85 @@ func (mods UserModSlice) Apply(ctx context.Context, n *UserTemplate) {
 // UserTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type UserTemplate struct {
-	ID        func() int64
-	Email     func() string
-	Password  func() string
-	CreatedAt func() time.Time
-	UpdatedAt func() time.Time
+	ID          func() int64
+	Email       func() string
+	Password    func() string
+	CreatedAt   func() time.Time
+	UpdatedAt   func() time.Time
+	Timezone    func() string
+	TodoSort    func() string
+	TodoSortDir func() string
 
+	r userR
 	f *Factory
 
 	alreadyPersisted bool
 }
 
+type userR struct {
+	Attachments        []*userRAttachmentsR
+	ChecklistTemplates []*userRChecklistTemplatesR
+	Comments           []*userRCommentsR
+	ListMembers        []*userRListMembersR
+	Lists              []*userRListsR
+	ActorNotifications []*userRActorNotificationsR
+	Notifications      []*userRNotificationsR
+	Tags               []*userRTagsR
+	TimeEntries        []*userRTimeEntriesR
+	TodoEvents         []*userRTodoEventsR
+	AssignedTodos      []*userRAssignedTodosR
+	Todos              []*userRTodosR
+}
+
+type userRAttachmentsR struct {
+	number int
+	o      *AttachmentTemplate
+}
+type userRChecklistTemplatesR struct {
+	number int
+	o      *ChecklistTemplateTemplate
+}
+type userRCommentsR struct {
+	number int
+	o      *CommentTemplate
+}
+type userRListMembersR struct {
+	number int
+	o      *ListMemberTemplate
+}
+type userRListsR struct {
+	number int
+	o      *ListTemplate
+}
+type userRActorNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type userRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type userRTagsR struct {
+	number int
+	o      *TagTemplate
+}
+type userRTimeEntriesR struct {
+	number int
+	o      *TimeEntryTemplate
+}
+type userRTodoEventsR struct {
+	number int
+	o      *TodoEventTemplate
+}
+type userRAssignedTodosR struct {
+	number int
+	o      *TodoTemplate
+}
+type userRTodosR struct {
+	number int
+	o      *TodoTemplate
+}
+
 // Apply mods to the UserTemplate
 func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
 	for _, mod := range mods {
@@ -55,7 +124,163 @@ func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
 
 // setModelRels creates and sets the relationships on *models.User
 // according to the relationships in the template. Nothing is inserted into the db
-func (t UserTemplate) setModelRels(o *models.User) {}
+func (t UserTemplate) setModelRels(o *models.User) {
+	if t.r.Attachments != nil {
+		rel := models.AttachmentSlice{}
+		for _, r := range t.r.Attachments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Attachments = rel
+	}
+
+	if t.r.ChecklistTemplates != nil {
+		rel := models.ChecklistTemplateSlice{}
+		for _, r := range t.r.ChecklistTemplates {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ChecklistTemplates = rel
+	}
+
+	if t.r.Comments != nil {
+		rel := models.CommentSlice{}
+		for _, r := range t.r.Comments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Comments = rel
+	}
+
+	if t.r.ListMembers != nil {
+		rel := models.ListMemberSlice{}
+		for _, r := range t.r.ListMembers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ListMembers = rel
+	}
+
+	if t.r.Lists != nil {
+		rel := models.ListSlice{}
+		for _, r := range t.r.Lists {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Lists = rel
+	}
+
+	if t.r.ActorNotifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.ActorNotifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ActorID = o.ID // h2
+				rel.R.Actor = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ActorNotifications = rel
+	}
+
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+
+	if t.r.Tags != nil {
+		rel := models.TagSlice{}
+		for _, r := range t.r.Tags {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Tags = rel
+	}
+
+	if t.r.TimeEntries != nil {
+		rel := models.TimeEntrySlice{}
+		for _, r := range t.r.TimeEntries {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TimeEntries = rel
+	}
+
+	if t.r.TodoEvents != nil {
+		rel := models.TodoEventSlice{}
+		for _, r := range t.r.TodoEvents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = null.From(o.ID) // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TodoEvents = rel
+	}
+
+	if t.r.AssignedTodos != nil {
+		rel := models.TodoSlice{}
+		for _, r := range t.r.AssignedTodos {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AssigneeID = null.From(o.ID) // h2
+				rel.R.Assignee = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AssignedTodos = rel
+	}
+
+	if t.r.Todos != nil {
+		rel := models.TodoSlice{}
+		for _, r := range t.r.Todos {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Todos = rel
+	}
+}
 
 // BuildSetter returns an *models.UserSetter
 // this does nothing with the relationship templates
@@ -82,6 +307,18 @@ func (o UserTemplate) BuildSetter() *models.UserSetter {
 		val := o.UpdatedAt()
 		m.UpdatedAt = omit.From(val)
 	}
+	if o.Timezone != nil {
+		val := o.Timezone()
+		m.Timezone = omit.From(val)
+	}
+	if o.TodoSort != nil {
+		val := o.TodoSort()
+		m.TodoSort = omit.From(val)
+	}
+	if o.TodoSortDir != nil {
+		val := o.TodoSortDir()
+		m.TodoSortDir = omit.From(val)
+	}
 
 	return m
 }
@@ -119,6 +356,15 @@ func (o UserTemplate) Build() *models.User {
 	if o.UpdatedAt != nil {
 		m.UpdatedAt = o.UpdatedAt()
 	}
+	if o.Timezone != nil {
+		m.Timezone = o.Timezone()
+	}
+	if o.TodoSort != nil {
+		m.TodoSort = o.TodoSort()
+	}
+	if o.TodoSortDir != nil {
+		m.TodoSortDir = o.TodoSortDir()
+	}
 
 	o.setModelRels(m)
 
@@ -155,6 +401,246 @@ func ensureCreatableUser(m *models.UserSetter) {
 func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.User) error {
 	var err error
 
+	isAttachmentsDone, _ := userRelAttachmentsCtx.Value(ctx)
+	if !isAttachmentsDone && o.r.Attachments != nil {
+		ctx = userRelAttachmentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Attachments {
+			if r.o.alreadyPersisted {
+				m.R.Attachments = append(m.R.Attachments, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAttachments(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isChecklistTemplatesDone, _ := userRelChecklistTemplatesCtx.Value(ctx)
+	if !isChecklistTemplatesDone && o.r.ChecklistTemplates != nil {
+		ctx = userRelChecklistTemplatesCtx.WithValue(ctx, true)
+		for _, r := range o.r.ChecklistTemplates {
+			if r.o.alreadyPersisted {
+				m.R.ChecklistTemplates = append(m.R.ChecklistTemplates, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachChecklistTemplates(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isCommentsDone, _ := userRelCommentsCtx.Value(ctx)
+	if !isCommentsDone && o.r.Comments != nil {
+		ctx = userRelCommentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Comments {
+			if r.o.alreadyPersisted {
+				m.R.Comments = append(m.R.Comments, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachComments(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isListMembersDone, _ := userRelListMembersCtx.Value(ctx)
+	if !isListMembersDone && o.r.ListMembers != nil {
+		ctx = userRelListMembersCtx.WithValue(ctx, true)
+		for _, r := range o.r.ListMembers {
+			if r.o.alreadyPersisted {
+				m.R.ListMembers = append(m.R.ListMembers, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachListMembers(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isListsDone, _ := userRelListsCtx.Value(ctx)
+	if !isListsDone && o.r.Lists != nil {
+		ctx = userRelListsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Lists {
+			if r.o.alreadyPersisted {
+				m.R.Lists = append(m.R.Lists, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachLists(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isActorNotificationsDone, _ := userRelActorNotificationsCtx.Value(ctx)
+	if !isActorNotificationsDone && o.r.ActorNotifications != nil {
+		ctx = userRelActorNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ActorNotifications {
+			if r.o.alreadyPersisted {
+				m.R.ActorNotifications = append(m.R.ActorNotifications, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachActorNotifications(ctx, exec, rel5...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationsDone, _ := userRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = userRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
+			if r.o.alreadyPersisted {
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotifications(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTagsDone, _ := userRelTagsCtx.Value(ctx)
+	if !isTagsDone && o.r.Tags != nil {
+		ctx = userRelTagsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Tags {
+			if r.o.alreadyPersisted {
+				m.R.Tags = append(m.R.Tags, r.o.Build())
+			} else {
+				rel7, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTags(ctx, exec, rel7...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTimeEntriesDone, _ := userRelTimeEntriesCtx.Value(ctx)
+	if !isTimeEntriesDone && o.r.TimeEntries != nil {
+		ctx = userRelTimeEntriesCtx.WithValue(ctx, true)
+		for _, r := range o.r.TimeEntries {
+			if r.o.alreadyPersisted {
+				m.R.TimeEntries = append(m.R.TimeEntries, r.o.Build())
+			} else {
+				rel8, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTimeEntries(ctx, exec, rel8...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTodoEventsDone, _ := userRelTodoEventsCtx.Value(ctx)
+	if !isTodoEventsDone && o.r.TodoEvents != nil {
+		ctx = userRelTodoEventsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TodoEvents {
+			if r.o.alreadyPersisted {
+				m.R.TodoEvents = append(m.R.TodoEvents, r.o.Build())
+			} else {
+				rel9, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTodoEvents(ctx, exec, rel9...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isAssignedTodosDone, _ := userRelAssignedTodosCtx.Value(ctx)
+	if !isAssignedTodosDone && o.r.AssignedTodos != nil {
+		ctx = userRelAssignedTodosCtx.WithValue(ctx, true)
+		for _, r := range o.r.AssignedTodos {
+			if r.o.alreadyPersisted {
+				m.R.AssignedTodos = append(m.R.AssignedTodos, r.o.Build())
+			} else {
+				rel10, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAssignedTodos(ctx, exec, rel10...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTodosDone, _ := userRelTodosCtx.Value(ctx)
+	if !isTodosDone && o.r.Todos != nil {
+		ctx = userRelTodosCtx.WithValue(ctx, true)
+		for _, r := range o.r.Todos {
+			if r.o.alreadyPersisted {
+				m.R.Todos = append(m.R.Todos, r.o.Build())
+			} else {
+				rel11, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTodos(ctx, exec, rel11...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return err
 }
 
@@ -252,6 +738,9 @@ func (m userMods) RandomizeAllColumns(f *faker.Faker) UserMod {
 		UserMods.RandomPassword(f),
 		UserMods.RandomCreatedAt(f),
 		UserMods.RandomUpdatedAt(f),
+		UserMods.RandomTimezone(f),
+		UserMods.RandomTodoSort(f),
+		UserMods.RandomTodoSortDir(f),
 	}
 }
 
@@ -410,6 +899,99 @@ func (m userMods) RandomUpdatedAt(f *faker.Faker) UserMod {
 	})
 }
 
+// Set the model columns to this value
+func (m userMods) Timezone(val string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Timezone = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) TimezoneFunc(f func() string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Timezone = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetTimezone() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Timezone = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomTimezone(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.Timezone = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) TodoSort(val string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSort = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) TodoSortFunc(f func() string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSort = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetTodoSort() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSort = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomTodoSort(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSort = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m userMods) TodoSortDir(val string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSortDir = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) TodoSortDirFunc(f func() string) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSortDir = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetTodoSortDir() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSortDir = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m userMods) RandomTodoSortDir(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.TodoSortDir = func() string {
+			return random_string(f)
+		}
+	})
+}
+
 func (m userMods) WithParentsCascading() UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
 		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
@@ -418,3 +1000,579 @@ func (m userMods) WithParentsCascading() UserMod {
 		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
 	})
 }
+
+func (m userMods) WithAttachments(number int, related *AttachmentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Attachments = []*userRAttachmentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAttachments(number int, mods ...AttachmentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAttachmentWithContext(ctx, mods...)
+		m.WithAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAttachments(number int, related *AttachmentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Attachments = append(o.r.Attachments, &userRAttachmentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAttachments(number int, mods ...AttachmentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewAttachmentWithContext(ctx, mods...)
+		m.AddAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAttachments(existingModels ...*models.Attachment) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Attachments = append(o.r.Attachments, &userRAttachmentsR{
+				o: o.f.FromExistingAttachment(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAttachments() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Attachments = nil
+	})
+}
+
+func (m userMods) WithChecklistTemplates(number int, related *ChecklistTemplateTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ChecklistTemplates = []*userRChecklistTemplatesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewChecklistTemplates(number int, mods ...ChecklistTemplateMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewChecklistTemplateWithContext(ctx, mods...)
+		m.WithChecklistTemplates(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddChecklistTemplates(number int, related *ChecklistTemplateTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ChecklistTemplates = append(o.r.ChecklistTemplates, &userRChecklistTemplatesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewChecklistTemplates(number int, mods ...ChecklistTemplateMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewChecklistTemplateWithContext(ctx, mods...)
+		m.AddChecklistTemplates(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingChecklistTemplates(existingModels ...*models.ChecklistTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ChecklistTemplates = append(o.r.ChecklistTemplates, &userRChecklistTemplatesR{
+				o: o.f.FromExistingChecklistTemplate(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutChecklistTemplates() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ChecklistTemplates = nil
+	})
+}
+
+func (m userMods) WithComments(number int, related *CommentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Comments = []*userRCommentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewComments(number int, mods ...CommentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.WithComments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddComments(number int, related *CommentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Comments = append(o.r.Comments, &userRCommentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewComments(number int, mods ...CommentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewCommentWithContext(ctx, mods...)
+		m.AddComments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingComments(existingModels ...*models.Comment) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Comments = append(o.r.Comments, &userRCommentsR{
+				o: o.f.FromExistingComment(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutComments() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Comments = nil
+	})
+}
+
+func (m userMods) WithListMembers(number int, related *ListMemberTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ListMembers = []*userRListMembersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewListMembers(number int, mods ...ListMemberMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewListMemberWithContext(ctx, mods...)
+		m.WithListMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddListMembers(number int, related *ListMemberTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ListMembers = append(o.r.ListMembers, &userRListMembersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewListMembers(number int, mods ...ListMemberMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewListMemberWithContext(ctx, mods...)
+		m.AddListMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingListMembers(existingModels ...*models.ListMember) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ListMembers = append(o.r.ListMembers, &userRListMembersR{
+				o: o.f.FromExistingListMember(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutListMembers() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ListMembers = nil
+	})
+}
+
+func (m userMods) WithLists(number int, related *ListTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Lists = []*userRListsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewLists(number int, mods ...ListMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewListWithContext(ctx, mods...)
+		m.WithLists(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddLists(number int, related *ListTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Lists = append(o.r.Lists, &userRListsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewLists(number int, mods ...ListMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewListWithContext(ctx, mods...)
+		m.AddLists(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingLists(existingModels ...*models.List) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Lists = append(o.r.Lists, &userRListsR{
+				o: o.f.FromExistingList(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutLists() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Lists = nil
+	})
+}
+
+func (m userMods) WithActorNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = []*userRActorNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewActorNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithActorNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddActorNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = append(o.r.ActorNotifications, &userRActorNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewActorNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddActorNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingActorNotifications(existingModels ...*models.Notification) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ActorNotifications = append(o.r.ActorNotifications, &userRActorNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutActorNotifications() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = nil
+	})
+}
+
+func (m userMods) WithNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = []*userRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingNotifications(existingModels ...*models.Notification) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutNotifications() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = nil
+	})
+}
+
+func (m userMods) WithTags(number int, related *TagTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Tags = []*userRTagsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTags(number int, mods ...TagMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTagWithContext(ctx, mods...)
+		m.WithTags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTags(number int, related *TagTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Tags = append(o.r.Tags, &userRTagsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTags(number int, mods ...TagMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTagWithContext(ctx, mods...)
+		m.AddTags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTags(existingModels ...*models.Tag) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Tags = append(o.r.Tags, &userRTagsR{
+				o: o.f.FromExistingTag(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTags() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Tags = nil
+	})
+}
+
+func (m userMods) WithTimeEntries(number int, related *TimeEntryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TimeEntries = []*userRTimeEntriesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTimeEntries(number int, mods ...TimeEntryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.WithTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTimeEntries(number int, related *TimeEntryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TimeEntries = append(o.r.TimeEntries, &userRTimeEntriesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTimeEntries(number int, mods ...TimeEntryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.AddTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTimeEntries(existingModels ...*models.TimeEntry) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TimeEntries = append(o.r.TimeEntries, &userRTimeEntriesR{
+				o: o.f.FromExistingTimeEntry(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTimeEntries() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TimeEntries = nil
+	})
+}
+
+func (m userMods) WithTodoEvents(number int, related *TodoEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TodoEvents = []*userRTodoEventsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTodoEvents(number int, mods ...TodoEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTodoEventWithContext(ctx, mods...)
+		m.WithTodoEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTodoEvents(number int, related *TodoEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TodoEvents = append(o.r.TodoEvents, &userRTodoEventsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTodoEvents(number int, mods ...TodoEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTodoEventWithContext(ctx, mods...)
+		m.AddTodoEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTodoEvents(existingModels ...*models.TodoEvent) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TodoEvents = append(o.r.TodoEvents, &userRTodoEventsR{
+				o: o.f.FromExistingTodoEvent(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTodoEvents() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TodoEvents = nil
+	})
+}
+
+func (m userMods) WithAssignedTodos(number int, related *TodoTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AssignedTodos = []*userRAssignedTodosR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewAssignedTodos(number int, mods ...TodoMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.WithAssignedTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddAssignedTodos(number int, related *TodoTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AssignedTodos = append(o.r.AssignedTodos, &userRAssignedTodosR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewAssignedTodos(number int, mods ...TodoMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.AddAssignedTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingAssignedTodos(existingModels ...*models.Todo) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.AssignedTodos = append(o.r.AssignedTodos, &userRAssignedTodosR{
+				o: o.f.FromExistingTodo(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutAssignedTodos() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.AssignedTodos = nil
+	})
+}
+
+func (m userMods) WithTodos(number int, related *TodoTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Todos = []*userRTodosR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTodos(number int, mods ...TodoMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.WithTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTodos(number int, related *TodoTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Todos = append(o.r.Todos, &userRTodosR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTodos(number int, mods ...TodoMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTodoWithContext(ctx, mods...)
+		m.AddTodos(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTodos(existingModels ...*models.Todo) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Todos = append(o.r.Todos, &userRTodosR{
+				o: o.f.FromExistingTodo(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTodos() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Todos = nil
+	})
+}
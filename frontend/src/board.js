@@ -0,0 +1,75 @@
+import htmx from 'htmx.org'
+
+// かんばんボードのカードをドラッグ＆ドロップで別の列や同じ列の別の位置へ動かす
+// ボード（#board）は htmx で差し替わるので、イベントは document で受ける
+// 落とした列の状態と前後のカードのIDをサーバーへ送り、ボードを描き直してもらう
+
+let dragging = null
+let originalColumn = null
+let originalNext = null
+
+function column(el) {
+  return el.closest('ul[data-board-status]')
+}
+
+function cardID(li) {
+  return li ? li.id.replace(/^card-/, '') : ''
+}
+
+document.addEventListener('dragstart', (e) => {
+  const li = e.target.closest?.('li[data-card][draggable="true"]')
+  if (!li) return
+  dragging = li
+  originalColumn = column(li)
+  originalNext = li.nextElementSibling
+  e.dataTransfer.effectAllowed = 'move'
+  e.dataTransfer.setData('text/plain', li.id)
+  li.style.opacity = '0.5'
+})
+
+document.addEventListener('dragover', (e) => {
+  if (!dragging) return
+  const ul = e.target.closest?.('ul[data-board-status]')
+  if (!ul) return
+  e.preventDefault()
+  const over = e.target.closest('li[data-card]')
+  if (!over) {
+    // 列の空いているところなら末尾に入れる
+    if (dragging.parentElement !== ul || dragging.nextElementSibling) ul.append(dragging)
+    return
+  }
+  if (over === dragging) return
+  // 要素の上半分なら前に、下半分なら後ろに入れる
+  const rect = over.getBoundingClientRect()
+  if (e.clientY < rect.top + rect.height / 2) {
+    over.before(dragging)
+  } else {
+    over.after(dragging)
+  }
+})
+
+document.addEventListener('drop', (e) => {
+  if (dragging) e.preventDefault()
+})
+
+document.addEventListener('dragend', () => {
+  const li = dragging
+  dragging = null
+  if (!li) return
+  li.style.opacity = ''
+
+  const ul = column(li)
+  const next = li.nextElementSibling
+  // 列も位置も変わっていなければ送らない
+  if (ul === originalColumn && next === originalNext) return
+  htmx.ajax('POST', '/todos/' + cardID(li) + '/status', {
+    target: '#board',
+    swap: 'innerHTML',
+    values: {
+      csrf_token: ul.closest('[data-csrf-token]').dataset.csrfToken,
+      status_id: ul.dataset.boardStatus,
+      before_id: cardID(li.previousElementSibling),
+      after_id: cardID(next),
+    },
+  })
+})
@@ -0,0 +1,13 @@
+// 一括操作の「すべて完了にする」「完了したTodoを削除」ボタン
+// 押したときに表示中の該当するTodo（data-bulk-select が active なら未完了、completed なら完了）を選び、
+// 操作を data-bulk-action にしてから一括操作のフォームを送る
+document.addEventListener('click', (e) => {
+  const button = e.target.closest?.('button[data-bulk-select]')
+  if (!button) return
+  const form = button.form
+  const completed = button.dataset.bulkSelect === 'completed'
+  for (const input of form.elements) {
+    if (input.name === 'ids') input.checked = input.hasAttribute('data-completed') === completed
+  }
+  form.elements.namedItem('action').value = button.dataset.bulkAction
+})
@@ -0,0 +1,49 @@
+import htmx from 'htmx.org'
+
+// カレンダーのTodoをドラッグ＆ドロップで別の日や「期限なし」へ動かす
+// カレンダー（#calendar）は htmx で差し替わるので、イベントは document で受ける
+// 落とした日をサーバーへ送り、カレンダーを描き直してもらう（403 などで失敗したら何も変わらない）
+
+let dragging = null
+
+function dayOf(el) {
+  return el.closest?.('[data-calendar-day]')
+}
+
+document.addEventListener('dragstart', (e) => {
+  const li = e.target.closest?.('li[data-calendar-todo]')
+  if (!li) return
+  dragging = li
+  e.dataTransfer.effectAllowed = 'move'
+  e.dataTransfer.setData('text/plain', li.id)
+  li.style.opacity = '0.5'
+})
+
+document.addEventListener('dragover', (e) => {
+  if (dragging && dayOf(e.target)) e.preventDefault()
+})
+
+document.addEventListener('drop', (e) => {
+  const li = dragging
+  const day = li && dayOf(e.target)
+  if (!day) return
+  e.preventDefault()
+  // 同じ日に落としたときは送らない
+  if (day === dayOf(li)) return
+  const calendar = day.closest('[data-calendar]')
+  htmx.ajax('POST', '/todos/' + li.id.replace(/^calendar-todo-/, '') + '/schedule', {
+    target: '#calendar',
+    swap: 'innerHTML',
+    values: {
+      csrf_token: calendar.dataset.csrfToken,
+      due_on: day.dataset.calendarDay,
+      view: calendar.dataset.view,
+      date: calendar.dataset.date,
+    },
+  })
+})
+
+document.addEventListener('dragend', () => {
+  if (dragging) dragging.style.opacity = ''
+  dragging = null
+})
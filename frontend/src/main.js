@@ -1,2 +1,34 @@
 import '@picocss/pico/css/pico.min.css'
-import 'htmx.org'
+import htmx from 'htmx.org'
+import './reorder.js'
+import './bulk.js'
+import './board.js'
+import './calendar.js'
+
+// hx-on などのインライン属性から htmx を参照できるようにする
+window.htmx = htmx
+
+// 入力エラーのフォーム（422）は差し替えて表示する。それ以外は htmx の既定のまま
+htmx.config.responseHandling = [
+  { code: '422', swap: true },
+  ...htmx.config.responseHandling,
+]
+
+// ブロック中のTodoを完了にしようとして断られた（409）ときは、確認してから force を付けて送り直す
+// 一括操作の「すべて完了にする」やボードで完了の列へ動かしたときに使う
+document.addEventListener('htmx:responseError', (e) => {
+  const { xhr, requestConfig } = e.detail
+  if (xhr.status !== 409 || requestConfig.formData.has('force')) return
+  let message = xhr.responseText
+  try {
+    message = JSON.parse(message).message
+  } catch {}
+  if (!confirm(message + '\n完了にしますか？')) return
+  const values = { force: '1' }
+  for (const key of requestConfig.formData.keys()) values[key] = requestConfig.formData.getAll(key)
+  htmx.ajax(requestConfig.verb.toUpperCase(), requestConfig.path, {
+    source: e.detail.elt,
+    target: e.detail.target,
+    values,
+  })
+})
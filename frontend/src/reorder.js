@@ -0,0 +1,80 @@
+import htmx from 'htmx.org'
+
+// 手動の並び順のTodo一覧をドラッグ＆ドロップで並び替える
+// 一覧（data-reorder-url 付きの ul）は htmx で差し替わるので、イベントは document で受ける
+// 並べ替えた後の前後のTodoのIDをサーバーへ送り、位置はサーバーが決める
+
+// 差し替えや追加で読み込まれた一覧のTodoをドラッグできるようにする
+htmx.onLoad((elt) => {
+  const items = [...elt.querySelectorAll('ul[data-reorder-url] > li')]
+  if (elt.matches('ul[data-reorder-url] > li')) items.push(elt)
+  for (const li of items) li.draggable = !li.hidden
+})
+
+let dragging = null
+let originalNext = null
+
+function reorderList(el) {
+  return el.closest('ul[data-reorder-url]')
+}
+
+// 削除して空になった行（hidden）は飛ばして隣のTodoを探す
+function sibling(li, prop) {
+  let el = li[prop]
+  while (el && el.hidden) el = el[prop]
+  return el
+}
+
+function todoID(li) {
+  return li ? li.id.replace(/^todo-/, '') : ''
+}
+
+document.addEventListener('dragstart', (e) => {
+  const li = e.target.closest?.('li[draggable="true"]')
+  if (!li || !reorderList(li)) return
+  dragging = li
+  originalNext = sibling(li, 'nextElementSibling')
+  e.dataTransfer.effectAllowed = 'move'
+  e.dataTransfer.setData('text/plain', li.id)
+  li.style.opacity = '0.5'
+})
+
+document.addEventListener('dragover', (e) => {
+  if (!dragging) return
+  const over = e.target.closest?.('li[draggable="true"]')
+  if (!over || over === dragging || reorderList(over) !== reorderList(dragging)) return
+  e.preventDefault()
+  // 要素の上半分なら前に、下半分なら後ろに入れる
+  const rect = over.getBoundingClientRect()
+  if (e.clientY < rect.top + rect.height / 2) {
+    over.before(dragging)
+  } else {
+    over.after(dragging)
+  }
+})
+
+document.addEventListener('drop', (e) => {
+  if (dragging) e.preventDefault()
+})
+
+document.addEventListener('dragend', () => {
+  const li = dragging
+  dragging = null
+  if (!li) return
+  li.style.opacity = ''
+
+  const list = reorderList(li)
+  const prev = sibling(li, 'previousElementSibling')
+  const next = sibling(li, 'nextElementSibling')
+  // 位置が変わっていなければ送らない
+  if (next === originalNext || (!prev && !next)) return
+  htmx.ajax('POST', list.dataset.reorderUrl, {
+    swap: 'none',
+    values: {
+      csrf_token: list.dataset.csrfToken,
+      id: todoID(li),
+      before_id: todoID(prev),
+      after_id: todoID(next),
+    },
+  })
+})
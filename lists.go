@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zhttp"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/storage"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+type ListInput struct {
+	Name string `zog:"name"`
+}
+
+var listSchema = z.Struct(z.Shape{
+	"Name": z.String().Trim().Required(z.Message("リスト名は必須です")),
+})
+
+// registerListRoutes はリストのルートを登録する
+// requireAuth の後ろに置く。個別のリストへの操作はrequireListRoleで権限を確認する
+func registerListRoutes(g *echo.Group, db bob.DB, store storage.Storage) {
+	// リスト管理ページ（自分が所有するリストのみ）
+	g.GET("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		lists, err := models.Lists.Query(
+			models.SelectWhere.Lists.UserID.EQ(userID),
+			sm.OrderBy(models.Lists.Columns.ID),
+		).All(ctx, db)
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.ListIndex(lists, csrfToken))
+	})
+
+	// リスト作成
+	g.POST("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+
+		var input ListInput
+		if issues := listSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, issues[0].Message)
+		}
+
+		// かんばんボードの既定の状態もあわせて作る
+		now := time.Now().UTC()
+		var list *models.List
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			var err error
+			list, err = models.Lists.Insert(&models.ListSetter{
+				UserID:    omit.From(userID),
+				Name:      omit.From(input.Name),
+				CreatedAt: omit.From(now),
+				UpdatedAt: omit.From(now),
+			}).One(ctx, exec)
+			if err != nil {
+				return err
+			}
+			return createDefaultStatuses(ctx, exec, list.ID)
+		})
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.ListItem(list, csrfToken))
+	})
+
+	// リスト名の変更
+	g.POST("/:id/rename", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+
+		var input ListInput
+		if issues := listSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, issues[0].Message)
+		}
+
+		err := list.Update(ctx, db, &models.ListSetter{
+			Name:      omit.From(input.Name),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		})
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.ListItem(list, csrfToken))
+	}, requireListRole(db, RoleAdmin))
+
+	// アーカイブ状態の切り替え
+	g.POST("/:id/archive", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+
+		err := list.Update(ctx, db, &models.ListSetter{
+			Archived:  omit.From(!list.Archived),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		})
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.ListItem(list, csrfToken))
+	}, requireListRole(db, RoleAdmin))
+
+	// リスト削除。リスト内のTodoは所有者の受信箱へ移してゴミ箱に入れ、ゴミ箱から戻せるようにする
+	// 移しそびれたTodoが外部キーのCASCADEで消えた場合に備え、参照されなくなった添付ファイルの中身は保存先からも消す
+	g.POST("/:id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		keys, err := listAttachmentKeys(ctx, db, list.ID)
+		if err != nil {
+			return err
+		}
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			return deleteList(ctx, exec, list, time.Now().UTC())
+		})
+		if err != nil {
+			return err
+		}
+		if err := deleteUnusedBlobs(ctx, db, store, keys...); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusOK)
+	}, requireListRole(db, RoleOwner))
+
+	// リスト内のTodo一覧
+	g.GET("/:id/todos", func(c echo.Context) error {
+		list := c.Get("list").(*models.List)
+		return renderTodoIndex(c, db, list, models.SelectWhere.Todos.ListID.EQ(list.ID))
+	}, requireListRole(db, RoleViewer))
+
+	// リスト内にTodo作成（作成者をTodoの所有者とする）
+	g.POST("/:id/todos", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		if list.Archived {
+			return echo.NewHTTPError(http.StatusConflict, "アーカイブしたリストにはTodoを追加できません")
+		}
+		title := c.FormValue("title")
+		if title == "" {
+			return c.Redirect(http.StatusFound, "/lists/"+c.Param("id")+"/todos")
+		}
+		userID := c.Get("user_id").(int64)
+		var todo *models.Todo
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			position, err := appendPosition(ctx, exec, positionScopeForList(list.ID, userID))
+			if err != nil {
+				return err
+			}
+			todo, err = models.Todos.Insert(&models.TodoSetter{
+				UserID:   omit.From(userID),
+				ListID:   omitnull.From(list.ID),
+				Title:    omit.From(title),
+				Position: omit.From(position),
+			}).One(ctx, exec)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, todo)
+	}, requireListRole(db, RoleEditor))
+
+	registerMemberRoutes(g, db)
+	registerBoardRoutes(g, db)
+}
+
+// deleteList はリストを削除する
+// リストのTodo（ゴミ箱にあるものも含む）はサブタスクごと所有者の受信箱へ移し、まだ削除していないものはゴミ箱に入れる
+// 担当者と状態はリストの中でだけ意味があるので外す
+func deleteList(ctx context.Context, exec bob.Executor, list *models.List, now time.Time) error {
+	todos, err := models.Todos.Query(
+		models.SelectWhere.Todos.ListID.EQ(list.ID),
+		models.SelectWhere.Todos.ParentID.IsNull(),
+	).All(withDeletedTodos(ctx), exec)
+	if err != nil {
+		return err
+	}
+	if len(todos) > 0 {
+		setter := &models.TodoSetter{UserID: omit.From(list.UserID), UpdatedAt: omit.From(now)}
+		setter.ListID.Null()
+		setter.StatusID.Null()
+		setter.AssigneeID.Null()
+		if err := moveTodos(ctx, exec, todos, setter, positionScope{UserID: list.UserID}); err != nil {
+			return err
+		}
+		var open models.TodoSlice
+		for _, todo := range todos {
+			if todo.DeletedAt.IsNull() {
+				open = append(open, todo)
+			}
+		}
+		if len(open) > 0 {
+			if err := softDeleteTodos(ctx, exec, open, now); err != nil {
+				return err
+			}
+		}
+	}
+	return list.Delete(ctx, exec)
+}
+
+// loadSidebar はサイドバーに表示するリストと未完了件数をContextに注入するミドルウェア
+// requireAuth の後ろに置く
+func loadSidebar(db bob.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			sidebar, err := buildSidebar(ctx, db, c.Get("user_id").(int64))
+			if err != nil {
+				return err
+			}
+			c.SetRequest(c.Request().WithContext(views.SidebarToContext(ctx, sidebar)))
+			return next(c)
+		}
+	}
+}
+
+// openTodoCount はリストごとの未完了Todo件数の集計結果
+type openTodoCount struct {
+	ListID    null.Val[int64] `db:"list_id"`
+	OpenCount int64           `db:"open_count"`
+}
+
+// buildSidebar はアクセスできるアーカイブされていないリストと、リストごとの未完了件数、未読の通知の件数を集計する
+func buildSidebar(ctx context.Context, db bob.DB, userID int64) (views.Sidebar, error) {
+	lists, err := models.Lists.Query(
+		sm.Where(models.Lists.Columns.ID.OP("IN", accessibleListIDs(userID))),
+		models.SelectWhere.Lists.Archived.EQ(false),
+		sm.OrderBy(models.Lists.Columns.ID),
+	).All(ctx, db)
+	if err != nil {
+		return views.Sidebar{}, err
+	}
+
+	counts, err := bob.All(ctx, db, sqlite.Select(
+		sm.Columns(models.Todos.Columns.ListID, sqlite.Raw("COUNT(*)").As("open_count")),
+		sm.From(models.Todos.Name()),
+		sm.Where(accessibleTodos(userID)),
+		models.SelectWhere.Todos.Completed.EQ(false),
+		models.SelectWhere.Todos.DeletedAt.IsNull(),
+		// サブタスクは親の行の中に表示するので数えない
+		models.SelectWhere.Todos.ParentID.IsNull(),
+		sm.GroupBy(models.Todos.Columns.ListID),
+	), scan.StructMapper[openTodoCount]())
+	if err != nil {
+		return views.Sidebar{}, err
+	}
+
+	var sidebar views.Sidebar
+	countByList := make(map[int64]int64, len(counts))
+	for _, count := range counts {
+		if listID, ok := count.ListID.Get(); ok {
+			countByList[listID] = count.OpenCount
+		} else {
+			sidebar.InboxOpenCount = count.OpenCount
+		}
+	}
+	for _, list := range lists {
+		sidebar.Lists = append(sidebar.Lists, views.SidebarList{List: list, OpenCount: countByList[list.ID]})
+	}
+
+	sidebar.UnreadNotificationCount, err = unreadNotificationCount(ctx, db, userID)
+	if err != nil {
+		return views.Sidebar{}, err
+	}
+	return sidebar, nil
+}
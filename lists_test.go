@@ -0,0 +1,187 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestListTodosAndSidebar(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	work := f.NewListWithContext(ctx, factory.ListMods.Name("Work"), factory.ListMods.Archived(false), factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	listPath := "/lists/" + strconv.FormatInt(work.ID, 10) + "/todos"
+	if rec := tc.do(http.MethodPost, listPath, url.Values{"title": {"write report"}}); rec.Code != http.StatusOK {
+		t.Fatalf("POST %s: status = %d", listPath, rec.Code)
+	}
+	if rec := tc.do(http.MethodPost, "/todos", url.Values{"title": {"buy milk"}}); rec.Code != http.StatusOK {
+		t.Fatalf("POST /todos: status = %d", rec.Code)
+	}
+
+	rec := tc.do(http.MethodGet, listPath, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s: status = %d", listPath, rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "write report") || strings.Contains(body, "buy milk") {
+		t.Errorf("GET %s: want only the list's todos, got %s", listPath, body)
+	}
+	if !strings.Contains(body, `Work</a> <small>(1)</small>`) {
+		t.Errorf("GET %s: sidebar does not show Work with 1 open todo", listPath)
+	}
+
+	// 受信箱のTodoをWorkへ移動する
+	inbox, err := alice.Todos(models.SelectWhere.Todos.ListID.IsNull()).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	movePath := "/todos/" + strconv.FormatInt(inbox.ID, 10) + "/move"
+	if rec := tc.do(http.MethodPost, movePath, url.Values{"list_id": {strconv.FormatInt(work.ID, 10)}}); rec.Code != http.StatusOK {
+		t.Fatalf("POST %s: status = %d", movePath, rec.Code)
+	}
+	if n, err := work.Todos().Count(ctx, db); err != nil || n != 2 {
+		t.Errorf("Work todos = %d (err %v), want 2", n, err)
+	}
+}
+
+func TestCreateTodoInArchivedList(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	archived := factory.New().NewListWithContext(ctx, factory.ListMods.Archived(true), factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	listPath := "/lists/" + strconv.FormatInt(archived.ID, 10) + "/todos"
+	if rec := tc.do(http.MethodPost, listPath, url.Values{"title": {"write report"}}); rec.Code != http.StatusConflict {
+		t.Errorf("POST %s: status = %d, want %d", listPath, rec.Code, http.StatusConflict)
+	}
+	if n, err := archived.Todos().Count(ctx, db); err != nil || n != 0 {
+		t.Errorf("archived list todos = %d (err %v), want 0", n, err)
+	}
+
+	// アーカイブを解除すれば追加できる
+	if rec := tc.do(http.MethodPost, "/lists/"+strconv.FormatInt(archived.ID, 10)+"/archive", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("unarchive: status = %d", rec.Code)
+	}
+	if rec := tc.do(http.MethodPost, listPath, url.Values{"title": {"write report"}}); rec.Code != http.StatusOK {
+		t.Errorf("POST %s after unarchive: status = %d", listPath, rec.Code)
+	}
+}
+
+func TestListsAreIsolatedPerUser(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	bob := createTestUser(t, db, "bob@example.com")
+	bobList := f.NewListWithContext(ctx, factory.ListMods.Name("Bob's"), factory.ListMods.WithExistingUser(bob)).CreateOrFail(ctx, t, db)
+	aliceTodo := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	bobListID := strconv.FormatInt(bobList.ID, 10)
+	for _, req := range []struct {
+		method string
+		path   string
+		form   url.Values
+	}{
+		{http.MethodGet, "/lists/" + bobListID + "/todos", nil},
+		{http.MethodPost, "/lists/" + bobListID + "/todos", url.Values{"title": {"intrusion"}}},
+		{http.MethodPost, "/lists/" + bobListID + "/rename", url.Values{"name": {"hacked"}}},
+		{http.MethodPost, "/lists/" + bobListID + "/archive", url.Values{}},
+		{http.MethodPost, "/lists/" + bobListID + "/delete", url.Values{}},
+		{http.MethodPost, "/todos/" + strconv.FormatInt(aliceTodo.ID, 10) + "/move", url.Values{"list_id": {bobListID}}},
+	} {
+		if rec := tc.do(req.method, req.path, req.form); rec.Code != http.StatusNotFound {
+			t.Errorf("%s %s: status = %d, want %d", req.method, req.path, rec.Code, http.StatusNotFound)
+		}
+	}
+
+	after, err := models.FindList(ctx, db, bobList.ID)
+	if err != nil {
+		t.Fatalf("bob's list was deleted: %v", err)
+	}
+	if after.Name != bobList.Name || after.Archived != bobList.Archived {
+		t.Errorf("bob's list was modified: %+v", after)
+	}
+}
+
+func TestDeleteListMovesTodosToTrash(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	editor := createTestUser(t, db, "editor@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(editor),
+		factory.ListMemberMods.Role(memberRoleEditor),
+	).CreateOrFail(ctx, t, db)
+	newTodo := func(title string, mods ...factory.TodoMod) *models.Todo {
+		mods = append([]factory.TodoMod{
+			factory.TodoMods.WithExistingUser(editor),
+			factory.TodoMods.ListID(null.From(list.ID)),
+			factory.TodoMods.Title(title),
+		}, mods...)
+		return f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db)
+	}
+	parent := newTodo("list-parent", factory.TodoMods.AssigneeID(null.From(editor.ID)))
+	child := newTodo("list-child", factory.TodoMods.ParentID(null.From(parent.ID)))
+	trashedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	trashed := newTodo("list-trashed", factory.TodoMods.DeletedAt(null.From(trashedAt)))
+	f.NewAttachmentWithContext(ctx, factory.AttachmentMods.WithExistingTodo(parent)).CreateOrFail(ctx, t, db)
+
+	tc := login(t, e, owner)
+	if rec := tc.do(http.MethodPost, "/lists/"+strconv.FormatInt(list.ID, 10)+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("delete list: status = %d", rec.Code)
+	}
+	if exists, _ := models.ListExists(ctx, db, list.ID); exists {
+		t.Fatal("list still exists")
+	}
+
+	// Todoは消えずに所有者の受信箱へ移り、ゴミ箱に入る。先にゴミ箱にあったものは削除日時を変えない
+	for _, todo := range []*models.Todo{parent, child, trashed} {
+		got, err := models.FindTodo(withDeletedTodos(ctx), db, todo.ID)
+		if err != nil {
+			t.Fatalf("%s: %v", todo.Title, err)
+		}
+		if got.ListID.IsValue() || got.UserID != owner.ID || got.AssigneeID.IsValue() || got.DeletedAt.IsNull() {
+			t.Errorf("%s = list %v, user %d, assignee %v, deleted %v; want the owner's trash", todo.Title, got.ListID, got.UserID, got.AssigneeID, got.DeletedAt)
+		}
+		if todo == trashed && !got.DeletedAt.MustGet().Equal(trashedAt) {
+			t.Errorf("deleted_at of already trashed todo = %v, want %v", got.DeletedAt.MustGet(), trashedAt)
+		}
+	}
+	if n, err := models.Attachments.Query().Count(ctx, db); err != nil || n != 1 {
+		t.Errorf("attachments = %d, %v; want 1", n, err)
+	}
+
+	rec := tc.do(http.MethodGet, "/trash", nil)
+	if body := rec.Body.String(); !strings.Contains(body, "list-parent") || !strings.Contains(body, "list-trashed") {
+		t.Errorf("trash does not list the todos of the deleted list: %s", body)
+	}
+	if rec := tc.do(http.MethodPost, "/trash/"+strconv.FormatInt(parent.ID, 10)+"/restore", url.Values{}); rec.Code != http.StatusFound {
+		t.Fatalf("restore: status = %d", rec.Code)
+	}
+	if exists, _ := models.TodoExists(ctx, db, child.ID); !exists {
+		t.Error("subtask was not restored with its parent")
+	}
+	if rec := tc.do(http.MethodGet, "/todos", nil); !strings.Contains(rec.Body.String(), "list-parent") {
+		t.Error("restored todo is not in the owner's inbox")
+	}
+}
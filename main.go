@@ -5,67 +5,36 @@ import (
 	"database/sql"
 	"embed"
 	"io/fs"
-	"net/http"
 	"os"
-	"strconv"
 	"time"
+	_ "time/tzdata"
 
-	"github.com/a-h/templ"
-	"github.com/aarondl/opt/omit"
 	"github.com/alexedwards/scs/sqlite3store"
 	"github.com/alexedwards/scs/v2"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	"github.com/labstack/gommon/log"
 	"github.com/olivere/vite"
 	"github.com/pressly/goose/v3"
 	"github.com/stephenafamo/bob"
-	"golang.org/x/crypto/bcrypt"
 	_ "modernc.org/sqlite"
 
-	z "github.com/Oudwins/zog"
-	"github.com/Oudwins/zog/zhttp"
-	"github.com/kimihito-sandbox/gostack-test/models"
-	"github.com/kimihito-sandbox/gostack-test/views"
+	"github.com/kimihito-sandbox/gostack-test/storage"
 )
 
 //go:embed all:frontend/dist
 var distFS embed.FS
 
-// バリデーション用の構造体とスキーマ
-type LoginInput struct {
-	Email    string `zog:"email"`
-	Password string `zog:"password"`
-}
-
-var loginSchema = z.Struct(z.Shape{
-	"Email":    z.String().Required(z.Message("メールアドレスは必須です")).Email(z.Message("有効なメールアドレスを入力してください")),
-	"Password": z.String().Required(z.Message("パスワードは必須です")),
-})
-
-type RegisterInput struct {
-	Email           string `zog:"email"`
-	Password        string `zog:"password"`
-	ConfirmPassword string `zog:"confirm_password"`
-}
-
-var registerSchema = z.Struct(z.Shape{
-	"Email":           z.String().Required(z.Message("メールアドレスは必須です")).Email(z.Message("有効なメールアドレスを入力してください")),
-	"Password":        z.String().Required(z.Message("パスワードは必須です")).Min(8, z.Message("パスワードは8文字以上で入力してください")),
-	"ConfirmPassword": z.String().Required(z.Message("パスワード確認は必須です")),
-})
-
-type TodoInput struct {
-	Title string `zog:"title"`
-}
+// dbOptions はSQLiteの接続オプション。外部キー制約を有効にする
+// トランザクションは始めるときに書き込みロックを取り（BEGIN IMMEDIATE）、読んだ値をもとに書く処理に別の書き込みが割り込まないようにする
+// ロックを待つ間は busy_timeout までエラーにせず待つ
+const dbOptions = "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate"
 
-var todoSchema = z.Struct(z.Shape{
-	"Title": z.String().Required(z.Message("タイトルは必須です")).Min(1, z.Message("タイトルは必須です")),
-})
+// dbDSN はSQLiteの接続文字列
+const dbDSN = "db/app.db" + dbOptions
 
 func main() {
 	// DB接続
-	sqlDB, err := sql.Open("sqlite", "db/app.db")
+	sqlDB, err := sql.Open("sqlite", dbDSN)
 	if err != nil {
 		panic(err)
 	}
@@ -86,9 +55,24 @@ func main() {
 	sessionManager.Store = sqlite3store.New(sqlDB)
 	sessionManager.Lifetime = 24 * time.Hour
 
-	e := echo.New()
+	// 添付ファイルの保存先
+	store, err := newStorage()
+	if err != nil {
+		panic(err)
+	}
+
+	// ゴミ箱の保持期間
+	retention, err := trashRetention()
+	if err != nil {
+		panic(err)
+	}
+
+	e := newServer(db, sessionManager, store)
 	e.Logger.SetLevel(log.DEBUG)
 
+	// 保持期間を過ぎたゴミ箱のTodoを定期的に完全に削除する
+	go runTrashPurger(context.Background(), db, store, retention, e.Logger)
+
 	// Vite設定
 	isDev := os.Getenv("VITE_DEV") == "true"
 	var viteConfig vite.Config
@@ -123,36 +107,6 @@ func main() {
 		e.StaticFS("/assets", echo.MustSubFS(assetsFS, "."))
 	}
 
-	// ミドルウェア
-	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogStatus:   true, // HTTPステータスコードを記録
-		LogURI:      true, // リクエストURIを記録
-		LogError:    true, // エラー情報を記録
-		HandleError: true, // エラー時もログを出力
-		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			if v.Error == nil {
-				e.Logger.Infof("REQUEST: uri=%v, status=%v", v.URI, v.Status)
-			} else {
-				e.Logger.Errorf("REQUEST ERROR: uri=%v, status=%v, err=%v", v.URI, v.Status, v.Error)
-			}
-			return nil
-		},
-	}))
-	e.Use(middleware.Recover())
-
-	// scsセッションミドルウェア
-	e.Use(echo.WrapMiddleware(sessionManager.LoadAndSave))
-
-	// CSRFミドルウェア
-	e.Use(middleware.CSRFWithConfig(middleware.CSRFConfig{
-		TokenLookup:    "form:csrf_token",       // フォームからトークンを取得
-		CookieName:     "_csrf",                 // Cookieの名前
-		CookiePath:     "/",                     // Cookie適用パス（全体）
-		CookieSecure:   false,                   // 開発環境ではfalse、本番ではtrue
-		CookieHTTPOnly: true,                    // JavaScriptからアクセス不可
-		CookieSameSite: http.SameSiteStrictMode, // CSRF対策を強化
-	}))
-
 	// ViteタグをContextに注入するミドルウェア
 	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -162,222 +116,26 @@ func main() {
 		}
 	})
 
-	// ルーティング
-	e.GET("/", func(c echo.Context) error {
-		return c.Redirect(http.StatusFound, "/todos")
-	})
-
-	// ========== 認証 ==========
-
-	// ログインページ表示
-	e.GET("/auth/login", func(c echo.Context) error {
-		// 既にログイン済みならリダイレクト
-		if sessionManager.GetInt64(c.Request().Context(), "user_id") != 0 {
-			return c.Redirect(http.StatusFound, "/todos")
-		}
-		csrfToken := c.Get("csrf").(string)
-		return render(c, http.StatusOK, views.LoginPage(csrfToken, nil))
-	})
-
-	// ログイン処理
-	e.POST("/auth/login", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		csrfToken := c.Get("csrf").(string)
-
-		var input LoginInput
-		if issues := loginSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
-			return render(c, http.StatusBadRequest, views.LoginPage(csrfToken, issuesToMap(issues)))
-		}
-
-		// ユーザーを検索
-		user, err := models.Users.Query(
-			models.SelectWhere.Users.Email.EQ(input.Email),
-		).One(ctx, db)
-		if err != nil {
-			return render(c, http.StatusBadRequest, views.LoginPage(csrfToken, map[string][]string{"_": {"メールアドレスまたはパスワードが正しくありません"}}))
-		}
-
-		// パスワード検証
-		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.Password)); err != nil {
-			return render(c, http.StatusBadRequest, views.LoginPage(csrfToken, map[string][]string{"_": {"メールアドレスまたはパスワードが正しくありません"}}))
-		}
-
-		// セッションにユーザーIDを保存
-		sessionManager.Put(ctx, "user_id", user.ID)
-
-		return c.Redirect(http.StatusFound, "/todos")
-	})
-
-	// 新規登録ページ表示
-	e.GET("/auth/register", func(c echo.Context) error {
-		// 既にログイン済みならリダイレクト
-		if sessionManager.GetInt64(c.Request().Context(), "user_id") != 0 {
-			return c.Redirect(http.StatusFound, "/todos")
-		}
-		csrfToken := c.Get("csrf").(string)
-		return render(c, http.StatusOK, views.RegisterPage(csrfToken, nil))
-	})
-
-	// 新規登録処理
-	e.POST("/auth/register", func(c echo.Context) error {
-		ctx := c.Request().Context()
-		csrfToken := c.Get("csrf").(string)
-
-		var input RegisterInput
-		if issues := registerSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
-			return render(c, http.StatusBadRequest, views.RegisterPage(csrfToken, issuesToMap(issues)))
-		}
-
-		// パスワード確認チェック
-		if input.Password != input.ConfirmPassword {
-			return render(c, http.StatusBadRequest, views.RegisterPage(csrfToken, map[string][]string{"confirm_password": {"パスワードが一致しません"}}))
-		}
-
-		// 既存ユーザーチェック
-		_, err := models.Users.Query(
-			models.SelectWhere.Users.Email.EQ(input.Email),
-		).One(ctx, db)
-		if err == nil {
-			return render(c, http.StatusBadRequest, views.RegisterPage(csrfToken, map[string][]string{"email": {"このメールアドレスは既に登録されています"}}))
-		}
-
-		// パスワードハッシュ化
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
-		if err != nil {
-			return err
-		}
-
-		// ユーザー作成
-		now := time.Now()
-		user, err := models.Users.Insert(&models.UserSetter{
-			Email:     omit.From(input.Email),
-			Password:  omit.From(string(hashedPassword)),
-			CreatedAt: omit.From(now),
-			UpdatedAt: omit.From(now),
-		}).One(ctx, db)
-		if err != nil {
-			return err
-		}
-
-		// セッションにユーザーIDを保存（自動ログイン）
-		sessionManager.Put(ctx, "user_id", user.ID)
-
-		return c.Redirect(http.StatusFound, "/todos")
-	})
-
-	// ログアウト
-	e.POST("/auth/logout", func(c echo.Context) error {
-		sessionManager.Destroy(c.Request().Context())
-		return c.Redirect(http.StatusFound, "/auth/login")
-	})
-
-	// ========== Todo（認証必須） ==========
-
-	// 認証が必要なルートグループ
-	protected := e.Group("/todos")
-	protected.Use(requireAuth(sessionManager))
-
-	// Todo一覧
-	protected.GET("", func(c echo.Context) error {
-		ctx := context.Background()
-		todos, err := models.Todos.Query().All(ctx, db)
-		if err != nil {
-			return err
-		}
-		csrfToken := c.Get("csrf").(string)
-		return render(c, http.StatusOK, views.TodoIndex(todos, csrfToken))
-	})
-
-	// Todo作成
-	protected.POST("", func(c echo.Context) error {
-		ctx := context.Background()
-		title := c.FormValue("title")
-		if title == "" {
-			return c.Redirect(http.StatusFound, "/todos")
-		}
-		todo, err := models.Todos.Insert(&models.TodoSetter{
-			Title: omit.From(title),
-		}).One(ctx, db)
-		if err != nil {
-			return err
-		}
-		csrfToken := c.Get("csrf").(string)
-		return render(c, http.StatusOK, views.TodoItem(todo, csrfToken))
-	})
-
-	// Todo完了状態の切り替え
-	protected.POST("/:id/toggle", func(c echo.Context) error {
-		ctx := context.Background()
-		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
-		if err != nil {
-			return err
-		}
-
-		todo, err := models.Todos.Query(
-			models.SelectWhere.Todos.ID.EQ(id),
-		).One(ctx, db)
-		if err != nil {
-			return err
-		}
-
-		err = todo.Update(ctx, db, &models.TodoSetter{
-			Completed: omit.From(!todo.Completed),
-			UpdatedAt: omit.From(time.Now()),
-		})
-		if err != nil {
-			return err
-		}
-		csrfToken := c.Get("csrf").(string)
-		return render(c, http.StatusOK, views.TodoItem(todo, csrfToken))
-	})
-
-	// Todo削除
-	protected.POST("/:id/delete", func(c echo.Context) error {
-		ctx := context.Background()
-		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
-		if err != nil {
-			return err
-		}
-		_, err = models.Todos.Delete(
-			models.DeleteWhere.Todos.ID.EQ(id),
-		).Exec(ctx, db)
-		if err != nil {
-			return err
-		}
-		return c.NoContent(http.StatusOK)
-	})
-
 	e.Logger.Fatal(e.Start(":8080"))
 }
 
-// render はTemplコンポーネントをEchoのレスポンスとして返すヘルパー関数
-func render(c echo.Context, statusCode int, t templ.Component) error {
-	c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextHTMLCharsetUTF8)
-	c.Response().WriteHeader(statusCode)
-	return t.Render(c.Request().Context(), c.Response())
-}
-
-// requireAuth は認証を必要とするミドルウェア
-func requireAuth(sessionManager *scs.SessionManager) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			userID := sessionManager.GetInt64(c.Request().Context(), "user_id")
-			if userID == 0 {
-				return c.Redirect(http.StatusFound, "/auth/login")
-			}
-			return next(c)
-		}
+// newStorage は環境変数で選んだ添付ファイルの保存先を返す
+// STORAGE_BACKEND=s3 ならS3互換のストレージ（S3_* で接続先を指定）、それ以外は ATTACHMENT_DIR（既定は db/attachments）に保存する
+func newStorage() (storage.Storage, error) {
+	if os.Getenv("STORAGE_BACKEND") == "s3" {
+		return storage.NewS3(storage.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			Region:    os.Getenv("S3_REGION"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			UseSSL:    os.Getenv("S3_USE_SSL") != "false",
+			PathStyle: os.Getenv("S3_PATH_STYLE") == "true",
+		})
 	}
-}
-
-// issuesToMap はzogのZogIssueListをフィールドごとのエラーマップに変換する
-func issuesToMap(issues z.ZogIssueList) map[string][]string {
-	errs := make(map[string][]string)
-	for _, issue := range issues {
-		if len(issue.Path) > 0 {
-			key := issue.Path[0]
-			errs[key] = append(errs[key], issue.Message)
-		}
+	dir := os.Getenv("ATTACHMENT_DIR")
+	if dir == "" {
+		dir = "db/attachments"
 	}
-	return errs
+	return storage.NewLocal(dir)
 }
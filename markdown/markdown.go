@@ -0,0 +1,119 @@
+// Package markdown はTodoのメモ（Markdown）をサニタイズ済みのHTMLに変換する
+// タスクリスト（- [ ] / - [x]）は番号付きのチェックボックスにし、チェック状態をMarkdownに書き戻せるようにする
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// HTML はサニタイズ済みのHTML。templ.Raw に渡してよいのはこの型の値だけにする
+type HTML string
+
+// TaskFieldName はタスクリストのチェックボックスのフォーム項目名。値はタスクの番号（0始まり）
+const TaskFieldName = "task"
+
+// policy は出力してよい要素と属性の許可リスト
+// 生のHTMLはgoldmarkが出力しないが、念のため変換後のHTMLも必ずここを通す
+var policy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("name").Matching(regexp.MustCompile(`^` + TaskFieldName + `$`)).OnElements("input")
+	p.AllowAttrs("value").Matching(regexp.MustCompile(`^[0-9]+$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^$`)).OnElements("input")
+	return p
+}()
+
+// Render はMarkdownをサニタイズ済みのHTMLに変換する。タスクリストのチェックボックスは操作できる
+func Render(source string) (HTML, error) {
+	return render(source, true)
+}
+
+// RenderReadOnly はチェックボックスを操作できない状態でHTMLに変換する
+func RenderReadOnly(source string) (HTML, error) {
+	return render(source, false)
+}
+
+func render(source string, editable bool) (HTML, error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(
+			// GFMのチェックボックスの描画より優先する
+			renderer.WithNodeRenderers(util.Prioritized(&taskRenderer{editable: editable}, 100)),
+		),
+	)
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+	return HTML(policy.SanitizeBytes(buf.Bytes())), nil
+}
+
+// SetTasks はタスクリストのチェック状態を checked の番号のものだけチェック済みにしたMarkdownを返す
+// 番号は Render が付けたものと同じく、文書中に現れる順の0始まり
+func SetTasks(source string, checked map[int]bool) string {
+	src := []byte(source)
+	doc := goldmark.New(goldmark.WithExtensions(extension.GFM)).Parser().Parse(text.NewReader(src))
+
+	out := bytes.Clone(src)
+	index := 0
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || n.Kind() != east.KindTaskCheckBox {
+			return ast.WalkContinue, nil
+		}
+		// チェックボックスは段落の先頭にあり、段落の1行目は "[ ]" の "[" から始まる
+		if lines := n.Parent().Lines(); lines.Len() > 0 {
+			pos := lines.At(0).Start + 1
+			if pos < len(out) && src[pos-1] == '[' {
+				if checked[index] {
+					out[pos] = 'x'
+				} else {
+					out[pos] = ' '
+				}
+			}
+		}
+		index++
+		return ast.WalkContinue, nil
+	})
+	return string(out)
+}
+
+// taskRenderer はタスクリストのチェックボックスを番号付きで描画する
+type taskRenderer struct {
+	editable bool
+	index    int
+}
+
+func (r *taskRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(east.KindTaskCheckBox, r.renderTaskCheckBox)
+}
+
+func (r *taskRenderer) renderTaskCheckBox(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*east.TaskCheckBox)
+
+	_, _ = w.WriteString(`<input type="checkbox" name="` + TaskFieldName + `" value="` + strconv.Itoa(r.index) + `"`)
+	if n.IsChecked {
+		_, _ = w.WriteString(` checked=""`)
+	}
+	if !r.editable {
+		_, _ = w.WriteString(` disabled=""`)
+	}
+	_, _ = w.WriteString("> ")
+	r.index++
+	return ast.WalkContinue, nil
+}
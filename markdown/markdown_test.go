@@ -0,0 +1,94 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	got, err := Render("# 見出し\n\n**太字** と [リンク](https://example.com)\n\n| a | b |\n|---|---|\n| 1 | 2 |\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<h1", "<strong>太字</strong>", `href="https://example.com"`, `rel="nofollow`, "<table>"} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("Render() = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestRenderSanitizes(t *testing.T) {
+	for _, source := range []string{
+		"<script>alert(1)</script>",
+		"<img src=x onerror=alert(1)>",
+		"[click](javascript:alert(1))",
+		"![img](javascript:alert(1))",
+		"<a href=\"https://example.com\" onclick=\"alert(1)\">x</a>",
+		"<iframe src=\"https://example.com\"></iframe>",
+		"<input type=\"text\" name=\"task\" value=\"0\" onfocus=\"alert(1)\" autofocus>",
+		"<form action=\"/todos/1/delete\"><button>x</button></form>",
+		"<div hx-post=\"/todos/1/delete\" hx-trigger=\"load\">x</div>",
+		"[x](https://example.com \"a\\\" onmouseover=\\\"alert(1)\")",
+	} {
+		for _, render := range []func(string) (HTML, error){Render, RenderReadOnly} {
+			got, err := render(source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lower := strings.ToLower(string(got))
+			for _, bad := range []string{"<script", "onerror", "onclick", "onfocus", "onmouseover=", "javascript:", "<iframe", "<form", "hx-", `type="text"`, "autofocus"} {
+				if strings.Contains(lower, bad) {
+					t.Errorf("Render(%q) = %s, contains %s", source, got, bad)
+				}
+			}
+		}
+	}
+}
+
+func TestRenderTaskList(t *testing.T) {
+	source := "- [ ] 買い物\n- [x] 掃除\n\n```\n- [ ] コードブロックの中\n```\n\n1. [ ] 番号付き\n"
+
+	got, err := Render(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<input type="checkbox" name="task" value="0">`,
+		`<input type="checkbox" name="task" value="1" checked="">`,
+		`<input type="checkbox" name="task" value="2">`,
+		"- [ ] コードブロックの中",
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("Render() = %s, want %s", got, want)
+		}
+	}
+	if strings.Contains(string(got), `value="3"`) {
+		t.Errorf("Render() numbered a task inside a code block: %s", got)
+	}
+
+	readOnly, err := RenderReadOnly(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(string(readOnly), `disabled=""`) != 3 {
+		t.Errorf("RenderReadOnly() = %s, want 3 disabled checkboxes", readOnly)
+	}
+}
+
+func TestSetTasks(t *testing.T) {
+	source := "メモ\n\n- [ ] 買い物\n- [x] 掃除\n  - [X] 入れ子\n\n```\n- [ ] コードブロックの中\n```\n\n* [ ] 最後\n"
+
+	got := SetTasks(source, map[int]bool{0: true, 3: true})
+	want := "メモ\n\n- [x] 買い物\n- [ ] 掃除\n  - [ ] 入れ子\n\n```\n- [ ] コードブロックの中\n```\n\n* [x] 最後\n"
+	if got != want {
+		t.Errorf("SetTasks() =\n%s\nwant\n%s", got, want)
+	}
+
+	// チェック状態が同じなら元のMarkdownのまま
+	if got := SetTasks("- [ ] a\n- [x] b\n", map[int]bool{1: true}); got != "- [ ] a\n- [x] b\n" {
+		t.Errorf("SetTasks() = %q, want unchanged", got)
+	}
+	if got := SetTasks("タスクなし", map[int]bool{0: true}); got != "タスクなし" {
+		t.Errorf("SetTasks() = %q, want unchanged", got)
+	}
+}
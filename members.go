@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zhttp"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+type MemberInput struct {
+	Email string `zog:"email"`
+	Role  string `zog:"role"`
+}
+
+var memberSchema = z.Struct(z.Shape{
+	"Email": z.String().Required(z.Message("メールアドレスは必須です")).Email(z.Message("有効なメールアドレスを入力してください")),
+	"Role":  z.String().Required(z.Message("権限は必須です")).OneOf([]string{memberRoleViewer, memberRoleEditor, memberRoleAdmin}, z.Message("権限が正しくありません")),
+})
+
+// registerMemberRoutes はリストのメンバー管理のルートを登録する
+// メンバーの招待・権限変更・削除はリストの管理者以上が行える
+func registerMemberRoutes(g *echo.Group, db bob.DB) {
+	// メンバー一覧
+	g.GET("/:id/members", func(c echo.Context) error {
+		list := c.Get("list").(*models.List)
+		members, err := listMembers(c.Request().Context(), db, list)
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.MemberIndex(list, members, csrfToken, nil))
+	}, requireListRole(db, RoleAdmin))
+
+	// メンバー招待
+	g.POST("/:id/members", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		csrfToken := c.Get("csrf").(string)
+
+		renderErrors := func(errs map[string][]string) error {
+			members, err := listMembers(c.Request().Context(), db, list)
+			if err != nil {
+				return err
+			}
+			return render(c, http.StatusBadRequest, views.MemberIndex(list, members, csrfToken, errs))
+		}
+
+		var input MemberInput
+		if issues := memberSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return renderErrors(issuesToMap(issues))
+		}
+
+		user, err := models.Users.Query(
+			models.SelectWhere.Users.Email.EQ(input.Email),
+		).One(ctx, db)
+		if errors.Is(err, sql.ErrNoRows) {
+			return renderErrors(map[string][]string{"email": {"このメールアドレスのユーザーは登録されていません"}})
+		}
+		if err != nil {
+			return err
+		}
+		if user.ID == list.UserID {
+			return renderErrors(map[string][]string{"email": {"リストの所有者は招待できません"}})
+		}
+		exists, err := models.ListMemberExists(ctx, db, list.ID, user.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return renderErrors(map[string][]string{"email": {"このユーザーは既にメンバーです"}})
+		}
+
+		now := time.Now().UTC()
+		_, err = models.ListMembers.Insert(&models.ListMemberSetter{
+			ListID:    omit.From(list.ID),
+			UserID:    omit.From(user.ID),
+			Role:      omit.From(input.Role),
+			CreatedAt: omit.From(now),
+			UpdatedAt: omit.From(now),
+		}).One(ctx, db)
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/lists/"+strconv.FormatInt(list.ID, 10)+"/members")
+	}, requireListRole(db, RoleAdmin))
+
+	// 権限の変更
+	g.POST("/:id/members/:user_id/role", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		member, err := findMember(c, db, list)
+		if err != nil {
+			return err
+		}
+
+		role := c.FormValue("role")
+		if _, ok := parseMemberRole(role); !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "権限が正しくありません")
+		}
+		err = member.Update(ctx, db, &models.ListMemberSetter{
+			Role:      omit.From(role),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		})
+		if err != nil {
+			return err
+		}
+		if err := member.LoadUser(ctx, db); err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.MemberItem(list, member, csrfToken))
+	}, requireListRole(db, RoleAdmin))
+
+	// メンバーから外す
+	g.POST("/:id/members/:user_id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		list := c.Get("list").(*models.List)
+		member, err := findMember(c, db, list)
+		if err != nil {
+			return err
+		}
+		// 担当していたTodoの担当も外す。外したことを変更履歴に残すため、トリガーに任せずに更新する
+		unassign := models.TodoSetter{UpdatedAt: omit.From(time.Now().UTC())}
+		unassign.AssigneeID.Null()
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			_, err := models.Todos.Update(
+				unassign.UpdateMod(),
+				models.UpdateWhere.Todos.ListID.EQ(list.ID),
+				models.UpdateWhere.Todos.AssigneeID.EQ(member.UserID),
+			).Exec(ctx, exec)
+			if err != nil {
+				return err
+			}
+			return member.Delete(ctx, exec)
+		})
+		if err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusOK)
+	}, requireListRole(db, RoleAdmin))
+}
+
+// listMembers はリストのメンバーをユーザー情報付きで取得する
+func listMembers(ctx context.Context, db bob.DB, list *models.List) (models.ListMemberSlice, error) {
+	members, err := list.ListMembers(sm.OrderBy(models.ListMembers.Columns.CreatedAt)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if err := members.LoadUser(ctx, db); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// findMember はパスパラメータ :user_id のメンバーを取得する
+func findMember(c echo.Context, db bob.DB, list *models.List) (*models.ListMember, error) {
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound)
+	}
+	member, err := models.FindListMember(c.Request().Context(), db, list.ID, userID)
+	if err != nil {
+		return nil, notFoundIfNoRows(err)
+	}
+	return member, nil
+}
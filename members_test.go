@@ -0,0 +1,127 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestListRolePermissions(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.Name("Shared"), factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	listID := strconv.FormatInt(list.ID, 10)
+
+	// 期待するステータスコード: 閲覧者, 編集者, 管理者, 所有者, 非メンバー
+	type want [5]int
+	cases := []struct {
+		name   string
+		method string
+		path   func(todoID string) string
+		form   url.Values
+		want   want
+	}{
+		{"view todos", http.MethodGet, func(string) string { return "/lists/" + listID + "/todos" }, nil, want{200, 200, 200, 200, 404}},
+		{"create todo", http.MethodPost, func(string) string { return "/lists/" + listID + "/todos" }, url.Values{"title": {"new"}}, want{403, 200, 200, 200, 404}},
+		{"toggle todo", http.MethodPost, func(id string) string { return "/todos/" + id + "/toggle" }, url.Values{}, want{403, 200, 200, 200, 404}},
+		{"move todo to inbox", http.MethodPost, func(id string) string { return "/todos/" + id + "/move" }, url.Values{"list_id": {""}}, want{403, 200, 200, 200, 404}},
+		{"delete todo", http.MethodPost, func(id string) string { return "/todos/" + id + "/delete" }, url.Values{}, want{403, 200, 200, 200, 404}},
+		{"view members", http.MethodGet, func(string) string { return "/lists/" + listID + "/members" }, nil, want{403, 403, 200, 200, 404}},
+		{"rename list", http.MethodPost, func(string) string { return "/lists/" + listID + "/rename" }, url.Values{"name": {"Shared"}}, want{403, 403, 200, 200, 404}},
+		{"archive list", http.MethodPost, func(string) string { return "/lists/" + listID + "/archive" }, url.Values{}, want{403, 403, 200, 200, 404}},
+	}
+
+	clients := [5]*testClient{}
+	for i, role := range []string{memberRoleViewer, memberRoleEditor, memberRoleAdmin} {
+		user := createTestUser(t, db, role+"@example.com")
+		f.NewListMemberWithContext(ctx,
+			factory.ListMemberMods.WithExistingList(list),
+			factory.ListMemberMods.WithExistingUser(user),
+			factory.ListMemberMods.Role(role),
+		).CreateOrFail(ctx, t, db)
+		clients[i] = login(t, e, user)
+	}
+	clients[3] = login(t, e, owner)
+	clients[4] = login(t, e, createTestUser(t, db, "stranger@example.com"))
+
+	roleNames := [5]string{"viewer", "editor", "admin", "owner", "stranger"}
+	for _, tt := range cases {
+		for i, tc := range clients {
+			t.Run(tt.name+"/"+roleNames[i], func(t *testing.T) {
+				todo := f.NewTodoWithContext(ctx,
+					factory.TodoMods.WithExistingUser(owner),
+					factory.TodoMods.ListID(null.From(list.ID)),
+				).CreateOrFail(ctx, t, db)
+
+				var form url.Values
+				if tt.form != nil {
+					form = url.Values{}
+					for k, v := range tt.form {
+						form[k] = v
+					}
+				}
+				rec := tc.do(tt.method, tt.path(strconv.FormatInt(todo.ID, 10)), form)
+				if rec.Code != tt.want[i] {
+					t.Errorf("status = %d, want %d", rec.Code, tt.want[i])
+				}
+			})
+		}
+	}
+
+	// 所有者以外はリストを削除できない
+	for i, tc := range clients[:3] {
+		if rec := tc.do(http.MethodPost, "/lists/"+listID+"/delete", url.Values{}); rec.Code != http.StatusForbidden {
+			t.Errorf("delete list as %s: status = %d, want %d", roleNames[i], rec.Code, http.StatusForbidden)
+		}
+	}
+	if rec := clients[3].do(http.MethodPost, "/lists/"+listID+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Errorf("delete list as owner: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestInviteMemberByEmail(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	invitee := createTestUser(t, db, "invitee@example.com")
+	list := factory.New().NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	membersPath := "/lists/" + strconv.FormatInt(list.ID, 10) + "/members"
+	tc := login(t, e, owner)
+
+	if rec := tc.do(http.MethodPost, membersPath, url.Values{"email": {"nobody@example.com"}, "role": {memberRoleEditor}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("invite unregistered email: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec := tc.do(http.MethodPost, membersPath, url.Values{"email": {invitee.Email}, "role": {"superuser"}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("invite with unknown role: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec := tc.do(http.MethodPost, membersPath, url.Values{"email": {invitee.Email}, "role": {memberRoleViewer}}); rec.Code != http.StatusFound {
+		t.Fatalf("invite: status = %d, want %d", rec.Code, http.StatusFound)
+	}
+
+	member, err := models.FindListMember(ctx, db, list.ID, invitee.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.Role != memberRoleViewer {
+		t.Errorf("role = %q, want %q", member.Role, memberRoleViewer)
+	}
+
+	// 招待されたユーザーはリストを閲覧できるが編集できない
+	invited := login(t, e, invitee)
+	if rec := invited.do(http.MethodGet, "/lists/"+strconv.FormatInt(list.ID, 10)+"/todos", nil); rec.Code != http.StatusOK {
+		t.Errorf("view shared list: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := invited.do(http.MethodPost, "/lists/"+strconv.FormatInt(list.ID, 10)+"/todos", url.Values{"title": {"x"}}); rec.Code != http.StatusForbidden {
+		t.Errorf("create todo as viewer: status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
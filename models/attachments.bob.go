@@ -0,0 +1,937 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Attachment is an object representing the database table.
+type Attachment struct {
+	ID           int64     `db:"id,pk" `
+	TodoID       int64     `db:"todo_id" `
+	UserID       int64     `db:"user_id" `
+	Filename     string    `db:"filename" `
+	ContentType  string    `db:"content_type" `
+	Size         int64     `db:"size" `
+	Sha256       string    `db:"sha256" `
+	HasThumbnail bool      `db:"has_thumbnail" `
+	CreatedAt    time.Time `db:"created_at" `
+
+	R attachmentR `db:"-" `
+}
+
+// AttachmentSlice is an alias for a slice of pointers to Attachment.
+// This should almost always be used instead of []*Attachment.
+type AttachmentSlice []*Attachment
+
+// Attachments contains methods to work with the attachments table
+var Attachments = sqlite.NewTablex[*Attachment, AttachmentSlice, *AttachmentSetter]("", "attachments", buildAttachmentColumns("attachments"))
+
+// AttachmentsQuery is a query on the attachments table
+type AttachmentsQuery = *sqlite.ViewQuery[*Attachment, AttachmentSlice]
+
+// attachmentR is where relationships are stored.
+type attachmentR struct {
+	User *User // fk_attachments_0
+	Todo *Todo // fk_attachments_1
+}
+
+func buildAttachmentColumns(alias string) attachmentColumns {
+	return attachmentColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "todo_id", "user_id", "filename", "content_type", "size", "sha256", "has_thumbnail", "created_at",
+		).WithParent("attachments"),
+		tableAlias:   alias,
+		ID:           sqlite.Quote(alias, "id"),
+		TodoID:       sqlite.Quote(alias, "todo_id"),
+		UserID:       sqlite.Quote(alias, "user_id"),
+		Filename:     sqlite.Quote(alias, "filename"),
+		ContentType:  sqlite.Quote(alias, "content_type"),
+		Size:         sqlite.Quote(alias, "size"),
+		Sha256:       sqlite.Quote(alias, "sha256"),
+		HasThumbnail: sqlite.Quote(alias, "has_thumbnail"),
+		CreatedAt:    sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type attachmentColumns struct {
+	expr.ColumnsExpr
+	tableAlias   string
+	ID           sqlite.Expression
+	TodoID       sqlite.Expression
+	UserID       sqlite.Expression
+	Filename     sqlite.Expression
+	ContentType  sqlite.Expression
+	Size         sqlite.Expression
+	Sha256       sqlite.Expression
+	HasThumbnail sqlite.Expression
+	CreatedAt    sqlite.Expression
+}
+
+func (c attachmentColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (attachmentColumns) AliasedAs(alias string) attachmentColumns {
+	return buildAttachmentColumns(alias)
+}
+
+// AttachmentSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AttachmentSetter struct {
+	ID           omit.Val[int64]     `db:"id,pk" `
+	TodoID       omit.Val[int64]     `db:"todo_id" `
+	UserID       omit.Val[int64]     `db:"user_id" `
+	Filename     omit.Val[string]    `db:"filename" `
+	ContentType  omit.Val[string]    `db:"content_type" `
+	Size         omit.Val[int64]     `db:"size" `
+	Sha256       omit.Val[string]    `db:"sha256" `
+	HasThumbnail omit.Val[bool]      `db:"has_thumbnail" `
+	CreatedAt    omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s AttachmentSetter) SetColumns() []string {
+	vals := make([]string, 0, 9)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.TodoID.IsValue() {
+		vals = append(vals, "todo_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Filename.IsValue() {
+		vals = append(vals, "filename")
+	}
+	if s.ContentType.IsValue() {
+		vals = append(vals, "content_type")
+	}
+	if s.Size.IsValue() {
+		vals = append(vals, "size")
+	}
+	if s.Sha256.IsValue() {
+		vals = append(vals, "sha256")
+	}
+	if s.HasThumbnail.IsValue() {
+		vals = append(vals, "has_thumbnail")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s AttachmentSetter) Overwrite(t *Attachment) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.TodoID.IsValue() {
+		t.TodoID = s.TodoID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Filename.IsValue() {
+		t.Filename = s.Filename.MustGet()
+	}
+	if s.ContentType.IsValue() {
+		t.ContentType = s.ContentType.MustGet()
+	}
+	if s.Size.IsValue() {
+		t.Size = s.Size.MustGet()
+	}
+	if s.Sha256.IsValue() {
+		t.Sha256 = s.Sha256.MustGet()
+	}
+	if s.HasThumbnail.IsValue() {
+		t.HasThumbnail = s.HasThumbnail.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *AttachmentSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Attachments.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 9)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.TodoID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if s.Filename.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Filename.MustGet()))
+		}
+
+		if s.ContentType.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ContentType.MustGet()))
+		}
+
+		if s.Size.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Size.MustGet()))
+		}
+
+		if s.Sha256.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Sha256.MustGet()))
+		}
+
+		if s.HasThumbnail.IsValue() {
+			vals = append(vals, sqlite.Arg(s.HasThumbnail.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AttachmentSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AttachmentSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 9)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.TodoID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_id")...),
+			sqlite.Arg(s.TodoID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.Filename.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "filename")...),
+			sqlite.Arg(s.Filename),
+		}})
+	}
+
+	if s.ContentType.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "content_type")...),
+			sqlite.Arg(s.ContentType),
+		}})
+	}
+
+	if s.Size.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "size")...),
+			sqlite.Arg(s.Size),
+		}})
+	}
+
+	if s.Sha256.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "sha256")...),
+			sqlite.Arg(s.Sha256),
+		}})
+	}
+
+	if s.HasThumbnail.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "has_thumbnail")...),
+			sqlite.Arg(s.HasThumbnail),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAttachment retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAttachment(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Attachment, error) {
+	if len(cols) == 0 {
+		return Attachments.Query(
+			sm.Where(Attachments.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Attachments.Query(
+		sm.Where(Attachments.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Attachments.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AttachmentExists checks the presence of a single record by primary key
+func AttachmentExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Attachments.Query(
+		sm.Where(Attachments.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Attachment is retrieved from the database
+func (o *Attachment) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Attachments.AfterSelectHooks.RunHooks(ctx, exec, AttachmentSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Attachments.AfterInsertHooks.RunHooks(ctx, exec, AttachmentSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Attachments.AfterUpdateHooks.RunHooks(ctx, exec, AttachmentSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Attachments.AfterDeleteHooks.RunHooks(ctx, exec, AttachmentSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Attachment
+func (o *Attachment) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *Attachment) pkEQ() dialect.Expression {
+	return sqlite.Quote("attachments", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Attachment
+func (o *Attachment) Update(ctx context.Context, exec bob.Executor, s *AttachmentSetter) error {
+	v, err := Attachments.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Attachment record with an executor
+func (o *Attachment) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Attachments.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Attachment using the executor
+func (o *Attachment) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Attachments.Query(
+		sm.Where(Attachments.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AttachmentSlice is retrieved from the database
+func (o AttachmentSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Attachments.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Attachments.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Attachments.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Attachments.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AttachmentSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("attachments", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AttachmentSlice) copyMatchingRows(from ...*Attachment) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AttachmentSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Attachments.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Attachment:
+				o.copyMatchingRows(retrieved)
+			case []*Attachment:
+				o.copyMatchingRows(retrieved...)
+			case AttachmentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Attachment or a slice of Attachment
+				// then run the AfterUpdateHooks on the slice
+				_, err = Attachments.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AttachmentSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Attachments.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Attachment:
+				o.copyMatchingRows(retrieved)
+			case []*Attachment:
+				o.copyMatchingRows(retrieved...)
+			case AttachmentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Attachment or a slice of Attachment
+				// then run the AfterDeleteHooks on the slice
+				_, err = Attachments.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AttachmentSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AttachmentSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Attachments.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AttachmentSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Attachments.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AttachmentSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Attachments.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *Attachment) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os AttachmentSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todo starts a query for related objects on todos
+func (o *Attachment) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.TodoID))),
+	)...)
+}
+
+func (os AttachmentSlice) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TodoID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachAttachmentUser0(ctx context.Context, exec bob.Executor, count int, attachment0 *Attachment, user1 *User) (*Attachment, error) {
+	setter := &AttachmentSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := attachment0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAttachmentUser0: %w", err)
+	}
+
+	return attachment0, nil
+}
+
+func (attachment0 *Attachment) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAttachmentUser0(ctx, exec, 1, attachment0, user1)
+	if err != nil {
+		return err
+	}
+
+	attachment0.R.User = user1
+
+	user1.R.Attachments = append(user1.R.Attachments, attachment0)
+
+	return nil
+}
+
+func (attachment0 *Attachment) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachAttachmentUser0(ctx, exec, 1, attachment0, user1)
+	if err != nil {
+		return err
+	}
+
+	attachment0.R.User = user1
+
+	user1.R.Attachments = append(user1.R.Attachments, attachment0)
+
+	return nil
+}
+
+func attachAttachmentTodo0(ctx context.Context, exec bob.Executor, count int, attachment0 *Attachment, todo1 *Todo) (*Attachment, error) {
+	setter := &AttachmentSetter{
+		TodoID: omit.From(todo1.ID),
+	}
+
+	err := attachment0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAttachmentTodo0: %w", err)
+	}
+
+	return attachment0, nil
+}
+
+func (attachment0 *Attachment) InsertTodo(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAttachmentTodo0(ctx, exec, 1, attachment0, todo1)
+	if err != nil {
+		return err
+	}
+
+	attachment0.R.Todo = todo1
+
+	todo1.R.Attachments = append(todo1.R.Attachments, attachment0)
+
+	return nil
+}
+
+func (attachment0 *Attachment) AttachTodo(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachAttachmentTodo0(ctx, exec, 1, attachment0, todo1)
+	if err != nil {
+		return err
+	}
+
+	attachment0.R.Todo = todo1
+
+	todo1.R.Attachments = append(todo1.R.Attachments, attachment0)
+
+	return nil
+}
+
+type attachmentWhere[Q sqlite.Filterable] struct {
+	ID           sqlite.WhereMod[Q, int64]
+	TodoID       sqlite.WhereMod[Q, int64]
+	UserID       sqlite.WhereMod[Q, int64]
+	Filename     sqlite.WhereMod[Q, string]
+	ContentType  sqlite.WhereMod[Q, string]
+	Size         sqlite.WhereMod[Q, int64]
+	Sha256       sqlite.WhereMod[Q, string]
+	HasThumbnail sqlite.WhereMod[Q, bool]
+	CreatedAt    sqlite.WhereMod[Q, time.Time]
+}
+
+func (attachmentWhere[Q]) AliasedAs(alias string) attachmentWhere[Q] {
+	return buildAttachmentWhere[Q](buildAttachmentColumns(alias))
+}
+
+func buildAttachmentWhere[Q sqlite.Filterable](cols attachmentColumns) attachmentWhere[Q] {
+	return attachmentWhere[Q]{
+		ID:           sqlite.Where[Q, int64](cols.ID),
+		TodoID:       sqlite.Where[Q, int64](cols.TodoID),
+		UserID:       sqlite.Where[Q, int64](cols.UserID),
+		Filename:     sqlite.Where[Q, string](cols.Filename),
+		ContentType:  sqlite.Where[Q, string](cols.ContentType),
+		Size:         sqlite.Where[Q, int64](cols.Size),
+		Sha256:       sqlite.Where[Q, string](cols.Sha256),
+		HasThumbnail: sqlite.Where[Q, bool](cols.HasThumbnail),
+		CreatedAt:    sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Attachment) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("attachment cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Attachments = AttachmentSlice{o}
+		}
+		return nil
+	case "Todo":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("attachment cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todo = rel
+
+		if rel != nil {
+			rel.R.Attachments = AttachmentSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("attachment has no relationship %q", name)
+	}
+}
+
+type attachmentPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+	Todo func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildAttachmentPreloader() attachmentPreloader {
+	return attachmentPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Attachments,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Todo: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Todo",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Attachments,
+						To:          Todos,
+						FromColumns: []string{"todo_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+	}
+}
+
+type attachmentThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todo func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAttachmentThenLoader[Q orm.Loadable]() attachmentThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoLoadInterface interface {
+		LoadTodo(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return attachmentThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		Todo: thenLoadBuilder[Q](
+			"Todo",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodo(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the attachment's User into the .R struct
+func (o *Attachment) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Attachments = AttachmentSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the attachment's User into the .R struct
+func (os AttachmentSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Attachments = append(rel.R.Attachments, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo loads the attachment's Todo into the .R struct
+func (o *Attachment) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todo = nil
+
+	related, err := o.Todo(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Attachments = AttachmentSlice{o}
+
+	o.R.Todo = related
+	return nil
+}
+
+// LoadTodo loads the attachment's Todo into the .R struct
+func (os AttachmentSlice) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todo(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.TodoID == rel.ID) {
+				continue
+			}
+
+			rel.R.Attachments = append(rel.R.Attachments, o)
+
+			o.R.Todo = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type attachmentJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+	Todo modAs[Q, todoColumns]
+}
+
+func (j attachmentJoins[Q]) aliasedAs(alias string) attachmentJoins[Q] {
+	return buildAttachmentJoins[Q](buildAttachmentColumns(alias), j.typ)
+}
+
+func buildAttachmentJoins[Q dialect.Joinable](cols attachmentColumns, typ string) attachmentJoins[Q] {
+	return attachmentJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todo: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
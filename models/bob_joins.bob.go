@@ -30,7 +30,23 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 	}
 }
 
-type joins[Q dialect.Joinable] struct{}
+type joins[Q dialect.Joinable] struct {
+	Attachments            joinSet[attachmentJoins[Q]]
+	ChecklistTemplateItems joinSet[checklistTemplateItemJoins[Q]]
+	ChecklistTemplates     joinSet[checklistTemplateJoins[Q]]
+	Comments               joinSet[commentJoins[Q]]
+	ListMembers            joinSet[listMemberJoins[Q]]
+	ListStatuses           joinSet[listStatusJoins[Q]]
+	Lists                  joinSet[listJoins[Q]]
+	Notifications          joinSet[notificationJoins[Q]]
+	Tags                   joinSet[tagJoins[Q]]
+	TimeEntries            joinSet[timeEntryJoins[Q]]
+	TodoDependencies       joinSet[todoDependencyJoins[Q]]
+	TodoEvents             joinSet[todoEventJoins[Q]]
+	TodoTags               joinSet[todoTagJoins[Q]]
+	Todos                  joinSet[todoJoins[Q]]
+	Users                  joinSet[userJoins[Q]]
+}
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
 	return joinSet[Q]{
@@ -41,7 +57,23 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 }
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
-	return joins[Q]{}
+	return joins[Q]{
+		Attachments:            buildJoinSet[attachmentJoins[Q]](Attachments.Columns, buildAttachmentJoins),
+		ChecklistTemplateItems: buildJoinSet[checklistTemplateItemJoins[Q]](ChecklistTemplateItems.Columns, buildChecklistTemplateItemJoins),
+		ChecklistTemplates:     buildJoinSet[checklistTemplateJoins[Q]](ChecklistTemplates.Columns, buildChecklistTemplateJoins),
+		Comments:               buildJoinSet[commentJoins[Q]](Comments.Columns, buildCommentJoins),
+		ListMembers:            buildJoinSet[listMemberJoins[Q]](ListMembers.Columns, buildListMemberJoins),
+		ListStatuses:           buildJoinSet[listStatusJoins[Q]](ListStatuses.Columns, buildListStatusJoins),
+		Lists:                  buildJoinSet[listJoins[Q]](Lists.Columns, buildListJoins),
+		Notifications:          buildJoinSet[notificationJoins[Q]](Notifications.Columns, buildNotificationJoins),
+		Tags:                   buildJoinSet[tagJoins[Q]](Tags.Columns, buildTagJoins),
+		TimeEntries:            buildJoinSet[timeEntryJoins[Q]](TimeEntries.Columns, buildTimeEntryJoins),
+		TodoDependencies:       buildJoinSet[todoDependencyJoins[Q]](TodoDependencies.Columns, buildTodoDependencyJoins),
+		TodoEvents:             buildJoinSet[todoEventJoins[Q]](TodoEvents.Columns, buildTodoEventJoins),
+		TodoTags:               buildJoinSet[todoTagJoins[Q]](TodoTags.Columns, buildTodoTagJoins),
+		Todos:                  buildJoinSet[todoJoins[Q]](Todos.Columns, buildTodoJoins),
+		Users:                  buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
+	}
 }
 
 type modAs[Q any, C interface{ AliasedAs(string) C }] struct {
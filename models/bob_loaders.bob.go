@@ -16,10 +16,42 @@ import (
 
 var Preload = getPreloaders()
 
-type preloaders struct{}
+type preloaders struct {
+	Attachment            attachmentPreloader
+	ChecklistTemplateItem checklistTemplateItemPreloader
+	ChecklistTemplate     checklistTemplatePreloader
+	Comment               commentPreloader
+	ListMember            listMemberPreloader
+	ListStatus            listStatusPreloader
+	List                  listPreloader
+	Notification          notificationPreloader
+	Tag                   tagPreloader
+	TimeEntry             timeEntryPreloader
+	TodoDependency        todoDependencyPreloader
+	TodoEvent             todoEventPreloader
+	TodoTag               todoTagPreloader
+	Todo                  todoPreloader
+	User                  userPreloader
+}
 
 func getPreloaders() preloaders {
-	return preloaders{}
+	return preloaders{
+		Attachment:            buildAttachmentPreloader(),
+		ChecklistTemplateItem: buildChecklistTemplateItemPreloader(),
+		ChecklistTemplate:     buildChecklistTemplatePreloader(),
+		Comment:               buildCommentPreloader(),
+		ListMember:            buildListMemberPreloader(),
+		ListStatus:            buildListStatusPreloader(),
+		List:                  buildListPreloader(),
+		Notification:          buildNotificationPreloader(),
+		Tag:                   buildTagPreloader(),
+		TimeEntry:             buildTimeEntryPreloader(),
+		TodoDependency:        buildTodoDependencyPreloader(),
+		TodoEvent:             buildTodoEventPreloader(),
+		TodoTag:               buildTodoTagPreloader(),
+		Todo:                  buildTodoPreloader(),
+		User:                  buildUserPreloader(),
+	}
 }
 
 var (
@@ -28,10 +60,42 @@ var (
 	UpdateThenLoad = getThenLoaders[*dialect.UpdateQuery]()
 )
 
-type thenLoaders[Q orm.Loadable] struct{}
+type thenLoaders[Q orm.Loadable] struct {
+	Attachment            attachmentThenLoader[Q]
+	ChecklistTemplateItem checklistTemplateItemThenLoader[Q]
+	ChecklistTemplate     checklistTemplateThenLoader[Q]
+	Comment               commentThenLoader[Q]
+	ListMember            listMemberThenLoader[Q]
+	ListStatus            listStatusThenLoader[Q]
+	List                  listThenLoader[Q]
+	Notification          notificationThenLoader[Q]
+	Tag                   tagThenLoader[Q]
+	TimeEntry             timeEntryThenLoader[Q]
+	TodoDependency        todoDependencyThenLoader[Q]
+	TodoEvent             todoEventThenLoader[Q]
+	TodoTag               todoTagThenLoader[Q]
+	Todo                  todoThenLoader[Q]
+	User                  userThenLoader[Q]
+}
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
-	return thenLoaders[Q]{}
+	return thenLoaders[Q]{
+		Attachment:            buildAttachmentThenLoader[Q](),
+		ChecklistTemplateItem: buildChecklistTemplateItemThenLoader[Q](),
+		ChecklistTemplate:     buildChecklistTemplateThenLoader[Q](),
+		Comment:               buildCommentThenLoader[Q](),
+		ListMember:            buildListMemberThenLoader[Q](),
+		ListStatus:            buildListStatusThenLoader[Q](),
+		List:                  buildListThenLoader[Q](),
+		Notification:          buildNotificationThenLoader[Q](),
+		Tag:                   buildTagThenLoader[Q](),
+		TimeEntry:             buildTimeEntryThenLoader[Q](),
+		TodoDependency:        buildTodoDependencyThenLoader[Q](),
+		TodoEvent:             buildTodoEventThenLoader[Q](),
+		TodoTag:               buildTodoTagThenLoader[Q](),
+		Todo:                  buildTodoThenLoader[Q](),
+		User:                  buildUserThenLoader[Q](),
+	}
 }
 
 func thenLoadBuilder[Q orm.Loadable, T any](name string, f func(context.Context, bob.Executor, T, ...bob.Mod[*dialect.SelectQuery]) error) func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q] {
@@ -8,12 +8,51 @@ import "github.com/stephenafamo/bob"
 // Set the testDB to enable tests that use the database
 var testDB bob.Transactor[bob.Tx]
 
+// Make sure the type Attachment runs hooks after queries
+var _ bob.HookableType = &Attachment{}
+
+// Make sure the type ChecklistTemplateItem runs hooks after queries
+var _ bob.HookableType = &ChecklistTemplateItem{}
+
+// Make sure the type ChecklistTemplate runs hooks after queries
+var _ bob.HookableType = &ChecklistTemplate{}
+
+// Make sure the type Comment runs hooks after queries
+var _ bob.HookableType = &Comment{}
+
 // Make sure the type GooseDBVersion runs hooks after queries
 var _ bob.HookableType = &GooseDBVersion{}
 
+// Make sure the type ListMember runs hooks after queries
+var _ bob.HookableType = &ListMember{}
+
+// Make sure the type ListStatus runs hooks after queries
+var _ bob.HookableType = &ListStatus{}
+
+// Make sure the type List runs hooks after queries
+var _ bob.HookableType = &List{}
+
+// Make sure the type Notification runs hooks after queries
+var _ bob.HookableType = &Notification{}
+
 // Make sure the type Session runs hooks after queries
 var _ bob.HookableType = &Session{}
 
+// Make sure the type Tag runs hooks after queries
+var _ bob.HookableType = &Tag{}
+
+// Make sure the type TimeEntry runs hooks after queries
+var _ bob.HookableType = &TimeEntry{}
+
+// Make sure the type TodoDependency runs hooks after queries
+var _ bob.HookableType = &TodoDependency{}
+
+// Make sure the type TodoEvent runs hooks after queries
+var _ bob.HookableType = &TodoEvent{}
+
+// Make sure the type TodoTag runs hooks after queries
+var _ bob.HookableType = &TodoTag{}
+
 // Make sure the type Todo runs hooks after queries
 var _ bob.HookableType = &Todo{}
 
@@ -17,20 +17,59 @@ var (
 )
 
 func Where[Q sqlite.Filterable]() struct {
-	GooseDBVersions gooseDBVersionWhere[Q]
-	Sessions        sessionWhere[Q]
-	Todos           todoWhere[Q]
-	Users           userWhere[Q]
+	Attachments            attachmentWhere[Q]
+	ChecklistTemplateItems checklistTemplateItemWhere[Q]
+	ChecklistTemplates     checklistTemplateWhere[Q]
+	Comments               commentWhere[Q]
+	GooseDBVersions        gooseDBVersionWhere[Q]
+	ListMembers            listMemberWhere[Q]
+	ListStatuses           listStatusWhere[Q]
+	Lists                  listWhere[Q]
+	Notifications          notificationWhere[Q]
+	Sessions               sessionWhere[Q]
+	Tags                   tagWhere[Q]
+	TimeEntries            timeEntryWhere[Q]
+	TodoDependencies       todoDependencyWhere[Q]
+	TodoEvents             todoEventWhere[Q]
+	TodoTags               todoTagWhere[Q]
+	Todos                  todoWhere[Q]
+	Users                  userWhere[Q]
 } {
 	return struct {
-		GooseDBVersions gooseDBVersionWhere[Q]
-		Sessions        sessionWhere[Q]
-		Todos           todoWhere[Q]
-		Users           userWhere[Q]
+		Attachments            attachmentWhere[Q]
+		ChecklistTemplateItems checklistTemplateItemWhere[Q]
+		ChecklistTemplates     checklistTemplateWhere[Q]
+		Comments               commentWhere[Q]
+		GooseDBVersions        gooseDBVersionWhere[Q]
+		ListMembers            listMemberWhere[Q]
+		ListStatuses           listStatusWhere[Q]
+		Lists                  listWhere[Q]
+		Notifications          notificationWhere[Q]
+		Sessions               sessionWhere[Q]
+		Tags                   tagWhere[Q]
+		TimeEntries            timeEntryWhere[Q]
+		TodoDependencies       todoDependencyWhere[Q]
+		TodoEvents             todoEventWhere[Q]
+		TodoTags               todoTagWhere[Q]
+		Todos                  todoWhere[Q]
+		Users                  userWhere[Q]
 	}{
-		GooseDBVersions: buildGooseDBVersionWhere[Q](GooseDBVersions.Columns),
-		Sessions:        buildSessionWhere[Q](Sessions.Columns),
-		Todos:           buildTodoWhere[Q](Todos.Columns),
-		Users:           buildUserWhere[Q](Users.Columns),
+		Attachments:            buildAttachmentWhere[Q](Attachments.Columns),
+		ChecklistTemplateItems: buildChecklistTemplateItemWhere[Q](ChecklistTemplateItems.Columns),
+		ChecklistTemplates:     buildChecklistTemplateWhere[Q](ChecklistTemplates.Columns),
+		Comments:               buildCommentWhere[Q](Comments.Columns),
+		GooseDBVersions:        buildGooseDBVersionWhere[Q](GooseDBVersions.Columns),
+		ListMembers:            buildListMemberWhere[Q](ListMembers.Columns),
+		ListStatuses:           buildListStatusWhere[Q](ListStatuses.Columns),
+		Lists:                  buildListWhere[Q](Lists.Columns),
+		Notifications:          buildNotificationWhere[Q](Notifications.Columns),
+		Sessions:               buildSessionWhere[Q](Sessions.Columns),
+		Tags:                   buildTagWhere[Q](Tags.Columns),
+		TimeEntries:            buildTimeEntryWhere[Q](TimeEntries.Columns),
+		TodoDependencies:       buildTodoDependencyWhere[Q](TodoDependencies.Columns),
+		TodoEvents:             buildTodoEventWhere[Q](TodoEvents.Columns),
+		TodoTags:               buildTodoTagWhere[Q](TodoTags.Columns),
+		Todos:                  buildTodoWhere[Q](Todos.Columns),
+		Users:                  buildUserWhere[Q](Users.Columns),
 	}
 }
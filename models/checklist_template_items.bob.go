@@ -0,0 +1,675 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// ChecklistTemplateItem is an object representing the database table.
+type ChecklistTemplateItem struct {
+	ID         int64           `db:"id,pk" `
+	TemplateID int64           `db:"template_id" `
+	Title      string          `db:"title" `
+	DueDays    null.Val[int64] `db:"due_days" `
+	Position   int64           `db:"position" `
+
+	R checklistTemplateItemR `db:"-" `
+}
+
+// ChecklistTemplateItemSlice is an alias for a slice of pointers to ChecklistTemplateItem.
+// This should almost always be used instead of []*ChecklistTemplateItem.
+type ChecklistTemplateItemSlice []*ChecklistTemplateItem
+
+// ChecklistTemplateItems contains methods to work with the checklist_template_items table
+var ChecklistTemplateItems = sqlite.NewTablex[*ChecklistTemplateItem, ChecklistTemplateItemSlice, *ChecklistTemplateItemSetter]("", "checklist_template_items", buildChecklistTemplateItemColumns("checklist_template_items"))
+
+// ChecklistTemplateItemsQuery is a query on the checklist_template_items table
+type ChecklistTemplateItemsQuery = *sqlite.ViewQuery[*ChecklistTemplateItem, ChecklistTemplateItemSlice]
+
+// checklistTemplateItemR is where relationships are stored.
+type checklistTemplateItemR struct {
+	Template *ChecklistTemplate // fk_checklist_template_items_0
+}
+
+func buildChecklistTemplateItemColumns(alias string) checklistTemplateItemColumns {
+	return checklistTemplateItemColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "template_id", "title", "due_days", "position",
+		).WithParent("checklist_template_items"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		TemplateID: sqlite.Quote(alias, "template_id"),
+		Title:      sqlite.Quote(alias, "title"),
+		DueDays:    sqlite.Quote(alias, "due_days"),
+		Position:   sqlite.Quote(alias, "position"),
+	}
+}
+
+type checklistTemplateItemColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	TemplateID sqlite.Expression
+	Title      sqlite.Expression
+	DueDays    sqlite.Expression
+	Position   sqlite.Expression
+}
+
+func (c checklistTemplateItemColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (checklistTemplateItemColumns) AliasedAs(alias string) checklistTemplateItemColumns {
+	return buildChecklistTemplateItemColumns(alias)
+}
+
+// ChecklistTemplateItemSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ChecklistTemplateItemSetter struct {
+	ID         omit.Val[int64]     `db:"id,pk" `
+	TemplateID omit.Val[int64]     `db:"template_id" `
+	Title      omit.Val[string]    `db:"title" `
+	DueDays    omitnull.Val[int64] `db:"due_days" `
+	Position   omit.Val[int64]     `db:"position" `
+}
+
+func (s ChecklistTemplateItemSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.TemplateID.IsValue() {
+		vals = append(vals, "template_id")
+	}
+	if s.Title.IsValue() {
+		vals = append(vals, "title")
+	}
+	if !s.DueDays.IsUnset() {
+		vals = append(vals, "due_days")
+	}
+	if s.Position.IsValue() {
+		vals = append(vals, "position")
+	}
+	return vals
+}
+
+func (s ChecklistTemplateItemSetter) Overwrite(t *ChecklistTemplateItem) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.TemplateID.IsValue() {
+		t.TemplateID = s.TemplateID.MustGet()
+	}
+	if s.Title.IsValue() {
+		t.Title = s.Title.MustGet()
+	}
+	if !s.DueDays.IsUnset() {
+		t.DueDays = s.DueDays.MustGetNull()
+	}
+	if s.Position.IsValue() {
+		t.Position = s.Position.MustGet()
+	}
+}
+
+func (s *ChecklistTemplateItemSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return ChecklistTemplateItems.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 5)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.TemplateID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TemplateID.MustGet()))
+		}
+
+		if s.Title.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Title.MustGet()))
+		}
+
+		if !s.DueDays.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.DueDays.MustGetNull()))
+		}
+
+		if s.Position.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Position.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ChecklistTemplateItemSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ChecklistTemplateItemSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.TemplateID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "template_id")...),
+			sqlite.Arg(s.TemplateID),
+		}})
+	}
+
+	if s.Title.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "title")...),
+			sqlite.Arg(s.Title),
+		}})
+	}
+
+	if !s.DueDays.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "due_days")...),
+			sqlite.Arg(s.DueDays),
+		}})
+	}
+
+	if s.Position.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "position")...),
+			sqlite.Arg(s.Position),
+		}})
+	}
+
+	return exprs
+}
+
+// FindChecklistTemplateItem retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindChecklistTemplateItem(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*ChecklistTemplateItem, error) {
+	if len(cols) == 0 {
+		return ChecklistTemplateItems.Query(
+			sm.Where(ChecklistTemplateItems.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return ChecklistTemplateItems.Query(
+		sm.Where(ChecklistTemplateItems.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(ChecklistTemplateItems.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ChecklistTemplateItemExists checks the presence of a single record by primary key
+func ChecklistTemplateItemExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return ChecklistTemplateItems.Query(
+		sm.Where(ChecklistTemplateItems.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after ChecklistTemplateItem is retrieved from the database
+func (o *ChecklistTemplateItem) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ChecklistTemplateItems.AfterSelectHooks.RunHooks(ctx, exec, ChecklistTemplateItemSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = ChecklistTemplateItems.AfterInsertHooks.RunHooks(ctx, exec, ChecklistTemplateItemSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = ChecklistTemplateItems.AfterUpdateHooks.RunHooks(ctx, exec, ChecklistTemplateItemSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = ChecklistTemplateItems.AfterDeleteHooks.RunHooks(ctx, exec, ChecklistTemplateItemSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the ChecklistTemplateItem
+func (o *ChecklistTemplateItem) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *ChecklistTemplateItem) pkEQ() dialect.Expression {
+	return sqlite.Quote("checklist_template_items", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the ChecklistTemplateItem
+func (o *ChecklistTemplateItem) Update(ctx context.Context, exec bob.Executor, s *ChecklistTemplateItemSetter) error {
+	v, err := ChecklistTemplateItems.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single ChecklistTemplateItem record with an executor
+func (o *ChecklistTemplateItem) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := ChecklistTemplateItems.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the ChecklistTemplateItem using the executor
+func (o *ChecklistTemplateItem) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := ChecklistTemplateItems.Query(
+		sm.Where(ChecklistTemplateItems.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ChecklistTemplateItemSlice is retrieved from the database
+func (o ChecklistTemplateItemSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ChecklistTemplateItems.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = ChecklistTemplateItems.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = ChecklistTemplateItems.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = ChecklistTemplateItems.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ChecklistTemplateItemSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("checklist_template_items", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ChecklistTemplateItemSlice) copyMatchingRows(from ...*ChecklistTemplateItem) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ChecklistTemplateItemSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ChecklistTemplateItems.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ChecklistTemplateItem:
+				o.copyMatchingRows(retrieved)
+			case []*ChecklistTemplateItem:
+				o.copyMatchingRows(retrieved...)
+			case ChecklistTemplateItemSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ChecklistTemplateItem or a slice of ChecklistTemplateItem
+				// then run the AfterUpdateHooks on the slice
+				_, err = ChecklistTemplateItems.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ChecklistTemplateItemSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ChecklistTemplateItems.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ChecklistTemplateItem:
+				o.copyMatchingRows(retrieved)
+			case []*ChecklistTemplateItem:
+				o.copyMatchingRows(retrieved...)
+			case ChecklistTemplateItemSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ChecklistTemplateItem or a slice of ChecklistTemplateItem
+				// then run the AfterDeleteHooks on the slice
+				_, err = ChecklistTemplateItems.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ChecklistTemplateItemSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ChecklistTemplateItemSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ChecklistTemplateItems.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ChecklistTemplateItemSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ChecklistTemplateItems.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ChecklistTemplateItemSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := ChecklistTemplateItems.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Template starts a query for related objects on checklist_templates
+func (o *ChecklistTemplateItem) Template(mods ...bob.Mod[*dialect.SelectQuery]) ChecklistTemplatesQuery {
+	return ChecklistTemplates.Query(append(mods,
+		sm.Where(ChecklistTemplates.Columns.ID.EQ(sqlite.Arg(o.TemplateID))),
+	)...)
+}
+
+func (os ChecklistTemplateItemSlice) Template(mods ...bob.Mod[*dialect.SelectQuery]) ChecklistTemplatesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TemplateID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return ChecklistTemplates.Query(append(mods,
+		sm.Where(sqlite.Group(ChecklistTemplates.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachChecklistTemplateItemTemplate0(ctx context.Context, exec bob.Executor, count int, checklistTemplateItem0 *ChecklistTemplateItem, checklistTemplate1 *ChecklistTemplate) (*ChecklistTemplateItem, error) {
+	setter := &ChecklistTemplateItemSetter{
+		TemplateID: omit.From(checklistTemplate1.ID),
+	}
+
+	err := checklistTemplateItem0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachChecklistTemplateItemTemplate0: %w", err)
+	}
+
+	return checklistTemplateItem0, nil
+}
+
+func (checklistTemplateItem0 *ChecklistTemplateItem) InsertTemplate(ctx context.Context, exec bob.Executor, related *ChecklistTemplateSetter) error {
+	var err error
+
+	checklistTemplate1, err := ChecklistTemplates.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachChecklistTemplateItemTemplate0(ctx, exec, 1, checklistTemplateItem0, checklistTemplate1)
+	if err != nil {
+		return err
+	}
+
+	checklistTemplateItem0.R.Template = checklistTemplate1
+
+	checklistTemplate1.R.Items = append(checklistTemplate1.R.Items, checklistTemplateItem0)
+
+	return nil
+}
+
+func (checklistTemplateItem0 *ChecklistTemplateItem) AttachTemplate(ctx context.Context, exec bob.Executor, checklistTemplate1 *ChecklistTemplate) error {
+	var err error
+
+	_, err = attachChecklistTemplateItemTemplate0(ctx, exec, 1, checklistTemplateItem0, checklistTemplate1)
+	if err != nil {
+		return err
+	}
+
+	checklistTemplateItem0.R.Template = checklistTemplate1
+
+	checklistTemplate1.R.Items = append(checklistTemplate1.R.Items, checklistTemplateItem0)
+
+	return nil
+}
+
+type checklistTemplateItemWhere[Q sqlite.Filterable] struct {
+	ID         sqlite.WhereMod[Q, int64]
+	TemplateID sqlite.WhereMod[Q, int64]
+	Title      sqlite.WhereMod[Q, string]
+	DueDays    sqlite.WhereNullMod[Q, int64]
+	Position   sqlite.WhereMod[Q, int64]
+}
+
+func (checklistTemplateItemWhere[Q]) AliasedAs(alias string) checklistTemplateItemWhere[Q] {
+	return buildChecklistTemplateItemWhere[Q](buildChecklistTemplateItemColumns(alias))
+}
+
+func buildChecklistTemplateItemWhere[Q sqlite.Filterable](cols checklistTemplateItemColumns) checklistTemplateItemWhere[Q] {
+	return checklistTemplateItemWhere[Q]{
+		ID:         sqlite.Where[Q, int64](cols.ID),
+		TemplateID: sqlite.Where[Q, int64](cols.TemplateID),
+		Title:      sqlite.Where[Q, string](cols.Title),
+		DueDays:    sqlite.WhereNull[Q, int64](cols.DueDays),
+		Position:   sqlite.Where[Q, int64](cols.Position),
+	}
+}
+
+func (o *ChecklistTemplateItem) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Template":
+		rel, ok := retrieved.(*ChecklistTemplate)
+		if !ok {
+			return fmt.Errorf("checklistTemplateItem cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Template = rel
+
+		if rel != nil {
+			rel.R.Items = ChecklistTemplateItemSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("checklistTemplateItem has no relationship %q", name)
+	}
+}
+
+type checklistTemplateItemPreloader struct {
+	Template func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildChecklistTemplateItemPreloader() checklistTemplateItemPreloader {
+	return checklistTemplateItemPreloader{
+		Template: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*ChecklistTemplate, ChecklistTemplateSlice](sqlite.PreloadRel{
+				Name: "Template",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        ChecklistTemplateItems,
+						To:          ChecklistTemplates,
+						FromColumns: []string{"template_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, ChecklistTemplates.Columns.Names(), opts...)
+		},
+	}
+}
+
+type checklistTemplateItemThenLoader[Q orm.Loadable] struct {
+	Template func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildChecklistTemplateItemThenLoader[Q orm.Loadable]() checklistTemplateItemThenLoader[Q] {
+	type TemplateLoadInterface interface {
+		LoadTemplate(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return checklistTemplateItemThenLoader[Q]{
+		Template: thenLoadBuilder[Q](
+			"Template",
+			func(ctx context.Context, exec bob.Executor, retrieved TemplateLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTemplate(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadTemplate loads the checklistTemplateItem's Template into the .R struct
+func (o *ChecklistTemplateItem) LoadTemplate(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Template = nil
+
+	related, err := o.Template(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Items = ChecklistTemplateItemSlice{o}
+
+	o.R.Template = related
+	return nil
+}
+
+// LoadTemplate loads the checklistTemplateItem's Template into the .R struct
+func (os ChecklistTemplateItemSlice) LoadTemplate(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	checklistTemplates, err := os.Template(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range checklistTemplates {
+
+			if !(o.TemplateID == rel.ID) {
+				continue
+			}
+
+			rel.R.Items = append(rel.R.Items, o)
+
+			o.R.Template = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type checklistTemplateItemJoins[Q dialect.Joinable] struct {
+	typ      string
+	Template modAs[Q, checklistTemplateColumns]
+}
+
+func (j checklistTemplateItemJoins[Q]) aliasedAs(alias string) checklistTemplateItemJoins[Q] {
+	return buildChecklistTemplateItemJoins[Q](buildChecklistTemplateItemColumns(alias), j.typ)
+}
+
+func buildChecklistTemplateItemJoins[Q dialect.Joinable](cols checklistTemplateItemColumns, typ string) checklistTemplateItemJoins[Q] {
+	return checklistTemplateItemJoins[Q]{
+		typ: typ,
+		Template: modAs[Q, checklistTemplateColumns]{
+			c: ChecklistTemplates.Columns,
+			f: func(to checklistTemplateColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ChecklistTemplates.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TemplateID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
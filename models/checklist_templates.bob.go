@@ -0,0 +1,862 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// ChecklistTemplate is an object representing the database table.
+type ChecklistTemplate struct {
+	ID        int64     `db:"id,pk" `
+	UserID    int64     `db:"user_id" `
+	Name      string    `db:"name" `
+	CreatedAt time.Time `db:"created_at" `
+	UpdatedAt time.Time `db:"updated_at" `
+
+	R checklistTemplateR `db:"-" `
+}
+
+// ChecklistTemplateSlice is an alias for a slice of pointers to ChecklistTemplate.
+// This should almost always be used instead of []*ChecklistTemplate.
+type ChecklistTemplateSlice []*ChecklistTemplate
+
+// ChecklistTemplates contains methods to work with the checklist_templates table
+var ChecklistTemplates = sqlite.NewTablex[*ChecklistTemplate, ChecklistTemplateSlice, *ChecklistTemplateSetter]("", "checklist_templates", buildChecklistTemplateColumns("checklist_templates"))
+
+// ChecklistTemplatesQuery is a query on the checklist_templates table
+type ChecklistTemplatesQuery = *sqlite.ViewQuery[*ChecklistTemplate, ChecklistTemplateSlice]
+
+// checklistTemplateR is where relationships are stored.
+type checklistTemplateR struct {
+	Items ChecklistTemplateItemSlice // fk_checklist_template_items_0
+	User  *User                      // fk_checklist_templates_0
+}
+
+func buildChecklistTemplateColumns(alias string) checklistTemplateColumns {
+	return checklistTemplateColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "name", "created_at", "updated_at",
+		).WithParent("checklist_templates"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		Name:       sqlite.Quote(alias, "name"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		UpdatedAt:  sqlite.Quote(alias, "updated_at"),
+	}
+}
+
+type checklistTemplateColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	UserID     sqlite.Expression
+	Name       sqlite.Expression
+	CreatedAt  sqlite.Expression
+	UpdatedAt  sqlite.Expression
+}
+
+func (c checklistTemplateColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (checklistTemplateColumns) AliasedAs(alias string) checklistTemplateColumns {
+	return buildChecklistTemplateColumns(alias)
+}
+
+// ChecklistTemplateSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ChecklistTemplateSetter struct {
+	ID        omit.Val[int64]     `db:"id,pk" `
+	UserID    omit.Val[int64]     `db:"user_id" `
+	Name      omit.Val[string]    `db:"name" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+	UpdatedAt omit.Val[time.Time] `db:"updated_at" `
+}
+
+func (s ChecklistTemplateSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.UpdatedAt.IsValue() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s ChecklistTemplateSetter) Overwrite(t *ChecklistTemplate) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.UpdatedAt.IsValue() {
+		t.UpdatedAt = s.UpdatedAt.MustGet()
+	}
+}
+
+func (s *ChecklistTemplateSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return ChecklistTemplates.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 5)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if s.Name.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Name.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.UpdatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UpdatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ChecklistTemplateSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ChecklistTemplateSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "name")...),
+			sqlite.Arg(s.Name),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.UpdatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "updated_at")...),
+			sqlite.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindChecklistTemplate retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindChecklistTemplate(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*ChecklistTemplate, error) {
+	if len(cols) == 0 {
+		return ChecklistTemplates.Query(
+			sm.Where(ChecklistTemplates.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return ChecklistTemplates.Query(
+		sm.Where(ChecklistTemplates.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(ChecklistTemplates.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ChecklistTemplateExists checks the presence of a single record by primary key
+func ChecklistTemplateExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return ChecklistTemplates.Query(
+		sm.Where(ChecklistTemplates.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after ChecklistTemplate is retrieved from the database
+func (o *ChecklistTemplate) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ChecklistTemplates.AfterSelectHooks.RunHooks(ctx, exec, ChecklistTemplateSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = ChecklistTemplates.AfterInsertHooks.RunHooks(ctx, exec, ChecklistTemplateSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = ChecklistTemplates.AfterUpdateHooks.RunHooks(ctx, exec, ChecklistTemplateSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = ChecklistTemplates.AfterDeleteHooks.RunHooks(ctx, exec, ChecklistTemplateSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the ChecklistTemplate
+func (o *ChecklistTemplate) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *ChecklistTemplate) pkEQ() dialect.Expression {
+	return sqlite.Quote("checklist_templates", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the ChecklistTemplate
+func (o *ChecklistTemplate) Update(ctx context.Context, exec bob.Executor, s *ChecklistTemplateSetter) error {
+	v, err := ChecklistTemplates.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single ChecklistTemplate record with an executor
+func (o *ChecklistTemplate) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := ChecklistTemplates.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the ChecklistTemplate using the executor
+func (o *ChecklistTemplate) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := ChecklistTemplates.Query(
+		sm.Where(ChecklistTemplates.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ChecklistTemplateSlice is retrieved from the database
+func (o ChecklistTemplateSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ChecklistTemplates.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = ChecklistTemplates.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = ChecklistTemplates.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = ChecklistTemplates.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ChecklistTemplateSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("checklist_templates", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ChecklistTemplateSlice) copyMatchingRows(from ...*ChecklistTemplate) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ChecklistTemplateSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ChecklistTemplates.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ChecklistTemplate:
+				o.copyMatchingRows(retrieved)
+			case []*ChecklistTemplate:
+				o.copyMatchingRows(retrieved...)
+			case ChecklistTemplateSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ChecklistTemplate or a slice of ChecklistTemplate
+				// then run the AfterUpdateHooks on the slice
+				_, err = ChecklistTemplates.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ChecklistTemplateSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ChecklistTemplates.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ChecklistTemplate:
+				o.copyMatchingRows(retrieved)
+			case []*ChecklistTemplate:
+				o.copyMatchingRows(retrieved...)
+			case ChecklistTemplateSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ChecklistTemplate or a slice of ChecklistTemplate
+				// then run the AfterDeleteHooks on the slice
+				_, err = ChecklistTemplates.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ChecklistTemplateSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ChecklistTemplateSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ChecklistTemplates.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ChecklistTemplateSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ChecklistTemplates.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ChecklistTemplateSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := ChecklistTemplates.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Items starts a query for related objects on checklist_template_items
+func (o *ChecklistTemplate) Items(mods ...bob.Mod[*dialect.SelectQuery]) ChecklistTemplateItemsQuery {
+	return ChecklistTemplateItems.Query(append(mods,
+		sm.Where(ChecklistTemplateItems.Columns.TemplateID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os ChecklistTemplateSlice) Items(mods ...bob.Mod[*dialect.SelectQuery]) ChecklistTemplateItemsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return ChecklistTemplateItems.Query(append(mods,
+		sm.Where(sqlite.Group(ChecklistTemplateItems.Columns.TemplateID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *ChecklistTemplate) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os ChecklistTemplateSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertChecklistTemplateItems0(ctx context.Context, exec bob.Executor, checklistTemplateItems1 []*ChecklistTemplateItemSetter, checklistTemplate0 *ChecklistTemplate) (ChecklistTemplateItemSlice, error) {
+	for i := range checklistTemplateItems1 {
+		checklistTemplateItems1[i].TemplateID = omit.From(checklistTemplate0.ID)
+	}
+
+	ret, err := ChecklistTemplateItems.Insert(bob.ToMods(checklistTemplateItems1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertChecklistTemplateItems0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachChecklistTemplateItems0(ctx context.Context, exec bob.Executor, count int, checklistTemplateItems1 ChecklistTemplateItemSlice, checklistTemplate0 *ChecklistTemplate) (ChecklistTemplateItemSlice, error) {
+	setter := &ChecklistTemplateItemSetter{
+		TemplateID: omit.From(checklistTemplate0.ID),
+	}
+
+	err := checklistTemplateItems1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachChecklistTemplateItems0: %w", err)
+	}
+
+	return checklistTemplateItems1, nil
+}
+
+func (checklistTemplate0 *ChecklistTemplate) InsertItems(ctx context.Context, exec bob.Executor, related ...*ChecklistTemplateItemSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	checklistTemplateItems1, err := insertChecklistTemplateItems0(ctx, exec, related, checklistTemplate0)
+	if err != nil {
+		return err
+	}
+
+	checklistTemplate0.R.Items = append(checklistTemplate0.R.Items, checklistTemplateItems1...)
+
+	for _, rel := range checklistTemplateItems1 {
+		rel.R.Template = checklistTemplate0
+	}
+	return nil
+}
+
+func (checklistTemplate0 *ChecklistTemplate) AttachItems(ctx context.Context, exec bob.Executor, related ...*ChecklistTemplateItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	checklistTemplateItems1 := ChecklistTemplateItemSlice(related)
+
+	_, err = attachChecklistTemplateItems0(ctx, exec, len(related), checklistTemplateItems1, checklistTemplate0)
+	if err != nil {
+		return err
+	}
+
+	checklistTemplate0.R.Items = append(checklistTemplate0.R.Items, checklistTemplateItems1...)
+
+	for _, rel := range related {
+		rel.R.Template = checklistTemplate0
+	}
+
+	return nil
+}
+
+func attachChecklistTemplateUser0(ctx context.Context, exec bob.Executor, count int, checklistTemplate0 *ChecklistTemplate, user1 *User) (*ChecklistTemplate, error) {
+	setter := &ChecklistTemplateSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := checklistTemplate0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachChecklistTemplateUser0: %w", err)
+	}
+
+	return checklistTemplate0, nil
+}
+
+func (checklistTemplate0 *ChecklistTemplate) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachChecklistTemplateUser0(ctx, exec, 1, checklistTemplate0, user1)
+	if err != nil {
+		return err
+	}
+
+	checklistTemplate0.R.User = user1
+
+	user1.R.ChecklistTemplates = append(user1.R.ChecklistTemplates, checklistTemplate0)
+
+	return nil
+}
+
+func (checklistTemplate0 *ChecklistTemplate) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachChecklistTemplateUser0(ctx, exec, 1, checklistTemplate0, user1)
+	if err != nil {
+		return err
+	}
+
+	checklistTemplate0.R.User = user1
+
+	user1.R.ChecklistTemplates = append(user1.R.ChecklistTemplates, checklistTemplate0)
+
+	return nil
+}
+
+type checklistTemplateWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereMod[Q, int64]
+	Name      sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	UpdatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (checklistTemplateWhere[Q]) AliasedAs(alias string) checklistTemplateWhere[Q] {
+	return buildChecklistTemplateWhere[Q](buildChecklistTemplateColumns(alias))
+}
+
+func buildChecklistTemplateWhere[Q sqlite.Filterable](cols checklistTemplateColumns) checklistTemplateWhere[Q] {
+	return checklistTemplateWhere[Q]{
+		ID:        sqlite.Where[Q, int64](cols.ID),
+		UserID:    sqlite.Where[Q, int64](cols.UserID),
+		Name:      sqlite.Where[Q, string](cols.Name),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt: sqlite.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *ChecklistTemplate) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Items":
+		rels, ok := retrieved.(ChecklistTemplateItemSlice)
+		if !ok {
+			return fmt.Errorf("checklistTemplate cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Items = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Template = o
+			}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("checklistTemplate cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.ChecklistTemplates = ChecklistTemplateSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("checklistTemplate has no relationship %q", name)
+	}
+}
+
+type checklistTemplatePreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildChecklistTemplatePreloader() checklistTemplatePreloader {
+	return checklistTemplatePreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        ChecklistTemplates,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type checklistTemplateThenLoader[Q orm.Loadable] struct {
+	Items func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildChecklistTemplateThenLoader[Q orm.Loadable]() checklistTemplateThenLoader[Q] {
+	type ItemsLoadInterface interface {
+		LoadItems(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return checklistTemplateThenLoader[Q]{
+		Items: thenLoadBuilder[Q](
+			"Items",
+			func(ctx context.Context, exec bob.Executor, retrieved ItemsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadItems(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadItems loads the checklistTemplate's Items into the .R struct
+func (o *ChecklistTemplate) LoadItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Items = nil
+
+	related, err := o.Items(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Template = o
+	}
+
+	o.R.Items = related
+	return nil
+}
+
+// LoadItems loads the checklistTemplate's Items into the .R struct
+func (os ChecklistTemplateSlice) LoadItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	checklistTemplateItems, err := os.Items(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Items = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range checklistTemplateItems {
+
+			if !(o.ID == rel.TemplateID) {
+				continue
+			}
+
+			rel.R.Template = o
+
+			o.R.Items = append(o.R.Items, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the checklistTemplate's User into the .R struct
+func (o *ChecklistTemplate) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ChecklistTemplates = ChecklistTemplateSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the checklistTemplate's User into the .R struct
+func (os ChecklistTemplateSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.ChecklistTemplates = append(rel.R.ChecklistTemplates, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type checklistTemplateJoins[Q dialect.Joinable] struct {
+	typ   string
+	Items modAs[Q, checklistTemplateItemColumns]
+	User  modAs[Q, userColumns]
+}
+
+func (j checklistTemplateJoins[Q]) aliasedAs(alias string) checklistTemplateJoins[Q] {
+	return buildChecklistTemplateJoins[Q](buildChecklistTemplateColumns(alias), j.typ)
+}
+
+func buildChecklistTemplateJoins[Q dialect.Joinable](cols checklistTemplateColumns, typ string) checklistTemplateJoins[Q] {
+	return checklistTemplateJoins[Q]{
+		typ: typ,
+		Items: modAs[Q, checklistTemplateItemColumns]{
+			c: ChecklistTemplateItems.Columns,
+			f: func(to checklistTemplateItemColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ChecklistTemplateItems.Name().As(to.Alias())).On(
+						to.TemplateID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
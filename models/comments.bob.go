@@ -0,0 +1,1426 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Comment is an object representing the database table.
+type Comment struct {
+	ID        int64           `db:"id,pk" `
+	TodoID    int64           `db:"todo_id" `
+	UserID    int64           `db:"user_id" `
+	ParentID  null.Val[int64] `db:"parent_id" `
+	Body      string          `db:"body" `
+	CreatedAt time.Time       `db:"created_at" `
+
+	R commentR `db:"-" `
+}
+
+// CommentSlice is an alias for a slice of pointers to Comment.
+// This should almost always be used instead of []*Comment.
+type CommentSlice []*Comment
+
+// Comments contains methods to work with the comments table
+var Comments = sqlite.NewTablex[*Comment, CommentSlice, *CommentSetter]("", "comments", buildCommentColumns("comments"))
+
+// CommentsQuery is a query on the comments table
+type CommentsQuery = *sqlite.ViewQuery[*Comment, CommentSlice]
+
+// commentR is where relationships are stored.
+type commentR struct {
+	Parent        *Comment          // fk_comments_0
+	Replies       CommentSlice      // fk_comments_0__self_join_reverse
+	User          *User             // fk_comments_1
+	Todo          *Todo             // fk_comments_2
+	Notifications NotificationSlice // fk_notifications_0
+}
+
+func buildCommentColumns(alias string) commentColumns {
+	return commentColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "todo_id", "user_id", "parent_id", "body", "created_at",
+		).WithParent("comments"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		TodoID:     sqlite.Quote(alias, "todo_id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		ParentID:   sqlite.Quote(alias, "parent_id"),
+		Body:       sqlite.Quote(alias, "body"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type commentColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	TodoID     sqlite.Expression
+	UserID     sqlite.Expression
+	ParentID   sqlite.Expression
+	Body       sqlite.Expression
+	CreatedAt  sqlite.Expression
+}
+
+func (c commentColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (commentColumns) AliasedAs(alias string) commentColumns {
+	return buildCommentColumns(alias)
+}
+
+// CommentSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type CommentSetter struct {
+	ID        omit.Val[int64]     `db:"id,pk" `
+	TodoID    omit.Val[int64]     `db:"todo_id" `
+	UserID    omit.Val[int64]     `db:"user_id" `
+	ParentID  omitnull.Val[int64] `db:"parent_id" `
+	Body      omit.Val[string]    `db:"body" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s CommentSetter) SetColumns() []string {
+	vals := make([]string, 0, 6)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.TodoID.IsValue() {
+		vals = append(vals, "todo_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if !s.ParentID.IsUnset() {
+		vals = append(vals, "parent_id")
+	}
+	if s.Body.IsValue() {
+		vals = append(vals, "body")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s CommentSetter) Overwrite(t *Comment) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.TodoID.IsValue() {
+		t.TodoID = s.TodoID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if !s.ParentID.IsUnset() {
+		t.ParentID = s.ParentID.MustGetNull()
+	}
+	if s.Body.IsValue() {
+		t.Body = s.Body.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *CommentSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Comments.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 6)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.TodoID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if !s.ParentID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.ParentID.MustGetNull()))
+		}
+
+		if s.Body.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Body.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s CommentSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s CommentSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 6)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.TodoID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_id")...),
+			sqlite.Arg(s.TodoID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if !s.ParentID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "parent_id")...),
+			sqlite.Arg(s.ParentID),
+		}})
+	}
+
+	if s.Body.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "body")...),
+			sqlite.Arg(s.Body),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindComment retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindComment(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Comment, error) {
+	if len(cols) == 0 {
+		return Comments.Query(
+			sm.Where(Comments.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Comments.Query(
+		sm.Where(Comments.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Comments.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// CommentExists checks the presence of a single record by primary key
+func CommentExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Comments.Query(
+		sm.Where(Comments.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Comment is retrieved from the database
+func (o *Comment) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Comments.AfterSelectHooks.RunHooks(ctx, exec, CommentSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Comments.AfterInsertHooks.RunHooks(ctx, exec, CommentSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Comments.AfterUpdateHooks.RunHooks(ctx, exec, CommentSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Comments.AfterDeleteHooks.RunHooks(ctx, exec, CommentSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Comment
+func (o *Comment) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *Comment) pkEQ() dialect.Expression {
+	return sqlite.Quote("comments", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Comment
+func (o *Comment) Update(ctx context.Context, exec bob.Executor, s *CommentSetter) error {
+	v, err := Comments.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Comment record with an executor
+func (o *Comment) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Comments.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Comment using the executor
+func (o *Comment) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Comments.Query(
+		sm.Where(Comments.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after CommentSlice is retrieved from the database
+func (o CommentSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Comments.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Comments.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Comments.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Comments.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o CommentSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("comments", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o CommentSlice) copyMatchingRows(from ...*Comment) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o CommentSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Comments.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Comment:
+				o.copyMatchingRows(retrieved)
+			case []*Comment:
+				o.copyMatchingRows(retrieved...)
+			case CommentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Comment or a slice of Comment
+				// then run the AfterUpdateHooks on the slice
+				_, err = Comments.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o CommentSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Comments.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Comment:
+				o.copyMatchingRows(retrieved)
+			case []*Comment:
+				o.copyMatchingRows(retrieved...)
+			case CommentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Comment or a slice of Comment
+				// then run the AfterDeleteHooks on the slice
+				_, err = Comments.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o CommentSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals CommentSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Comments.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o CommentSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Comments.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o CommentSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Comments.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Parent starts a query for related objects on comments
+func (o *Comment) Parent(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	return Comments.Query(append(mods,
+		sm.Where(Comments.Columns.ID.EQ(sqlite.Arg(o.ParentID))),
+	)...)
+}
+
+func (os CommentSlice) Parent(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ParentID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Comments.Query(append(mods,
+		sm.Where(sqlite.Group(Comments.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Replies starts a query for related objects on comments
+func (o *Comment) Replies(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	return Comments.Query(append(mods,
+		sm.Where(Comments.Columns.ParentID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os CommentSlice) Replies(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Comments.Query(append(mods,
+		sm.Where(sqlite.Group(Comments.Columns.ParentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Comment) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os CommentSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todo starts a query for related objects on todos
+func (o *Comment) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.TodoID))),
+	)...)
+}
+
+func (os CommentSlice) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TodoID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Notifications starts a query for related objects on notifications
+func (o *Comment) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.CommentID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os CommentSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Notifications.Query(append(mods,
+		sm.Where(sqlite.Group(Notifications.Columns.CommentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachCommentParent0(ctx context.Context, exec bob.Executor, count int, comment0 *Comment, comment1 *Comment) (*Comment, error) {
+	setter := &CommentSetter{
+		ParentID: omitnull.From(comment1.ID),
+	}
+
+	err := comment0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachCommentParent0: %w", err)
+	}
+
+	return comment0, nil
+}
+
+func (comment0 *Comment) InsertParent(ctx context.Context, exec bob.Executor, related *CommentSetter) error {
+	var err error
+
+	comment1, err := Comments.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachCommentParent0(ctx, exec, 1, comment0, comment1)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Parent = comment1
+
+	comment1.R.Parent = comment0
+
+	return nil
+}
+
+func (comment0 *Comment) AttachParent(ctx context.Context, exec bob.Executor, comment1 *Comment) error {
+	var err error
+
+	_, err = attachCommentParent0(ctx, exec, 1, comment0, comment1)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Parent = comment1
+
+	comment1.R.Parent = comment0
+
+	return nil
+}
+
+func insertCommentReplies0(ctx context.Context, exec bob.Executor, comments1 []*CommentSetter, comment0 *Comment) (CommentSlice, error) {
+	for i := range comments1 {
+		comments1[i].ParentID = omitnull.From(comment0.ID)
+	}
+
+	ret, err := Comments.Insert(bob.ToMods(comments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertCommentReplies0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachCommentReplies0(ctx context.Context, exec bob.Executor, count int, comments1 CommentSlice, comment0 *Comment) (CommentSlice, error) {
+	setter := &CommentSetter{
+		ParentID: omitnull.From(comment0.ID),
+	}
+
+	err := comments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachCommentReplies0: %w", err)
+	}
+
+	return comments1, nil
+}
+
+func (comment0 *Comment) InsertReplies(ctx context.Context, exec bob.Executor, related ...*CommentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	comments1, err := insertCommentReplies0(ctx, exec, related, comment0)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Replies = append(comment0.R.Replies, comments1...)
+
+	for _, rel := range comments1 {
+		rel.R.Replies = append(rel.R.Replies, comment0)
+	}
+	return nil
+}
+
+func (comment0 *Comment) AttachReplies(ctx context.Context, exec bob.Executor, related ...*Comment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	comments1 := CommentSlice(related)
+
+	_, err = attachCommentReplies0(ctx, exec, len(related), comments1, comment0)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Replies = append(comment0.R.Replies, comments1...)
+
+	for _, rel := range related {
+		rel.R.Replies = append(rel.R.Replies, comment0)
+	}
+
+	return nil
+}
+
+func attachCommentUser0(ctx context.Context, exec bob.Executor, count int, comment0 *Comment, user1 *User) (*Comment, error) {
+	setter := &CommentSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := comment0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachCommentUser0: %w", err)
+	}
+
+	return comment0, nil
+}
+
+func (comment0 *Comment) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachCommentUser0(ctx, exec, 1, comment0, user1)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.User = user1
+
+	user1.R.Comments = append(user1.R.Comments, comment0)
+
+	return nil
+}
+
+func (comment0 *Comment) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachCommentUser0(ctx, exec, 1, comment0, user1)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.User = user1
+
+	user1.R.Comments = append(user1.R.Comments, comment0)
+
+	return nil
+}
+
+func attachCommentTodo0(ctx context.Context, exec bob.Executor, count int, comment0 *Comment, todo1 *Todo) (*Comment, error) {
+	setter := &CommentSetter{
+		TodoID: omit.From(todo1.ID),
+	}
+
+	err := comment0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachCommentTodo0: %w", err)
+	}
+
+	return comment0, nil
+}
+
+func (comment0 *Comment) InsertTodo(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachCommentTodo0(ctx, exec, 1, comment0, todo1)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Todo = todo1
+
+	todo1.R.Comments = append(todo1.R.Comments, comment0)
+
+	return nil
+}
+
+func (comment0 *Comment) AttachTodo(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachCommentTodo0(ctx, exec, 1, comment0, todo1)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Todo = todo1
+
+	todo1.R.Comments = append(todo1.R.Comments, comment0)
+
+	return nil
+}
+
+func insertCommentNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, comment0 *Comment) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].CommentID = omitnull.From(comment0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertCommentNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachCommentNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, comment0 *Comment) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		CommentID: omitnull.From(comment0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachCommentNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (comment0 *Comment) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertCommentNotifications0(ctx, exec, related, comment0)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Notifications = append(comment0.R.Notifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.Comment = comment0
+	}
+	return nil
+}
+
+func (comment0 *Comment) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachCommentNotifications0(ctx, exec, len(related), notifications1, comment0)
+	if err != nil {
+		return err
+	}
+
+	comment0.R.Notifications = append(comment0.R.Notifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.Comment = comment0
+	}
+
+	return nil
+}
+
+type commentWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int64]
+	TodoID    sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereMod[Q, int64]
+	ParentID  sqlite.WhereNullMod[Q, int64]
+	Body      sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (commentWhere[Q]) AliasedAs(alias string) commentWhere[Q] {
+	return buildCommentWhere[Q](buildCommentColumns(alias))
+}
+
+func buildCommentWhere[Q sqlite.Filterable](cols commentColumns) commentWhere[Q] {
+	return commentWhere[Q]{
+		ID:        sqlite.Where[Q, int64](cols.ID),
+		TodoID:    sqlite.Where[Q, int64](cols.TodoID),
+		UserID:    sqlite.Where[Q, int64](cols.UserID),
+		ParentID:  sqlite.WhereNull[Q, int64](cols.ParentID),
+		Body:      sqlite.Where[Q, string](cols.Body),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Comment) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Parent":
+		rel, ok := retrieved.(*Comment)
+		if !ok {
+			return fmt.Errorf("comment cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Parent = rel
+
+		if rel != nil {
+			rel.R.Parent = o
+		}
+		return nil
+	case "Replies":
+		rels, ok := retrieved.(CommentSlice)
+		if !ok {
+			return fmt.Errorf("comment cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Replies = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Replies = CommentSlice{o}
+			}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("comment cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Comments = CommentSlice{o}
+		}
+		return nil
+	case "Todo":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("comment cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todo = rel
+
+		if rel != nil {
+			rel.R.Comments = CommentSlice{o}
+		}
+		return nil
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("comment cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Comment = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("comment has no relationship %q", name)
+	}
+}
+
+type commentPreloader struct {
+	Parent func(...sqlite.PreloadOption) sqlite.Preloader
+	User   func(...sqlite.PreloadOption) sqlite.Preloader
+	Todo   func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildCommentPreloader() commentPreloader {
+	return commentPreloader{
+		Parent: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Comment, CommentSlice](sqlite.PreloadRel{
+				Name: "Parent",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Comments,
+						To:          Comments,
+						FromColumns: []string{"parent_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Comments.Columns.Names(), opts...)
+		},
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Comments,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Todo: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Todo",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Comments,
+						To:          Todos,
+						FromColumns: []string{"todo_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+	}
+}
+
+type commentThenLoader[Q orm.Loadable] struct {
+	Parent        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Replies       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todo          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Notifications func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildCommentThenLoader[Q orm.Loadable]() commentThenLoader[Q] {
+	type ParentLoadInterface interface {
+		LoadParent(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type RepliesLoadInterface interface {
+		LoadReplies(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoLoadInterface interface {
+		LoadTodo(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return commentThenLoader[Q]{
+		Parent: thenLoadBuilder[Q](
+			"Parent",
+			func(ctx context.Context, exec bob.Executor, retrieved ParentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadParent(ctx, exec, mods...)
+			},
+		),
+		Replies: thenLoadBuilder[Q](
+			"Replies",
+			func(ctx context.Context, exec bob.Executor, retrieved RepliesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadReplies(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		Todo: thenLoadBuilder[Q](
+			"Todo",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodo(ctx, exec, mods...)
+			},
+		),
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadParent loads the comment's Parent into the .R struct
+func (o *Comment) LoadParent(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Parent = nil
+
+	related, err := o.Parent(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Parent = o
+
+	o.R.Parent = related
+	return nil
+}
+
+// LoadParent loads the comment's Parent into the .R struct
+func (os CommentSlice) LoadParent(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	comments, err := os.Parent(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range comments {
+			if !o.ParentID.IsValue() {
+				continue
+			}
+
+			if !(o.ParentID.IsValue() && o.ParentID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.Parent = o
+
+			o.R.Parent = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadReplies loads the comment's Replies into the .R struct
+func (o *Comment) LoadReplies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Replies = nil
+
+	related, err := o.Replies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Replies = CommentSlice{o}
+	}
+
+	o.R.Replies = related
+	return nil
+}
+
+// LoadReplies loads the comment's Replies into the .R struct
+func (os CommentSlice) LoadReplies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	comments, err := os.Replies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Replies = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range comments {
+
+			if !rel.ParentID.IsValue() {
+				continue
+			}
+			if !(rel.ParentID.IsValue() && o.ID == rel.ParentID.MustGet()) {
+				continue
+			}
+
+			rel.R.Replies = append(rel.R.Replies, o)
+
+			o.R.Replies = append(o.R.Replies, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the comment's User into the .R struct
+func (o *Comment) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Comments = CommentSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the comment's User into the .R struct
+func (os CommentSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Comments = append(rel.R.Comments, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo loads the comment's Todo into the .R struct
+func (o *Comment) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todo = nil
+
+	related, err := o.Todo(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Comments = CommentSlice{o}
+
+	o.R.Todo = related
+	return nil
+}
+
+// LoadTodo loads the comment's Todo into the .R struct
+func (os CommentSlice) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todo(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.TodoID == rel.ID) {
+				continue
+			}
+
+			rel.R.Comments = append(rel.R.Comments, o)
+
+			o.R.Todo = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications loads the comment's Notifications into the .R struct
+func (o *Comment) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Comment = o
+	}
+
+	o.R.Notifications = related
+	return nil
+}
+
+// LoadNotifications loads the comment's Notifications into the .R struct
+func (os CommentSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !rel.CommentID.IsValue() {
+				continue
+			}
+			if !(rel.CommentID.IsValue() && o.ID == rel.CommentID.MustGet()) {
+				continue
+			}
+
+			rel.R.Comment = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
+
+	return nil
+}
+
+type commentJoins[Q dialect.Joinable] struct {
+	typ           string
+	Parent        modAs[Q, commentColumns]
+	Replies       modAs[Q, commentColumns]
+	User          modAs[Q, userColumns]
+	Todo          modAs[Q, todoColumns]
+	Notifications modAs[Q, notificationColumns]
+}
+
+func (j commentJoins[Q]) aliasedAs(alias string) commentJoins[Q] {
+	return buildCommentJoins[Q](buildCommentColumns(alias), j.typ)
+}
+
+func buildCommentJoins[Q dialect.Joinable](cols commentColumns, typ string) commentJoins[Q] {
+	return commentJoins[Q]{
+		typ: typ,
+		Parent: modAs[Q, commentColumns]{
+			c: Comments.Columns,
+			f: func(to commentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Comments.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ParentID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Replies: modAs[Q, commentColumns]{
+			c: Comments.Columns,
+			f: func(to commentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Comments.Name().As(to.Alias())).On(
+						to.ParentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todo: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.CommentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
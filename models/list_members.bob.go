@@ -0,0 +1,855 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// ListMember is an object representing the database table.
+type ListMember struct {
+	ListID    int64     `db:"list_id,pk" `
+	UserID    int64     `db:"user_id,pk" `
+	Role      string    `db:"role" `
+	CreatedAt time.Time `db:"created_at" `
+	UpdatedAt time.Time `db:"updated_at" `
+
+	R listMemberR `db:"-" `
+}
+
+// ListMemberSlice is an alias for a slice of pointers to ListMember.
+// This should almost always be used instead of []*ListMember.
+type ListMemberSlice []*ListMember
+
+// ListMembers contains methods to work with the list_members table
+var ListMembers = sqlite.NewTablex[*ListMember, ListMemberSlice, *ListMemberSetter]("", "list_members", buildListMemberColumns("list_members"))
+
+// ListMembersQuery is a query on the list_members table
+type ListMembersQuery = *sqlite.ViewQuery[*ListMember, ListMemberSlice]
+
+// listMemberR is where relationships are stored.
+type listMemberR struct {
+	User *User // fk_list_members_0
+	List *List // fk_list_members_1
+}
+
+func buildListMemberColumns(alias string) listMemberColumns {
+	return listMemberColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"list_id", "user_id", "role", "created_at", "updated_at",
+		).WithParent("list_members"),
+		tableAlias: alias,
+		ListID:     sqlite.Quote(alias, "list_id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		Role:       sqlite.Quote(alias, "role"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		UpdatedAt:  sqlite.Quote(alias, "updated_at"),
+	}
+}
+
+type listMemberColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ListID     sqlite.Expression
+	UserID     sqlite.Expression
+	Role       sqlite.Expression
+	CreatedAt  sqlite.Expression
+	UpdatedAt  sqlite.Expression
+}
+
+func (c listMemberColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (listMemberColumns) AliasedAs(alias string) listMemberColumns {
+	return buildListMemberColumns(alias)
+}
+
+// ListMemberSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ListMemberSetter struct {
+	ListID    omit.Val[int64]     `db:"list_id,pk" `
+	UserID    omit.Val[int64]     `db:"user_id,pk" `
+	Role      omit.Val[string]    `db:"role" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+	UpdatedAt omit.Val[time.Time] `db:"updated_at" `
+}
+
+func (s ListMemberSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ListID.IsValue() {
+		vals = append(vals, "list_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Role.IsValue() {
+		vals = append(vals, "role")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.UpdatedAt.IsValue() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s ListMemberSetter) Overwrite(t *ListMember) {
+	if s.ListID.IsValue() {
+		t.ListID = s.ListID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Role.IsValue() {
+		t.Role = s.Role.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.UpdatedAt.IsValue() {
+		t.UpdatedAt = s.UpdatedAt.MustGet()
+	}
+}
+
+func (s *ListMemberSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return ListMembers.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"list_id", "user_id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 5)
+		if s.ListID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ListID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if s.Role.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Role.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.UpdatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UpdatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil), sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ListMemberSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ListMemberSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ListID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "list_id")...),
+			sqlite.Arg(s.ListID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.Role.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "role")...),
+			sqlite.Arg(s.Role),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.UpdatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "updated_at")...),
+			sqlite.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindListMember retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindListMember(ctx context.Context, exec bob.Executor, ListIDPK int64, UserIDPK int64, cols ...string) (*ListMember, error) {
+	if len(cols) == 0 {
+		return ListMembers.Query(
+			sm.Where(ListMembers.Columns.ListID.EQ(sqlite.Arg(ListIDPK))),
+			sm.Where(ListMembers.Columns.UserID.EQ(sqlite.Arg(UserIDPK))),
+		).One(ctx, exec)
+	}
+
+	return ListMembers.Query(
+		sm.Where(ListMembers.Columns.ListID.EQ(sqlite.Arg(ListIDPK))),
+		sm.Where(ListMembers.Columns.UserID.EQ(sqlite.Arg(UserIDPK))),
+		sm.Columns(ListMembers.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ListMemberExists checks the presence of a single record by primary key
+func ListMemberExists(ctx context.Context, exec bob.Executor, ListIDPK int64, UserIDPK int64) (bool, error) {
+	return ListMembers.Query(
+		sm.Where(ListMembers.Columns.ListID.EQ(sqlite.Arg(ListIDPK))),
+		sm.Where(ListMembers.Columns.UserID.EQ(sqlite.Arg(UserIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after ListMember is retrieved from the database
+func (o *ListMember) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ListMembers.AfterSelectHooks.RunHooks(ctx, exec, ListMemberSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = ListMembers.AfterInsertHooks.RunHooks(ctx, exec, ListMemberSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = ListMembers.AfterUpdateHooks.RunHooks(ctx, exec, ListMemberSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = ListMembers.AfterDeleteHooks.RunHooks(ctx, exec, ListMemberSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the ListMember
+func (o *ListMember) primaryKeyVals() bob.Expression {
+	return sqlite.ArgGroup(
+		o.ListID,
+		o.UserID,
+	)
+}
+
+func (o *ListMember) pkEQ() dialect.Expression {
+	return sqlite.Group(sqlite.Quote("list_members", "list_id"), sqlite.Quote("list_members", "user_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the ListMember
+func (o *ListMember) Update(ctx context.Context, exec bob.Executor, s *ListMemberSetter) error {
+	v, err := ListMembers.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single ListMember record with an executor
+func (o *ListMember) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := ListMembers.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the ListMember using the executor
+func (o *ListMember) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := ListMembers.Query(
+		sm.Where(ListMembers.Columns.ListID.EQ(sqlite.Arg(o.ListID))),
+		sm.Where(ListMembers.Columns.UserID.EQ(sqlite.Arg(o.UserID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ListMemberSlice is retrieved from the database
+func (o ListMemberSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ListMembers.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = ListMembers.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = ListMembers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = ListMembers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ListMemberSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Group(sqlite.Quote("list_members", "list_id"), sqlite.Quote("list_members", "user_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ListMemberSlice) copyMatchingRows(from ...*ListMember) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ListID != old.ListID {
+				continue
+			}
+			if new.UserID != old.UserID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ListMemberSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ListMembers.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ListMember:
+				o.copyMatchingRows(retrieved)
+			case []*ListMember:
+				o.copyMatchingRows(retrieved...)
+			case ListMemberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ListMember or a slice of ListMember
+				// then run the AfterUpdateHooks on the slice
+				_, err = ListMembers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ListMemberSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ListMembers.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ListMember:
+				o.copyMatchingRows(retrieved)
+			case []*ListMember:
+				o.copyMatchingRows(retrieved...)
+			case ListMemberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ListMember or a slice of ListMember
+				// then run the AfterDeleteHooks on the slice
+				_, err = ListMembers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ListMemberSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ListMemberSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ListMembers.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ListMemberSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ListMembers.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ListMemberSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := ListMembers.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *ListMember) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os ListMemberSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// List starts a query for related objects on lists
+func (o *ListMember) List(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	return Lists.Query(append(mods,
+		sm.Where(Lists.Columns.ID.EQ(sqlite.Arg(o.ListID))),
+	)...)
+}
+
+func (os ListMemberSlice) List(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ListID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Lists.Query(append(mods,
+		sm.Where(sqlite.Group(Lists.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachListMemberUser0(ctx context.Context, exec bob.Executor, count int, listMember0 *ListMember, user1 *User) (*ListMember, error) {
+	setter := &ListMemberSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := listMember0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListMemberUser0: %w", err)
+	}
+
+	return listMember0, nil
+}
+
+func (listMember0 *ListMember) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachListMemberUser0(ctx, exec, 1, listMember0, user1)
+	if err != nil {
+		return err
+	}
+
+	listMember0.R.User = user1
+
+	user1.R.ListMembers = append(user1.R.ListMembers, listMember0)
+
+	return nil
+}
+
+func (listMember0 *ListMember) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachListMemberUser0(ctx, exec, 1, listMember0, user1)
+	if err != nil {
+		return err
+	}
+
+	listMember0.R.User = user1
+
+	user1.R.ListMembers = append(user1.R.ListMembers, listMember0)
+
+	return nil
+}
+
+func attachListMemberList0(ctx context.Context, exec bob.Executor, count int, listMember0 *ListMember, list1 *List) (*ListMember, error) {
+	setter := &ListMemberSetter{
+		ListID: omit.From(list1.ID),
+	}
+
+	err := listMember0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListMemberList0: %w", err)
+	}
+
+	return listMember0, nil
+}
+
+func (listMember0 *ListMember) InsertList(ctx context.Context, exec bob.Executor, related *ListSetter) error {
+	var err error
+
+	list1, err := Lists.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachListMemberList0(ctx, exec, 1, listMember0, list1)
+	if err != nil {
+		return err
+	}
+
+	listMember0.R.List = list1
+
+	list1.R.ListMembers = append(list1.R.ListMembers, listMember0)
+
+	return nil
+}
+
+func (listMember0 *ListMember) AttachList(ctx context.Context, exec bob.Executor, list1 *List) error {
+	var err error
+
+	_, err = attachListMemberList0(ctx, exec, 1, listMember0, list1)
+	if err != nil {
+		return err
+	}
+
+	listMember0.R.List = list1
+
+	list1.R.ListMembers = append(list1.R.ListMembers, listMember0)
+
+	return nil
+}
+
+type listMemberWhere[Q sqlite.Filterable] struct {
+	ListID    sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereMod[Q, int64]
+	Role      sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	UpdatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (listMemberWhere[Q]) AliasedAs(alias string) listMemberWhere[Q] {
+	return buildListMemberWhere[Q](buildListMemberColumns(alias))
+}
+
+func buildListMemberWhere[Q sqlite.Filterable](cols listMemberColumns) listMemberWhere[Q] {
+	return listMemberWhere[Q]{
+		ListID:    sqlite.Where[Q, int64](cols.ListID),
+		UserID:    sqlite.Where[Q, int64](cols.UserID),
+		Role:      sqlite.Where[Q, string](cols.Role),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt: sqlite.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *ListMember) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("listMember cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.ListMembers = ListMemberSlice{o}
+		}
+		return nil
+	case "List":
+		rel, ok := retrieved.(*List)
+		if !ok {
+			return fmt.Errorf("listMember cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.List = rel
+
+		if rel != nil {
+			rel.R.ListMembers = ListMemberSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("listMember has no relationship %q", name)
+	}
+}
+
+type listMemberPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+	List func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildListMemberPreloader() listMemberPreloader {
+	return listMemberPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        ListMembers,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		List: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*List, ListSlice](sqlite.PreloadRel{
+				Name: "List",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        ListMembers,
+						To:          Lists,
+						FromColumns: []string{"list_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Lists.Columns.Names(), opts...)
+		},
+	}
+}
+
+type listMemberThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	List func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildListMemberThenLoader[Q orm.Loadable]() listMemberThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ListLoadInterface interface {
+		LoadList(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return listMemberThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		List: thenLoadBuilder[Q](
+			"List",
+			func(ctx context.Context, exec bob.Executor, retrieved ListLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadList(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the listMember's User into the .R struct
+func (o *ListMember) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ListMembers = ListMemberSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the listMember's User into the .R struct
+func (os ListMemberSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.ListMembers = append(rel.R.ListMembers, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadList loads the listMember's List into the .R struct
+func (o *ListMember) LoadList(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.List = nil
+
+	related, err := o.List(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ListMembers = ListMemberSlice{o}
+
+	o.R.List = related
+	return nil
+}
+
+// LoadList loads the listMember's List into the .R struct
+func (os ListMemberSlice) LoadList(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	lists, err := os.List(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range lists {
+
+			if !(o.ListID == rel.ID) {
+				continue
+			}
+
+			rel.R.ListMembers = append(rel.R.ListMembers, o)
+
+			o.R.List = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type listMemberJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+	List modAs[Q, listColumns]
+}
+
+func (j listMemberJoins[Q]) aliasedAs(alias string) listMemberJoins[Q] {
+	return buildListMemberJoins[Q](buildListMemberColumns(alias), j.typ)
+}
+
+func buildListMemberJoins[Q dialect.Joinable](cols listMemberColumns, typ string) listMemberJoins[Q] {
+	return listMemberJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		List: modAs[Q, listColumns]{
+			c: Lists.Columns,
+			f: func(to listColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Lists.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ListID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,865 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// ListStatus is an object representing the database table.
+type ListStatus struct {
+	ID       int64  `db:"id,pk" `
+	ListID   int64  `db:"list_id" `
+	Name     string `db:"name" `
+	Done     bool   `db:"done" `
+	Position int64  `db:"position" `
+
+	R listStatusR `db:"-" `
+}
+
+// ListStatusSlice is an alias for a slice of pointers to ListStatus.
+// This should almost always be used instead of []*ListStatus.
+type ListStatusSlice []*ListStatus
+
+// ListStatuses contains methods to work with the list_statuses table
+var ListStatuses = sqlite.NewTablex[*ListStatus, ListStatusSlice, *ListStatusSetter]("", "list_statuses", buildListStatusColumns("list_statuses"))
+
+// ListStatusesQuery is a query on the list_statuses table
+type ListStatusesQuery = *sqlite.ViewQuery[*ListStatus, ListStatusSlice]
+
+// listStatusR is where relationships are stored.
+type listStatusR struct {
+	List  *List     // fk_list_statuses_0
+	Todos TodoSlice // fk_todos_1
+}
+
+func buildListStatusColumns(alias string) listStatusColumns {
+	return listStatusColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "list_id", "name", "done", "position",
+		).WithParent("list_statuses"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		ListID:     sqlite.Quote(alias, "list_id"),
+		Name:       sqlite.Quote(alias, "name"),
+		Done:       sqlite.Quote(alias, "done"),
+		Position:   sqlite.Quote(alias, "position"),
+	}
+}
+
+type listStatusColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	ListID     sqlite.Expression
+	Name       sqlite.Expression
+	Done       sqlite.Expression
+	Position   sqlite.Expression
+}
+
+func (c listStatusColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (listStatusColumns) AliasedAs(alias string) listStatusColumns {
+	return buildListStatusColumns(alias)
+}
+
+// ListStatusSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ListStatusSetter struct {
+	ID       omit.Val[int64]  `db:"id,pk" `
+	ListID   omit.Val[int64]  `db:"list_id" `
+	Name     omit.Val[string] `db:"name" `
+	Done     omit.Val[bool]   `db:"done" `
+	Position omit.Val[int64]  `db:"position" `
+}
+
+func (s ListStatusSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.ListID.IsValue() {
+		vals = append(vals, "list_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.Done.IsValue() {
+		vals = append(vals, "done")
+	}
+	if s.Position.IsValue() {
+		vals = append(vals, "position")
+	}
+	return vals
+}
+
+func (s ListStatusSetter) Overwrite(t *ListStatus) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.ListID.IsValue() {
+		t.ListID = s.ListID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.Done.IsValue() {
+		t.Done = s.Done.MustGet()
+	}
+	if s.Position.IsValue() {
+		t.Position = s.Position.MustGet()
+	}
+}
+
+func (s *ListStatusSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return ListStatuses.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 5)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.ListID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ListID.MustGet()))
+		}
+
+		if s.Name.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Name.MustGet()))
+		}
+
+		if s.Done.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Done.MustGet()))
+		}
+
+		if s.Position.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Position.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ListStatusSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ListStatusSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.ListID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "list_id")...),
+			sqlite.Arg(s.ListID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "name")...),
+			sqlite.Arg(s.Name),
+		}})
+	}
+
+	if s.Done.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "done")...),
+			sqlite.Arg(s.Done),
+		}})
+	}
+
+	if s.Position.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "position")...),
+			sqlite.Arg(s.Position),
+		}})
+	}
+
+	return exprs
+}
+
+// FindListStatus retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindListStatus(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*ListStatus, error) {
+	if len(cols) == 0 {
+		return ListStatuses.Query(
+			sm.Where(ListStatuses.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return ListStatuses.Query(
+		sm.Where(ListStatuses.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(ListStatuses.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ListStatusExists checks the presence of a single record by primary key
+func ListStatusExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return ListStatuses.Query(
+		sm.Where(ListStatuses.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after ListStatus is retrieved from the database
+func (o *ListStatus) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ListStatuses.AfterSelectHooks.RunHooks(ctx, exec, ListStatusSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = ListStatuses.AfterInsertHooks.RunHooks(ctx, exec, ListStatusSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = ListStatuses.AfterUpdateHooks.RunHooks(ctx, exec, ListStatusSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = ListStatuses.AfterDeleteHooks.RunHooks(ctx, exec, ListStatusSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the ListStatus
+func (o *ListStatus) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *ListStatus) pkEQ() dialect.Expression {
+	return sqlite.Quote("list_statuses", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the ListStatus
+func (o *ListStatus) Update(ctx context.Context, exec bob.Executor, s *ListStatusSetter) error {
+	v, err := ListStatuses.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single ListStatus record with an executor
+func (o *ListStatus) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := ListStatuses.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the ListStatus using the executor
+func (o *ListStatus) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := ListStatuses.Query(
+		sm.Where(ListStatuses.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ListStatusSlice is retrieved from the database
+func (o ListStatusSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ListStatuses.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = ListStatuses.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = ListStatuses.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = ListStatuses.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ListStatusSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("list_statuses", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ListStatusSlice) copyMatchingRows(from ...*ListStatus) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ListStatusSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ListStatuses.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ListStatus:
+				o.copyMatchingRows(retrieved)
+			case []*ListStatus:
+				o.copyMatchingRows(retrieved...)
+			case ListStatusSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ListStatus or a slice of ListStatus
+				// then run the AfterUpdateHooks on the slice
+				_, err = ListStatuses.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ListStatusSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ListStatuses.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ListStatus:
+				o.copyMatchingRows(retrieved)
+			case []*ListStatus:
+				o.copyMatchingRows(retrieved...)
+			case ListStatusSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ListStatus or a slice of ListStatus
+				// then run the AfterDeleteHooks on the slice
+				_, err = ListStatuses.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ListStatusSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ListStatusSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ListStatuses.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ListStatusSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ListStatuses.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ListStatusSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := ListStatuses.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// List starts a query for related objects on lists
+func (o *ListStatus) List(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	return Lists.Query(append(mods,
+		sm.Where(Lists.Columns.ID.EQ(sqlite.Arg(o.ListID))),
+	)...)
+}
+
+func (os ListStatusSlice) List(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ListID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Lists.Query(append(mods,
+		sm.Where(sqlite.Group(Lists.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todos starts a query for related objects on todos
+func (o *ListStatus) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.StatusID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os ListStatusSlice) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.StatusID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachListStatusList0(ctx context.Context, exec bob.Executor, count int, listStatus0 *ListStatus, list1 *List) (*ListStatus, error) {
+	setter := &ListStatusSetter{
+		ListID: omit.From(list1.ID),
+	}
+
+	err := listStatus0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListStatusList0: %w", err)
+	}
+
+	return listStatus0, nil
+}
+
+func (listStatus0 *ListStatus) InsertList(ctx context.Context, exec bob.Executor, related *ListSetter) error {
+	var err error
+
+	list1, err := Lists.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachListStatusList0(ctx, exec, 1, listStatus0, list1)
+	if err != nil {
+		return err
+	}
+
+	listStatus0.R.List = list1
+
+	list1.R.ListStatuses = append(list1.R.ListStatuses, listStatus0)
+
+	return nil
+}
+
+func (listStatus0 *ListStatus) AttachList(ctx context.Context, exec bob.Executor, list1 *List) error {
+	var err error
+
+	_, err = attachListStatusList0(ctx, exec, 1, listStatus0, list1)
+	if err != nil {
+		return err
+	}
+
+	listStatus0.R.List = list1
+
+	list1.R.ListStatuses = append(list1.R.ListStatuses, listStatus0)
+
+	return nil
+}
+
+func insertListStatusTodos0(ctx context.Context, exec bob.Executor, todos1 []*TodoSetter, listStatus0 *ListStatus) (TodoSlice, error) {
+	for i := range todos1 {
+		todos1[i].StatusID = omitnull.From(listStatus0.ID)
+	}
+
+	ret, err := Todos.Insert(bob.ToMods(todos1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertListStatusTodos0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachListStatusTodos0(ctx context.Context, exec bob.Executor, count int, todos1 TodoSlice, listStatus0 *ListStatus) (TodoSlice, error) {
+	setter := &TodoSetter{
+		StatusID: omitnull.From(listStatus0.ID),
+	}
+
+	err := todos1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListStatusTodos0: %w", err)
+	}
+
+	return todos1, nil
+}
+
+func (listStatus0 *ListStatus) InsertTodos(ctx context.Context, exec bob.Executor, related ...*TodoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todos1, err := insertListStatusTodos0(ctx, exec, related, listStatus0)
+	if err != nil {
+		return err
+	}
+
+	listStatus0.R.Todos = append(listStatus0.R.Todos, todos1...)
+
+	for _, rel := range todos1 {
+		rel.R.Status = listStatus0
+	}
+	return nil
+}
+
+func (listStatus0 *ListStatus) AttachTodos(ctx context.Context, exec bob.Executor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todos1 := TodoSlice(related)
+
+	_, err = attachListStatusTodos0(ctx, exec, len(related), todos1, listStatus0)
+	if err != nil {
+		return err
+	}
+
+	listStatus0.R.Todos = append(listStatus0.R.Todos, todos1...)
+
+	for _, rel := range related {
+		rel.R.Status = listStatus0
+	}
+
+	return nil
+}
+
+type listStatusWhere[Q sqlite.Filterable] struct {
+	ID       sqlite.WhereMod[Q, int64]
+	ListID   sqlite.WhereMod[Q, int64]
+	Name     sqlite.WhereMod[Q, string]
+	Done     sqlite.WhereMod[Q, bool]
+	Position sqlite.WhereMod[Q, int64]
+}
+
+func (listStatusWhere[Q]) AliasedAs(alias string) listStatusWhere[Q] {
+	return buildListStatusWhere[Q](buildListStatusColumns(alias))
+}
+
+func buildListStatusWhere[Q sqlite.Filterable](cols listStatusColumns) listStatusWhere[Q] {
+	return listStatusWhere[Q]{
+		ID:       sqlite.Where[Q, int64](cols.ID),
+		ListID:   sqlite.Where[Q, int64](cols.ListID),
+		Name:     sqlite.Where[Q, string](cols.Name),
+		Done:     sqlite.Where[Q, bool](cols.Done),
+		Position: sqlite.Where[Q, int64](cols.Position),
+	}
+}
+
+func (o *ListStatus) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "List":
+		rel, ok := retrieved.(*List)
+		if !ok {
+			return fmt.Errorf("listStatus cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.List = rel
+
+		if rel != nil {
+			rel.R.ListStatuses = ListStatusSlice{o}
+		}
+		return nil
+	case "Todos":
+		rels, ok := retrieved.(TodoSlice)
+		if !ok {
+			return fmt.Errorf("listStatus cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todos = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Status = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("listStatus has no relationship %q", name)
+	}
+}
+
+type listStatusPreloader struct {
+	List func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildListStatusPreloader() listStatusPreloader {
+	return listStatusPreloader{
+		List: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*List, ListSlice](sqlite.PreloadRel{
+				Name: "List",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        ListStatuses,
+						To:          Lists,
+						FromColumns: []string{"list_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Lists.Columns.Names(), opts...)
+		},
+	}
+}
+
+type listStatusThenLoader[Q orm.Loadable] struct {
+	List  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todos func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildListStatusThenLoader[Q orm.Loadable]() listStatusThenLoader[Q] {
+	type ListLoadInterface interface {
+		LoadList(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodosLoadInterface interface {
+		LoadTodos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return listStatusThenLoader[Q]{
+		List: thenLoadBuilder[Q](
+			"List",
+			func(ctx context.Context, exec bob.Executor, retrieved ListLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadList(ctx, exec, mods...)
+			},
+		),
+		Todos: thenLoadBuilder[Q](
+			"Todos",
+			func(ctx context.Context, exec bob.Executor, retrieved TodosLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodos(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadList loads the listStatus's List into the .R struct
+func (o *ListStatus) LoadList(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.List = nil
+
+	related, err := o.List(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ListStatuses = ListStatusSlice{o}
+
+	o.R.List = related
+	return nil
+}
+
+// LoadList loads the listStatus's List into the .R struct
+func (os ListStatusSlice) LoadList(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	lists, err := os.List(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range lists {
+
+			if !(o.ListID == rel.ID) {
+				continue
+			}
+
+			rel.R.ListStatuses = append(rel.R.ListStatuses, o)
+
+			o.R.List = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodos loads the listStatus's Todos into the .R struct
+func (o *ListStatus) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todos = nil
+
+	related, err := o.Todos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Status = o
+	}
+
+	o.R.Todos = related
+	return nil
+}
+
+// LoadTodos loads the listStatus's Todos into the .R struct
+func (os ListStatusSlice) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Todos = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !rel.StatusID.IsValue() {
+				continue
+			}
+			if !(rel.StatusID.IsValue() && o.ID == rel.StatusID.MustGet()) {
+				continue
+			}
+
+			rel.R.Status = o
+
+			o.R.Todos = append(o.R.Todos, rel)
+		}
+	}
+
+	return nil
+}
+
+type listStatusJoins[Q dialect.Joinable] struct {
+	typ   string
+	List  modAs[Q, listColumns]
+	Todos modAs[Q, todoColumns]
+}
+
+func (j listStatusJoins[Q]) aliasedAs(alias string) listStatusJoins[Q] {
+	return buildListStatusJoins[Q](buildListStatusColumns(alias), j.typ)
+}
+
+func buildListStatusJoins[Q dialect.Joinable](cols listStatusColumns, typ string) listStatusJoins[Q] {
+	return listStatusJoins[Q]{
+		typ: typ,
+		List: modAs[Q, listColumns]{
+			c: Lists.Columns,
+			f: func(to listColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Lists.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ListID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todos: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.StatusID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1265 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// List is an object representing the database table.
+type List struct {
+	ID        int64     `db:"id,pk" `
+	UserID    int64     `db:"user_id" `
+	Name      string    `db:"name" `
+	Archived  bool      `db:"archived" `
+	CreatedAt time.Time `db:"created_at" `
+	UpdatedAt time.Time `db:"updated_at" `
+
+	R listR `db:"-" `
+}
+
+// ListSlice is an alias for a slice of pointers to List.
+// This should almost always be used instead of []*List.
+type ListSlice []*List
+
+// Lists contains methods to work with the lists table
+var Lists = sqlite.NewTablex[*List, ListSlice, *ListSetter]("", "lists", buildListColumns("lists"))
+
+// ListsQuery is a query on the lists table
+type ListsQuery = *sqlite.ViewQuery[*List, ListSlice]
+
+// listR is where relationships are stored.
+type listR struct {
+	ListMembers  ListMemberSlice // fk_list_members_1
+	ListStatuses ListStatusSlice // fk_list_statuses_0
+	User         *User           // fk_lists_0
+	Todos        TodoSlice       // fk_todos_3
+}
+
+func buildListColumns(alias string) listColumns {
+	return listColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "name", "archived", "created_at", "updated_at",
+		).WithParent("lists"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		Name:       sqlite.Quote(alias, "name"),
+		Archived:   sqlite.Quote(alias, "archived"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		UpdatedAt:  sqlite.Quote(alias, "updated_at"),
+	}
+}
+
+type listColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	UserID     sqlite.Expression
+	Name       sqlite.Expression
+	Archived   sqlite.Expression
+	CreatedAt  sqlite.Expression
+	UpdatedAt  sqlite.Expression
+}
+
+func (c listColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (listColumns) AliasedAs(alias string) listColumns {
+	return buildListColumns(alias)
+}
+
+// ListSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ListSetter struct {
+	ID        omit.Val[int64]     `db:"id,pk" `
+	UserID    omit.Val[int64]     `db:"user_id" `
+	Name      omit.Val[string]    `db:"name" `
+	Archived  omit.Val[bool]      `db:"archived" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+	UpdatedAt omit.Val[time.Time] `db:"updated_at" `
+}
+
+func (s ListSetter) SetColumns() []string {
+	vals := make([]string, 0, 6)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.Archived.IsValue() {
+		vals = append(vals, "archived")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.UpdatedAt.IsValue() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s ListSetter) Overwrite(t *List) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.Archived.IsValue() {
+		t.Archived = s.Archived.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.UpdatedAt.IsValue() {
+		t.UpdatedAt = s.UpdatedAt.MustGet()
+	}
+}
+
+func (s *ListSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Lists.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 6)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if s.Name.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Name.MustGet()))
+		}
+
+		if s.Archived.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Archived.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.UpdatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UpdatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ListSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ListSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 6)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "name")...),
+			sqlite.Arg(s.Name),
+		}})
+	}
+
+	if s.Archived.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "archived")...),
+			sqlite.Arg(s.Archived),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.UpdatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "updated_at")...),
+			sqlite.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindList retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindList(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*List, error) {
+	if len(cols) == 0 {
+		return Lists.Query(
+			sm.Where(Lists.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Lists.Query(
+		sm.Where(Lists.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Lists.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ListExists checks the presence of a single record by primary key
+func ListExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Lists.Query(
+		sm.Where(Lists.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after List is retrieved from the database
+func (o *List) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Lists.AfterSelectHooks.RunHooks(ctx, exec, ListSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Lists.AfterInsertHooks.RunHooks(ctx, exec, ListSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Lists.AfterUpdateHooks.RunHooks(ctx, exec, ListSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Lists.AfterDeleteHooks.RunHooks(ctx, exec, ListSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the List
+func (o *List) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *List) pkEQ() dialect.Expression {
+	return sqlite.Quote("lists", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the List
+func (o *List) Update(ctx context.Context, exec bob.Executor, s *ListSetter) error {
+	v, err := Lists.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single List record with an executor
+func (o *List) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Lists.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the List using the executor
+func (o *List) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Lists.Query(
+		sm.Where(Lists.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ListSlice is retrieved from the database
+func (o ListSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Lists.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Lists.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Lists.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Lists.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ListSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("lists", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ListSlice) copyMatchingRows(from ...*List) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ListSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Lists.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *List:
+				o.copyMatchingRows(retrieved)
+			case []*List:
+				o.copyMatchingRows(retrieved...)
+			case ListSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a List or a slice of List
+				// then run the AfterUpdateHooks on the slice
+				_, err = Lists.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ListSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Lists.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *List:
+				o.copyMatchingRows(retrieved)
+			case []*List:
+				o.copyMatchingRows(retrieved...)
+			case ListSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a List or a slice of List
+				// then run the AfterDeleteHooks on the slice
+				_, err = Lists.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ListSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ListSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Lists.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ListSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Lists.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ListSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Lists.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ListMembers starts a query for related objects on list_members
+func (o *List) ListMembers(mods ...bob.Mod[*dialect.SelectQuery]) ListMembersQuery {
+	return ListMembers.Query(append(mods,
+		sm.Where(ListMembers.Columns.ListID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os ListSlice) ListMembers(mods ...bob.Mod[*dialect.SelectQuery]) ListMembersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return ListMembers.Query(append(mods,
+		sm.Where(sqlite.Group(ListMembers.Columns.ListID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ListStatuses starts a query for related objects on list_statuses
+func (o *List) ListStatuses(mods ...bob.Mod[*dialect.SelectQuery]) ListStatusesQuery {
+	return ListStatuses.Query(append(mods,
+		sm.Where(ListStatuses.Columns.ListID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os ListSlice) ListStatuses(mods ...bob.Mod[*dialect.SelectQuery]) ListStatusesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return ListStatuses.Query(append(mods,
+		sm.Where(sqlite.Group(ListStatuses.Columns.ListID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *List) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os ListSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todos starts a query for related objects on todos
+func (o *List) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ListID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os ListSlice) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ListID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertListListMembers0(ctx context.Context, exec bob.Executor, listMembers1 []*ListMemberSetter, list0 *List) (ListMemberSlice, error) {
+	for i := range listMembers1 {
+		listMembers1[i].ListID = omit.From(list0.ID)
+	}
+
+	ret, err := ListMembers.Insert(bob.ToMods(listMembers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertListListMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachListListMembers0(ctx context.Context, exec bob.Executor, count int, listMembers1 ListMemberSlice, list0 *List) (ListMemberSlice, error) {
+	setter := &ListMemberSetter{
+		ListID: omit.From(list0.ID),
+	}
+
+	err := listMembers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListListMembers0: %w", err)
+	}
+
+	return listMembers1, nil
+}
+
+func (list0 *List) InsertListMembers(ctx context.Context, exec bob.Executor, related ...*ListMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	listMembers1, err := insertListListMembers0(ctx, exec, related, list0)
+	if err != nil {
+		return err
+	}
+
+	list0.R.ListMembers = append(list0.R.ListMembers, listMembers1...)
+
+	for _, rel := range listMembers1 {
+		rel.R.List = list0
+	}
+	return nil
+}
+
+func (list0 *List) AttachListMembers(ctx context.Context, exec bob.Executor, related ...*ListMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	listMembers1 := ListMemberSlice(related)
+
+	_, err = attachListListMembers0(ctx, exec, len(related), listMembers1, list0)
+	if err != nil {
+		return err
+	}
+
+	list0.R.ListMembers = append(list0.R.ListMembers, listMembers1...)
+
+	for _, rel := range related {
+		rel.R.List = list0
+	}
+
+	return nil
+}
+
+func insertListListStatuses0(ctx context.Context, exec bob.Executor, listStatuses1 []*ListStatusSetter, list0 *List) (ListStatusSlice, error) {
+	for i := range listStatuses1 {
+		listStatuses1[i].ListID = omit.From(list0.ID)
+	}
+
+	ret, err := ListStatuses.Insert(bob.ToMods(listStatuses1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertListListStatuses0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachListListStatuses0(ctx context.Context, exec bob.Executor, count int, listStatuses1 ListStatusSlice, list0 *List) (ListStatusSlice, error) {
+	setter := &ListStatusSetter{
+		ListID: omit.From(list0.ID),
+	}
+
+	err := listStatuses1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListListStatuses0: %w", err)
+	}
+
+	return listStatuses1, nil
+}
+
+func (list0 *List) InsertListStatuses(ctx context.Context, exec bob.Executor, related ...*ListStatusSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	listStatuses1, err := insertListListStatuses0(ctx, exec, related, list0)
+	if err != nil {
+		return err
+	}
+
+	list0.R.ListStatuses = append(list0.R.ListStatuses, listStatuses1...)
+
+	for _, rel := range listStatuses1 {
+		rel.R.List = list0
+	}
+	return nil
+}
+
+func (list0 *List) AttachListStatuses(ctx context.Context, exec bob.Executor, related ...*ListStatus) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	listStatuses1 := ListStatusSlice(related)
+
+	_, err = attachListListStatuses0(ctx, exec, len(related), listStatuses1, list0)
+	if err != nil {
+		return err
+	}
+
+	list0.R.ListStatuses = append(list0.R.ListStatuses, listStatuses1...)
+
+	for _, rel := range related {
+		rel.R.List = list0
+	}
+
+	return nil
+}
+
+func attachListUser0(ctx context.Context, exec bob.Executor, count int, list0 *List, user1 *User) (*List, error) {
+	setter := &ListSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := list0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListUser0: %w", err)
+	}
+
+	return list0, nil
+}
+
+func (list0 *List) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachListUser0(ctx, exec, 1, list0, user1)
+	if err != nil {
+		return err
+	}
+
+	list0.R.User = user1
+
+	user1.R.Lists = append(user1.R.Lists, list0)
+
+	return nil
+}
+
+func (list0 *List) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachListUser0(ctx, exec, 1, list0, user1)
+	if err != nil {
+		return err
+	}
+
+	list0.R.User = user1
+
+	user1.R.Lists = append(user1.R.Lists, list0)
+
+	return nil
+}
+
+func insertListTodos0(ctx context.Context, exec bob.Executor, todos1 []*TodoSetter, list0 *List) (TodoSlice, error) {
+	for i := range todos1 {
+		todos1[i].ListID = omitnull.From(list0.ID)
+	}
+
+	ret, err := Todos.Insert(bob.ToMods(todos1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertListTodos0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachListTodos0(ctx context.Context, exec bob.Executor, count int, todos1 TodoSlice, list0 *List) (TodoSlice, error) {
+	setter := &TodoSetter{
+		ListID: omitnull.From(list0.ID),
+	}
+
+	err := todos1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachListTodos0: %w", err)
+	}
+
+	return todos1, nil
+}
+
+func (list0 *List) InsertTodos(ctx context.Context, exec bob.Executor, related ...*TodoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todos1, err := insertListTodos0(ctx, exec, related, list0)
+	if err != nil {
+		return err
+	}
+
+	list0.R.Todos = append(list0.R.Todos, todos1...)
+
+	for _, rel := range todos1 {
+		rel.R.List = list0
+	}
+	return nil
+}
+
+func (list0 *List) AttachTodos(ctx context.Context, exec bob.Executor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todos1 := TodoSlice(related)
+
+	_, err = attachListTodos0(ctx, exec, len(related), todos1, list0)
+	if err != nil {
+		return err
+	}
+
+	list0.R.Todos = append(list0.R.Todos, todos1...)
+
+	for _, rel := range related {
+		rel.R.List = list0
+	}
+
+	return nil
+}
+
+type listWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereMod[Q, int64]
+	Name      sqlite.WhereMod[Q, string]
+	Archived  sqlite.WhereMod[Q, bool]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	UpdatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (listWhere[Q]) AliasedAs(alias string) listWhere[Q] {
+	return buildListWhere[Q](buildListColumns(alias))
+}
+
+func buildListWhere[Q sqlite.Filterable](cols listColumns) listWhere[Q] {
+	return listWhere[Q]{
+		ID:        sqlite.Where[Q, int64](cols.ID),
+		UserID:    sqlite.Where[Q, int64](cols.UserID),
+		Name:      sqlite.Where[Q, string](cols.Name),
+		Archived:  sqlite.Where[Q, bool](cols.Archived),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt: sqlite.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *List) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ListMembers":
+		rels, ok := retrieved.(ListMemberSlice)
+		if !ok {
+			return fmt.Errorf("list cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ListMembers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.List = o
+			}
+		}
+		return nil
+	case "ListStatuses":
+		rels, ok := retrieved.(ListStatusSlice)
+		if !ok {
+			return fmt.Errorf("list cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ListStatuses = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.List = o
+			}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("list cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Lists = ListSlice{o}
+		}
+		return nil
+	case "Todos":
+		rels, ok := retrieved.(TodoSlice)
+		if !ok {
+			return fmt.Errorf("list cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todos = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.List = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("list has no relationship %q", name)
+	}
+}
+
+type listPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildListPreloader() listPreloader {
+	return listPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Lists,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type listThenLoader[Q orm.Loadable] struct {
+	ListMembers  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ListStatuses func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todos        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildListThenLoader[Q orm.Loadable]() listThenLoader[Q] {
+	type ListMembersLoadInterface interface {
+		LoadListMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ListStatusesLoadInterface interface {
+		LoadListStatuses(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodosLoadInterface interface {
+		LoadTodos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return listThenLoader[Q]{
+		ListMembers: thenLoadBuilder[Q](
+			"ListMembers",
+			func(ctx context.Context, exec bob.Executor, retrieved ListMembersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadListMembers(ctx, exec, mods...)
+			},
+		),
+		ListStatuses: thenLoadBuilder[Q](
+			"ListStatuses",
+			func(ctx context.Context, exec bob.Executor, retrieved ListStatusesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadListStatuses(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		Todos: thenLoadBuilder[Q](
+			"Todos",
+			func(ctx context.Context, exec bob.Executor, retrieved TodosLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodos(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadListMembers loads the list's ListMembers into the .R struct
+func (o *List) LoadListMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ListMembers = nil
+
+	related, err := o.ListMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.List = o
+	}
+
+	o.R.ListMembers = related
+	return nil
+}
+
+// LoadListMembers loads the list's ListMembers into the .R struct
+func (os ListSlice) LoadListMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	listMembers, err := os.ListMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ListMembers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range listMembers {
+
+			if !(o.ID == rel.ListID) {
+				continue
+			}
+
+			rel.R.List = o
+
+			o.R.ListMembers = append(o.R.ListMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadListStatuses loads the list's ListStatuses into the .R struct
+func (o *List) LoadListStatuses(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ListStatuses = nil
+
+	related, err := o.ListStatuses(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.List = o
+	}
+
+	o.R.ListStatuses = related
+	return nil
+}
+
+// LoadListStatuses loads the list's ListStatuses into the .R struct
+func (os ListSlice) LoadListStatuses(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	listStatuses, err := os.ListStatuses(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ListStatuses = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range listStatuses {
+
+			if !(o.ID == rel.ListID) {
+				continue
+			}
+
+			rel.R.List = o
+
+			o.R.ListStatuses = append(o.R.ListStatuses, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the list's User into the .R struct
+func (o *List) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Lists = ListSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the list's User into the .R struct
+func (os ListSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Lists = append(rel.R.Lists, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodos loads the list's Todos into the .R struct
+func (o *List) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todos = nil
+
+	related, err := o.Todos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.List = o
+	}
+
+	o.R.Todos = related
+	return nil
+}
+
+// LoadTodos loads the list's Todos into the .R struct
+func (os ListSlice) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Todos = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !rel.ListID.IsValue() {
+				continue
+			}
+			if !(rel.ListID.IsValue() && o.ID == rel.ListID.MustGet()) {
+				continue
+			}
+
+			rel.R.List = o
+
+			o.R.Todos = append(o.R.Todos, rel)
+		}
+	}
+
+	return nil
+}
+
+type listJoins[Q dialect.Joinable] struct {
+	typ          string
+	ListMembers  modAs[Q, listMemberColumns]
+	ListStatuses modAs[Q, listStatusColumns]
+	User         modAs[Q, userColumns]
+	Todos        modAs[Q, todoColumns]
+}
+
+func (j listJoins[Q]) aliasedAs(alias string) listJoins[Q] {
+	return buildListJoins[Q](buildListColumns(alias), j.typ)
+}
+
+func buildListJoins[Q dialect.Joinable](cols listColumns, typ string) listJoins[Q] {
+	return listJoins[Q]{
+		typ: typ,
+		ListMembers: modAs[Q, listMemberColumns]{
+			c: ListMembers.Columns,
+			f: func(to listMemberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ListMembers.Name().As(to.Alias())).On(
+						to.ListID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ListStatuses: modAs[Q, listStatusColumns]{
+			c: ListStatuses.Columns,
+			f: func(to listStatusColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ListStatuses.Name().As(to.Alias())).On(
+						to.ListID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todos: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ListID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1261 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// Notification is an object representing the database table.
+type Notification struct {
+	ID        int64               `db:"id,pk" `
+	UserID    int64               `db:"user_id" `
+	ActorID   int64               `db:"actor_id" `
+	Kind      string              `db:"kind" `
+	TodoID    int64               `db:"todo_id" `
+	CommentID null.Val[int64]     `db:"comment_id" `
+	ReadAt    null.Val[time.Time] `db:"read_at" `
+	CreatedAt time.Time           `db:"created_at" `
+
+	R notificationR `db:"-" `
+}
+
+// NotificationSlice is an alias for a slice of pointers to Notification.
+// This should almost always be used instead of []*Notification.
+type NotificationSlice []*Notification
+
+// Notifications contains methods to work with the notifications table
+var Notifications = sqlite.NewTablex[*Notification, NotificationSlice, *NotificationSetter]("", "notifications", buildNotificationColumns("notifications"))
+
+// NotificationsQuery is a query on the notifications table
+type NotificationsQuery = *sqlite.ViewQuery[*Notification, NotificationSlice]
+
+// notificationR is where relationships are stored.
+type notificationR struct {
+	Comment *Comment // fk_notifications_0
+	Todo    *Todo    // fk_notifications_1
+	Actor   *User    // fk_notifications_2
+	User    *User    // fk_notifications_3
+}
+
+func buildNotificationColumns(alias string) notificationColumns {
+	return notificationColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "actor_id", "kind", "todo_id", "comment_id", "read_at", "created_at",
+		).WithParent("notifications"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		ActorID:    sqlite.Quote(alias, "actor_id"),
+		Kind:       sqlite.Quote(alias, "kind"),
+		TodoID:     sqlite.Quote(alias, "todo_id"),
+		CommentID:  sqlite.Quote(alias, "comment_id"),
+		ReadAt:     sqlite.Quote(alias, "read_at"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type notificationColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	UserID     sqlite.Expression
+	ActorID    sqlite.Expression
+	Kind       sqlite.Expression
+	TodoID     sqlite.Expression
+	CommentID  sqlite.Expression
+	ReadAt     sqlite.Expression
+	CreatedAt  sqlite.Expression
+}
+
+func (c notificationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (notificationColumns) AliasedAs(alias string) notificationColumns {
+	return buildNotificationColumns(alias)
+}
+
+// NotificationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type NotificationSetter struct {
+	ID        omit.Val[int64]         `db:"id,pk" `
+	UserID    omit.Val[int64]         `db:"user_id" `
+	ActorID   omit.Val[int64]         `db:"actor_id" `
+	Kind      omit.Val[string]        `db:"kind" `
+	TodoID    omit.Val[int64]         `db:"todo_id" `
+	CommentID omitnull.Val[int64]     `db:"comment_id" `
+	ReadAt    omitnull.Val[time.Time] `db:"read_at" `
+	CreatedAt omit.Val[time.Time]     `db:"created_at" `
+}
+
+func (s NotificationSetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.ActorID.IsValue() {
+		vals = append(vals, "actor_id")
+	}
+	if s.Kind.IsValue() {
+		vals = append(vals, "kind")
+	}
+	if s.TodoID.IsValue() {
+		vals = append(vals, "todo_id")
+	}
+	if !s.CommentID.IsUnset() {
+		vals = append(vals, "comment_id")
+	}
+	if !s.ReadAt.IsUnset() {
+		vals = append(vals, "read_at")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s NotificationSetter) Overwrite(t *Notification) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.ActorID.IsValue() {
+		t.ActorID = s.ActorID.MustGet()
+	}
+	if s.Kind.IsValue() {
+		t.Kind = s.Kind.MustGet()
+	}
+	if s.TodoID.IsValue() {
+		t.TodoID = s.TodoID.MustGet()
+	}
+	if !s.CommentID.IsUnset() {
+		t.CommentID = s.CommentID.MustGetNull()
+	}
+	if !s.ReadAt.IsUnset() {
+		t.ReadAt = s.ReadAt.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *NotificationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Notifications.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 8)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if s.ActorID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ActorID.MustGet()))
+		}
+
+		if s.Kind.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Kind.MustGet()))
+		}
+
+		if s.TodoID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoID.MustGet()))
+		}
+
+		if !s.CommentID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.CommentID.MustGetNull()))
+		}
+
+		if !s.ReadAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.ReadAt.MustGetNull()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s NotificationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s NotificationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.ActorID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "actor_id")...),
+			sqlite.Arg(s.ActorID),
+		}})
+	}
+
+	if s.Kind.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "kind")...),
+			sqlite.Arg(s.Kind),
+		}})
+	}
+
+	if s.TodoID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_id")...),
+			sqlite.Arg(s.TodoID),
+		}})
+	}
+
+	if !s.CommentID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "comment_id")...),
+			sqlite.Arg(s.CommentID),
+		}})
+	}
+
+	if !s.ReadAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "read_at")...),
+			sqlite.Arg(s.ReadAt),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindNotification retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindNotification(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Notification, error) {
+	if len(cols) == 0 {
+		return Notifications.Query(
+			sm.Where(Notifications.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Notifications.Query(
+		sm.Where(Notifications.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Notifications.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// NotificationExists checks the presence of a single record by primary key
+func NotificationExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Notifications.Query(
+		sm.Where(Notifications.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Notification is retrieved from the database
+func (o *Notification) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Notifications.AfterSelectHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Notifications.AfterInsertHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Notifications.AfterUpdateHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Notifications.AfterDeleteHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Notification
+func (o *Notification) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *Notification) pkEQ() dialect.Expression {
+	return sqlite.Quote("notifications", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Notification
+func (o *Notification) Update(ctx context.Context, exec bob.Executor, s *NotificationSetter) error {
+	v, err := Notifications.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Notification record with an executor
+func (o *Notification) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Notifications.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Notification using the executor
+func (o *Notification) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Notifications.Query(
+		sm.Where(Notifications.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after NotificationSlice is retrieved from the database
+func (o NotificationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Notifications.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Notifications.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Notifications.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Notifications.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o NotificationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("notifications", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o NotificationSlice) copyMatchingRows(from ...*Notification) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o NotificationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Notifications.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Notification:
+				o.copyMatchingRows(retrieved)
+			case []*Notification:
+				o.copyMatchingRows(retrieved...)
+			case NotificationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Notification or a slice of Notification
+				// then run the AfterUpdateHooks on the slice
+				_, err = Notifications.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o NotificationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Notifications.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Notification:
+				o.copyMatchingRows(retrieved)
+			case []*Notification:
+				o.copyMatchingRows(retrieved...)
+			case NotificationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Notification or a slice of Notification
+				// then run the AfterDeleteHooks on the slice
+				_, err = Notifications.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o NotificationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals NotificationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Notifications.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o NotificationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Notifications.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o NotificationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Notifications.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Comment starts a query for related objects on comments
+func (o *Notification) Comment(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	return Comments.Query(append(mods,
+		sm.Where(Comments.Columns.ID.EQ(sqlite.Arg(o.CommentID))),
+	)...)
+}
+
+func (os NotificationSlice) Comment(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.CommentID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Comments.Query(append(mods,
+		sm.Where(sqlite.Group(Comments.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todo starts a query for related objects on todos
+func (o *Notification) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.TodoID))),
+	)...)
+}
+
+func (os NotificationSlice) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TodoID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Actor starts a query for related objects on users
+func (o *Notification) Actor(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.ActorID))),
+	)...)
+}
+
+func (os NotificationSlice) Actor(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ActorID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Notification) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os NotificationSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachNotificationComment0(ctx context.Context, exec bob.Executor, count int, notification0 *Notification, comment1 *Comment) (*Notification, error) {
+	setter := &NotificationSetter{
+		CommentID: omitnull.From(comment1.ID),
+	}
+
+	err := notification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachNotificationComment0: %w", err)
+	}
+
+	return notification0, nil
+}
+
+func (notification0 *Notification) InsertComment(ctx context.Context, exec bob.Executor, related *CommentSetter) error {
+	var err error
+
+	comment1, err := Comments.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachNotificationComment0(ctx, exec, 1, notification0, comment1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Comment = comment1
+
+	comment1.R.Notifications = append(comment1.R.Notifications, notification0)
+
+	return nil
+}
+
+func (notification0 *Notification) AttachComment(ctx context.Context, exec bob.Executor, comment1 *Comment) error {
+	var err error
+
+	_, err = attachNotificationComment0(ctx, exec, 1, notification0, comment1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Comment = comment1
+
+	comment1.R.Notifications = append(comment1.R.Notifications, notification0)
+
+	return nil
+}
+
+func attachNotificationTodo0(ctx context.Context, exec bob.Executor, count int, notification0 *Notification, todo1 *Todo) (*Notification, error) {
+	setter := &NotificationSetter{
+		TodoID: omit.From(todo1.ID),
+	}
+
+	err := notification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachNotificationTodo0: %w", err)
+	}
+
+	return notification0, nil
+}
+
+func (notification0 *Notification) InsertTodo(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachNotificationTodo0(ctx, exec, 1, notification0, todo1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Todo = todo1
+
+	todo1.R.Notifications = append(todo1.R.Notifications, notification0)
+
+	return nil
+}
+
+func (notification0 *Notification) AttachTodo(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachNotificationTodo0(ctx, exec, 1, notification0, todo1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Todo = todo1
+
+	todo1.R.Notifications = append(todo1.R.Notifications, notification0)
+
+	return nil
+}
+
+func attachNotificationActor0(ctx context.Context, exec bob.Executor, count int, notification0 *Notification, user1 *User) (*Notification, error) {
+	setter := &NotificationSetter{
+		ActorID: omit.From(user1.ID),
+	}
+
+	err := notification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachNotificationActor0: %w", err)
+	}
+
+	return notification0, nil
+}
+
+func (notification0 *Notification) InsertActor(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachNotificationActor0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Actor = user1
+
+	user1.R.ActorNotifications = append(user1.R.ActorNotifications, notification0)
+
+	return nil
+}
+
+func (notification0 *Notification) AttachActor(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachNotificationActor0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Actor = user1
+
+	user1.R.ActorNotifications = append(user1.R.ActorNotifications, notification0)
+
+	return nil
+}
+
+func attachNotificationUser0(ctx context.Context, exec bob.Executor, count int, notification0 *Notification, user1 *User) (*Notification, error) {
+	setter := &NotificationSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := notification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachNotificationUser0: %w", err)
+	}
+
+	return notification0, nil
+}
+
+func (notification0 *Notification) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachNotificationUser0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.User = user1
+
+	user1.R.Notifications = append(user1.R.Notifications, notification0)
+
+	return nil
+}
+
+func (notification0 *Notification) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachNotificationUser0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.User = user1
+
+	user1.R.Notifications = append(user1.R.Notifications, notification0)
+
+	return nil
+}
+
+type notificationWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereMod[Q, int64]
+	ActorID   sqlite.WhereMod[Q, int64]
+	Kind      sqlite.WhereMod[Q, string]
+	TodoID    sqlite.WhereMod[Q, int64]
+	CommentID sqlite.WhereNullMod[Q, int64]
+	ReadAt    sqlite.WhereNullMod[Q, time.Time]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (notificationWhere[Q]) AliasedAs(alias string) notificationWhere[Q] {
+	return buildNotificationWhere[Q](buildNotificationColumns(alias))
+}
+
+func buildNotificationWhere[Q sqlite.Filterable](cols notificationColumns) notificationWhere[Q] {
+	return notificationWhere[Q]{
+		ID:        sqlite.Where[Q, int64](cols.ID),
+		UserID:    sqlite.Where[Q, int64](cols.UserID),
+		ActorID:   sqlite.Where[Q, int64](cols.ActorID),
+		Kind:      sqlite.Where[Q, string](cols.Kind),
+		TodoID:    sqlite.Where[Q, int64](cols.TodoID),
+		CommentID: sqlite.WhereNull[Q, int64](cols.CommentID),
+		ReadAt:    sqlite.WhereNull[Q, time.Time](cols.ReadAt),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Notification) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Comment":
+		rel, ok := retrieved.(*Comment)
+		if !ok {
+			return fmt.Errorf("notification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Comment = rel
+
+		if rel != nil {
+			rel.R.Notifications = NotificationSlice{o}
+		}
+		return nil
+	case "Todo":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("notification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todo = rel
+
+		if rel != nil {
+			rel.R.Notifications = NotificationSlice{o}
+		}
+		return nil
+	case "Actor":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("notification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Actor = rel
+
+		if rel != nil {
+			rel.R.ActorNotifications = NotificationSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("notification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Notifications = NotificationSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("notification has no relationship %q", name)
+	}
+}
+
+type notificationPreloader struct {
+	Comment func(...sqlite.PreloadOption) sqlite.Preloader
+	Todo    func(...sqlite.PreloadOption) sqlite.Preloader
+	Actor   func(...sqlite.PreloadOption) sqlite.Preloader
+	User    func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildNotificationPreloader() notificationPreloader {
+	return notificationPreloader{
+		Comment: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Comment, CommentSlice](sqlite.PreloadRel{
+				Name: "Comment",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Notifications,
+						To:          Comments,
+						FromColumns: []string{"comment_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Comments.Columns.Names(), opts...)
+		},
+		Todo: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Todo",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Notifications,
+						To:          Todos,
+						FromColumns: []string{"todo_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+		Actor: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "Actor",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Notifications,
+						To:          Users,
+						FromColumns: []string{"actor_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Notifications,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type notificationThenLoader[Q orm.Loadable] struct {
+	Comment func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todo    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Actor   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildNotificationThenLoader[Q orm.Loadable]() notificationThenLoader[Q] {
+	type CommentLoadInterface interface {
+		LoadComment(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoLoadInterface interface {
+		LoadTodo(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ActorLoadInterface interface {
+		LoadActor(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return notificationThenLoader[Q]{
+		Comment: thenLoadBuilder[Q](
+			"Comment",
+			func(ctx context.Context, exec bob.Executor, retrieved CommentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadComment(ctx, exec, mods...)
+			},
+		),
+		Todo: thenLoadBuilder[Q](
+			"Todo",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodo(ctx, exec, mods...)
+			},
+		),
+		Actor: thenLoadBuilder[Q](
+			"Actor",
+			func(ctx context.Context, exec bob.Executor, retrieved ActorLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadActor(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadComment loads the notification's Comment into the .R struct
+func (o *Notification) LoadComment(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Comment = nil
+
+	related, err := o.Comment(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Notifications = NotificationSlice{o}
+
+	o.R.Comment = related
+	return nil
+}
+
+// LoadComment loads the notification's Comment into the .R struct
+func (os NotificationSlice) LoadComment(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	comments, err := os.Comment(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range comments {
+			if !o.CommentID.IsValue() {
+				continue
+			}
+
+			if !(o.CommentID.IsValue() && o.CommentID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.Notifications = append(rel.R.Notifications, o)
+
+			o.R.Comment = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo loads the notification's Todo into the .R struct
+func (o *Notification) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todo = nil
+
+	related, err := o.Todo(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Notifications = NotificationSlice{o}
+
+	o.R.Todo = related
+	return nil
+}
+
+// LoadTodo loads the notification's Todo into the .R struct
+func (os NotificationSlice) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todo(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.TodoID == rel.ID) {
+				continue
+			}
+
+			rel.R.Notifications = append(rel.R.Notifications, o)
+
+			o.R.Todo = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadActor loads the notification's Actor into the .R struct
+func (o *Notification) LoadActor(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Actor = nil
+
+	related, err := o.Actor(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ActorNotifications = NotificationSlice{o}
+
+	o.R.Actor = related
+	return nil
+}
+
+// LoadActor loads the notification's Actor into the .R struct
+func (os NotificationSlice) LoadActor(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.Actor(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.ActorID == rel.ID) {
+				continue
+			}
+
+			rel.R.ActorNotifications = append(rel.R.ActorNotifications, o)
+
+			o.R.Actor = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the notification's User into the .R struct
+func (o *Notification) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Notifications = NotificationSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the notification's User into the .R struct
+func (os NotificationSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Notifications = append(rel.R.Notifications, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type notificationJoins[Q dialect.Joinable] struct {
+	typ     string
+	Comment modAs[Q, commentColumns]
+	Todo    modAs[Q, todoColumns]
+	Actor   modAs[Q, userColumns]
+	User    modAs[Q, userColumns]
+}
+
+func (j notificationJoins[Q]) aliasedAs(alias string) notificationJoins[Q] {
+	return buildNotificationJoins[Q](buildNotificationColumns(alias), j.typ)
+}
+
+func buildNotificationJoins[Q dialect.Joinable](cols notificationColumns, typ string) notificationJoins[Q] {
+	return notificationJoins[Q]{
+		typ: typ,
+		Comment: modAs[Q, commentColumns]{
+			c: Comments.Columns,
+			f: func(to commentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Comments.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.CommentID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todo: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Actor: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ActorID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
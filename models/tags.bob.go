@@ -0,0 +1,917 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/scan"
+)
+
+// Tag is an object representing the database table.
+type Tag struct {
+	ID        int64     `db:"id,pk" `
+	UserID    int64     `db:"user_id" `
+	Name      string    `db:"name" `
+	Color     string    `db:"color" `
+	CreatedAt time.Time `db:"created_at" `
+	UpdatedAt time.Time `db:"updated_at" `
+
+	R tagR `db:"-" `
+}
+
+// TagSlice is an alias for a slice of pointers to Tag.
+// This should almost always be used instead of []*Tag.
+type TagSlice []*Tag
+
+// Tags contains methods to work with the tags table
+var Tags = sqlite.NewTablex[*Tag, TagSlice, *TagSetter]("", "tags", buildTagColumns("tags"))
+
+// TagsQuery is a query on the tags table
+type TagsQuery = *sqlite.ViewQuery[*Tag, TagSlice]
+
+// tagR is where relationships are stored.
+type tagR struct {
+	User  *User     // fk_tags_0
+	Todos TodoSlice // fk_todo_tags_0fk_todo_tags_1
+}
+
+func buildTagColumns(alias string) tagColumns {
+	return tagColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "name", "color", "created_at", "updated_at",
+		).WithParent("tags"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		Name:       sqlite.Quote(alias, "name"),
+		Color:      sqlite.Quote(alias, "color"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+		UpdatedAt:  sqlite.Quote(alias, "updated_at"),
+	}
+}
+
+type tagColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	UserID     sqlite.Expression
+	Name       sqlite.Expression
+	Color      sqlite.Expression
+	CreatedAt  sqlite.Expression
+	UpdatedAt  sqlite.Expression
+}
+
+func (c tagColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (tagColumns) AliasedAs(alias string) tagColumns {
+	return buildTagColumns(alias)
+}
+
+// TagSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TagSetter struct {
+	ID        omit.Val[int64]     `db:"id,pk" `
+	UserID    omit.Val[int64]     `db:"user_id" `
+	Name      omit.Val[string]    `db:"name" `
+	Color     omit.Val[string]    `db:"color" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+	UpdatedAt omit.Val[time.Time] `db:"updated_at" `
+}
+
+func (s TagSetter) SetColumns() []string {
+	vals := make([]string, 0, 6)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.Name.IsValue() {
+		vals = append(vals, "name")
+	}
+	if s.Color.IsValue() {
+		vals = append(vals, "color")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	if s.UpdatedAt.IsValue() {
+		vals = append(vals, "updated_at")
+	}
+	return vals
+}
+
+func (s TagSetter) Overwrite(t *Tag) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.Name.IsValue() {
+		t.Name = s.Name.MustGet()
+	}
+	if s.Color.IsValue() {
+		t.Color = s.Color.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+	if s.UpdatedAt.IsValue() {
+		t.UpdatedAt = s.UpdatedAt.MustGet()
+	}
+}
+
+func (s *TagSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Tags.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 6)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if s.Name.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Name.MustGet()))
+		}
+
+		if s.Color.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Color.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if s.UpdatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UpdatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TagSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TagSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 6)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.Name.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "name")...),
+			sqlite.Arg(s.Name),
+		}})
+	}
+
+	if s.Color.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "color")...),
+			sqlite.Arg(s.Color),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.UpdatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "updated_at")...),
+			sqlite.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTag retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTag(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*Tag, error) {
+	if len(cols) == 0 {
+		return Tags.Query(
+			sm.Where(Tags.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Tags.Query(
+		sm.Where(Tags.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(Tags.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TagExists checks the presence of a single record by primary key
+func TagExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return Tags.Query(
+		sm.Where(Tags.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Tag is retrieved from the database
+func (o *Tag) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Tags.AfterSelectHooks.RunHooks(ctx, exec, TagSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Tags.AfterInsertHooks.RunHooks(ctx, exec, TagSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Tags.AfterUpdateHooks.RunHooks(ctx, exec, TagSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Tags.AfterDeleteHooks.RunHooks(ctx, exec, TagSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Tag
+func (o *Tag) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *Tag) pkEQ() dialect.Expression {
+	return sqlite.Quote("tags", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Tag
+func (o *Tag) Update(ctx context.Context, exec bob.Executor, s *TagSetter) error {
+	v, err := Tags.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Tag record with an executor
+func (o *Tag) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Tags.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Tag using the executor
+func (o *Tag) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Tags.Query(
+		sm.Where(Tags.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TagSlice is retrieved from the database
+func (o TagSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Tags.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Tags.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Tags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Tags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TagSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("tags", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TagSlice) copyMatchingRows(from ...*Tag) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TagSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Tags.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Tag:
+				o.copyMatchingRows(retrieved)
+			case []*Tag:
+				o.copyMatchingRows(retrieved...)
+			case TagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Tag or a slice of Tag
+				// then run the AfterUpdateHooks on the slice
+				_, err = Tags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TagSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Tags.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Tag:
+				o.copyMatchingRows(retrieved)
+			case []*Tag:
+				o.copyMatchingRows(retrieved...)
+			case TagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Tag or a slice of Tag
+				// then run the AfterDeleteHooks on the slice
+				_, err = Tags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TagSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TagSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Tags.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TagSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Tags.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TagSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Tags.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *Tag) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os TagSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todos starts a query for related objects on todos
+func (o *Tag) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.InnerJoin(TodoTags.NameAs()).On(
+			Todos.Columns.ID.EQ(TodoTags.Columns.TodoID)),
+		sm.Where(TodoTags.Columns.TagID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TagSlice) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.InnerJoin(TodoTags.NameAs()).On(
+			Todos.Columns.ID.EQ(TodoTags.Columns.TodoID),
+		),
+		sm.Where(sqlite.Group(TodoTags.Columns.TagID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachTagUser0(ctx context.Context, exec bob.Executor, count int, tag0 *Tag, user1 *User) (*Tag, error) {
+	setter := &TagSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := tag0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTagUser0: %w", err)
+	}
+
+	return tag0, nil
+}
+
+func (tag0 *Tag) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTagUser0(ctx, exec, 1, tag0, user1)
+	if err != nil {
+		return err
+	}
+
+	tag0.R.User = user1
+
+	user1.R.Tags = append(user1.R.Tags, tag0)
+
+	return nil
+}
+
+func (tag0 *Tag) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachTagUser0(ctx, exec, 1, tag0, user1)
+	if err != nil {
+		return err
+	}
+
+	tag0.R.User = user1
+
+	user1.R.Tags = append(user1.R.Tags, tag0)
+
+	return nil
+}
+
+func attachTagTodos0(ctx context.Context, exec bob.Executor, count int, tag0 *Tag, todos2 TodoSlice) (TodoTagSlice, error) {
+	setters := make([]*TodoTagSetter, count)
+	for i := range count {
+		setters[i] = &TodoTagSetter{
+			TagID:  omit.From(tag0.ID),
+			TodoID: omit.From(todos2[i].ID),
+		}
+	}
+
+	todoTags1, err := TodoTags.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachTagTodos0: %w", err)
+	}
+
+	return todoTags1, nil
+}
+
+func (tag0 *Tag) InsertTodos(ctx context.Context, exec bob.Executor, related ...*TodoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Todos.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	todos2 := TodoSlice(inserted)
+
+	_, err = attachTagTodos0(ctx, exec, len(related), tag0, todos2)
+	if err != nil {
+		return err
+	}
+
+	tag0.R.Todos = append(tag0.R.Todos, todos2...)
+
+	for _, rel := range todos2 {
+		rel.R.Tags = append(rel.R.Tags, tag0)
+	}
+	return nil
+}
+
+func (tag0 *Tag) AttachTodos(ctx context.Context, exec bob.Executor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todos2 := TodoSlice(related)
+
+	_, err = attachTagTodos0(ctx, exec, len(related), tag0, todos2)
+	if err != nil {
+		return err
+	}
+
+	tag0.R.Todos = append(tag0.R.Todos, todos2...)
+
+	for _, rel := range related {
+		rel.R.Tags = append(rel.R.Tags, tag0)
+	}
+
+	return nil
+}
+
+type tagWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereMod[Q, int64]
+	Name      sqlite.WhereMod[Q, string]
+	Color     sqlite.WhereMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+	UpdatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (tagWhere[Q]) AliasedAs(alias string) tagWhere[Q] {
+	return buildTagWhere[Q](buildTagColumns(alias))
+}
+
+func buildTagWhere[Q sqlite.Filterable](cols tagColumns) tagWhere[Q] {
+	return tagWhere[Q]{
+		ID:        sqlite.Where[Q, int64](cols.ID),
+		UserID:    sqlite.Where[Q, int64](cols.UserID),
+		Name:      sqlite.Where[Q, string](cols.Name),
+		Color:     sqlite.Where[Q, string](cols.Color),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt: sqlite.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+func (o *Tag) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("tag cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Tags = TagSlice{o}
+		}
+		return nil
+	case "Todos":
+		rels, ok := retrieved.(TodoSlice)
+		if !ok {
+			return fmt.Errorf("tag cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todos = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Tags = TagSlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("tag has no relationship %q", name)
+	}
+}
+
+type tagPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildTagPreloader() tagPreloader {
+	return tagPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Tags,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type tagThenLoader[Q orm.Loadable] struct {
+	User  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todos func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTagThenLoader[Q orm.Loadable]() tagThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodosLoadInterface interface {
+		LoadTodos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return tagThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		Todos: thenLoadBuilder[Q](
+			"Todos",
+			func(ctx context.Context, exec bob.Executor, retrieved TodosLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodos(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the tag's User into the .R struct
+func (o *Tag) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Tags = TagSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the tag's User into the .R struct
+func (os TagSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Tags = append(rel.R.Tags, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodos loads the tag's Todos into the .R struct
+func (o *Tag) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todos = nil
+
+	related, err := o.Todos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Tags = TagSlice{o}
+	}
+
+	o.R.Todos = related
+	return nil
+}
+
+// LoadTodos loads the tag's Todos into the .R struct
+func (os TagSlice) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Todos.Columns))
+	}
+
+	q := os.Todos(append(
+		mods,
+		sm.Columns(TodoTags.Columns.TagID.As("related_tags.ID")),
+	)...)
+
+	IDSlice := []int64{}
+
+	mapper := scan.Mod(scan.StructMapper[*Todo](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int64))
+				row.ScheduleScanByName("related_tags.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	todos, err := bob.Allx[bob.SliceTransformer[*Todo, TodoSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Todos = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range todos {
+			if !(o.ID == IDSlice[i]) {
+				continue
+			}
+
+			rel.R.Tags = append(rel.R.Tags, o)
+
+			o.R.Todos = append(o.R.Todos, rel)
+		}
+	}
+
+	return nil
+}
+
+type tagJoins[Q dialect.Joinable] struct {
+	typ   string
+	User  modAs[Q, userColumns]
+	Todos modAs[Q, todoColumns]
+}
+
+func (j tagJoins[Q]) aliasedAs(alias string) tagJoins[Q] {
+	return buildTagJoins[Q](buildTagColumns(alias), j.typ)
+}
+
+func buildTagJoins[Q dialect.Joinable](cols tagColumns, typ string) tagJoins[Q] {
+	return tagJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todos: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := TodoTags.Columns.AliasedAs(TodoTags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, TodoTags.Name().As(to.Alias())).On(
+						to.TagID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := TodoTags.Columns.AliasedAs(TodoTags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,847 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// TimeEntry is an object representing the database table.
+type TimeEntry struct {
+	ID        int64               `db:"id,pk" `
+	TodoID    int64               `db:"todo_id" `
+	UserID    int64               `db:"user_id" `
+	StartedAt time.Time           `db:"started_at" `
+	EndedAt   null.Val[time.Time] `db:"ended_at" `
+
+	R timeEntryR `db:"-" `
+}
+
+// TimeEntrySlice is an alias for a slice of pointers to TimeEntry.
+// This should almost always be used instead of []*TimeEntry.
+type TimeEntrySlice []*TimeEntry
+
+// TimeEntries contains methods to work with the time_entries table
+var TimeEntries = sqlite.NewTablex[*TimeEntry, TimeEntrySlice, *TimeEntrySetter]("", "time_entries", buildTimeEntryColumns("time_entries"))
+
+// TimeEntriesQuery is a query on the time_entries table
+type TimeEntriesQuery = *sqlite.ViewQuery[*TimeEntry, TimeEntrySlice]
+
+// timeEntryR is where relationships are stored.
+type timeEntryR struct {
+	User *User // fk_time_entries_0
+	Todo *Todo // fk_time_entries_1
+}
+
+func buildTimeEntryColumns(alias string) timeEntryColumns {
+	return timeEntryColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "todo_id", "user_id", "started_at", "ended_at",
+		).WithParent("time_entries"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		TodoID:     sqlite.Quote(alias, "todo_id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		StartedAt:  sqlite.Quote(alias, "started_at"),
+		EndedAt:    sqlite.Quote(alias, "ended_at"),
+	}
+}
+
+type timeEntryColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	TodoID     sqlite.Expression
+	UserID     sqlite.Expression
+	StartedAt  sqlite.Expression
+	EndedAt    sqlite.Expression
+}
+
+func (c timeEntryColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (timeEntryColumns) AliasedAs(alias string) timeEntryColumns {
+	return buildTimeEntryColumns(alias)
+}
+
+// TimeEntrySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TimeEntrySetter struct {
+	ID        omit.Val[int64]         `db:"id,pk" `
+	TodoID    omit.Val[int64]         `db:"todo_id" `
+	UserID    omit.Val[int64]         `db:"user_id" `
+	StartedAt omit.Val[time.Time]     `db:"started_at" `
+	EndedAt   omitnull.Val[time.Time] `db:"ended_at" `
+}
+
+func (s TimeEntrySetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.TodoID.IsValue() {
+		vals = append(vals, "todo_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.StartedAt.IsValue() {
+		vals = append(vals, "started_at")
+	}
+	if !s.EndedAt.IsUnset() {
+		vals = append(vals, "ended_at")
+	}
+	return vals
+}
+
+func (s TimeEntrySetter) Overwrite(t *TimeEntry) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.TodoID.IsValue() {
+		t.TodoID = s.TodoID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.StartedAt.IsValue() {
+		t.StartedAt = s.StartedAt.MustGet()
+	}
+	if !s.EndedAt.IsUnset() {
+		t.EndedAt = s.EndedAt.MustGetNull()
+	}
+}
+
+func (s *TimeEntrySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return TimeEntries.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 5)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.TodoID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoID.MustGet()))
+		}
+
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
+		if s.StartedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.StartedAt.MustGet()))
+		}
+
+		if !s.EndedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.EndedAt.MustGetNull()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TimeEntrySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TimeEntrySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.TodoID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_id")...),
+			sqlite.Arg(s.TodoID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.StartedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "started_at")...),
+			sqlite.Arg(s.StartedAt),
+		}})
+	}
+
+	if !s.EndedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "ended_at")...),
+			sqlite.Arg(s.EndedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTimeEntry retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTimeEntry(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*TimeEntry, error) {
+	if len(cols) == 0 {
+		return TimeEntries.Query(
+			sm.Where(TimeEntries.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return TimeEntries.Query(
+		sm.Where(TimeEntries.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(TimeEntries.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TimeEntryExists checks the presence of a single record by primary key
+func TimeEntryExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return TimeEntries.Query(
+		sm.Where(TimeEntries.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after TimeEntry is retrieved from the database
+func (o *TimeEntry) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TimeEntries.AfterSelectHooks.RunHooks(ctx, exec, TimeEntrySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = TimeEntries.AfterInsertHooks.RunHooks(ctx, exec, TimeEntrySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = TimeEntries.AfterUpdateHooks.RunHooks(ctx, exec, TimeEntrySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = TimeEntries.AfterDeleteHooks.RunHooks(ctx, exec, TimeEntrySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the TimeEntry
+func (o *TimeEntry) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *TimeEntry) pkEQ() dialect.Expression {
+	return sqlite.Quote("time_entries", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the TimeEntry
+func (o *TimeEntry) Update(ctx context.Context, exec bob.Executor, s *TimeEntrySetter) error {
+	v, err := TimeEntries.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single TimeEntry record with an executor
+func (o *TimeEntry) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := TimeEntries.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the TimeEntry using the executor
+func (o *TimeEntry) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := TimeEntries.Query(
+		sm.Where(TimeEntries.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TimeEntrySlice is retrieved from the database
+func (o TimeEntrySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TimeEntries.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = TimeEntries.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = TimeEntries.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = TimeEntries.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TimeEntrySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("time_entries", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TimeEntrySlice) copyMatchingRows(from ...*TimeEntry) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TimeEntrySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TimeEntries.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TimeEntry:
+				o.copyMatchingRows(retrieved)
+			case []*TimeEntry:
+				o.copyMatchingRows(retrieved...)
+			case TimeEntrySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TimeEntry or a slice of TimeEntry
+				// then run the AfterUpdateHooks on the slice
+				_, err = TimeEntries.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TimeEntrySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TimeEntries.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TimeEntry:
+				o.copyMatchingRows(retrieved)
+			case []*TimeEntry:
+				o.copyMatchingRows(retrieved...)
+			case TimeEntrySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TimeEntry or a slice of TimeEntry
+				// then run the AfterDeleteHooks on the slice
+				_, err = TimeEntries.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TimeEntrySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TimeEntrySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TimeEntries.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TimeEntrySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TimeEntries.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TimeEntrySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := TimeEntries.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *TimeEntry) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os TimeEntrySlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todo starts a query for related objects on todos
+func (o *TimeEntry) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.TodoID))),
+	)...)
+}
+
+func (os TimeEntrySlice) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TodoID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachTimeEntryUser0(ctx context.Context, exec bob.Executor, count int, timeEntry0 *TimeEntry, user1 *User) (*TimeEntry, error) {
+	setter := &TimeEntrySetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := timeEntry0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTimeEntryUser0: %w", err)
+	}
+
+	return timeEntry0, nil
+}
+
+func (timeEntry0 *TimeEntry) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTimeEntryUser0(ctx, exec, 1, timeEntry0, user1)
+	if err != nil {
+		return err
+	}
+
+	timeEntry0.R.User = user1
+
+	user1.R.TimeEntries = append(user1.R.TimeEntries, timeEntry0)
+
+	return nil
+}
+
+func (timeEntry0 *TimeEntry) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachTimeEntryUser0(ctx, exec, 1, timeEntry0, user1)
+	if err != nil {
+		return err
+	}
+
+	timeEntry0.R.User = user1
+
+	user1.R.TimeEntries = append(user1.R.TimeEntries, timeEntry0)
+
+	return nil
+}
+
+func attachTimeEntryTodo0(ctx context.Context, exec bob.Executor, count int, timeEntry0 *TimeEntry, todo1 *Todo) (*TimeEntry, error) {
+	setter := &TimeEntrySetter{
+		TodoID: omit.From(todo1.ID),
+	}
+
+	err := timeEntry0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTimeEntryTodo0: %w", err)
+	}
+
+	return timeEntry0, nil
+}
+
+func (timeEntry0 *TimeEntry) InsertTodo(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTimeEntryTodo0(ctx, exec, 1, timeEntry0, todo1)
+	if err != nil {
+		return err
+	}
+
+	timeEntry0.R.Todo = todo1
+
+	todo1.R.TimeEntries = append(todo1.R.TimeEntries, timeEntry0)
+
+	return nil
+}
+
+func (timeEntry0 *TimeEntry) AttachTodo(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachTimeEntryTodo0(ctx, exec, 1, timeEntry0, todo1)
+	if err != nil {
+		return err
+	}
+
+	timeEntry0.R.Todo = todo1
+
+	todo1.R.TimeEntries = append(todo1.R.TimeEntries, timeEntry0)
+
+	return nil
+}
+
+type timeEntryWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int64]
+	TodoID    sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereMod[Q, int64]
+	StartedAt sqlite.WhereMod[Q, time.Time]
+	EndedAt   sqlite.WhereNullMod[Q, time.Time]
+}
+
+func (timeEntryWhere[Q]) AliasedAs(alias string) timeEntryWhere[Q] {
+	return buildTimeEntryWhere[Q](buildTimeEntryColumns(alias))
+}
+
+func buildTimeEntryWhere[Q sqlite.Filterable](cols timeEntryColumns) timeEntryWhere[Q] {
+	return timeEntryWhere[Q]{
+		ID:        sqlite.Where[Q, int64](cols.ID),
+		TodoID:    sqlite.Where[Q, int64](cols.TodoID),
+		UserID:    sqlite.Where[Q, int64](cols.UserID),
+		StartedAt: sqlite.Where[Q, time.Time](cols.StartedAt),
+		EndedAt:   sqlite.WhereNull[Q, time.Time](cols.EndedAt),
+	}
+}
+
+func (o *TimeEntry) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("timeEntry cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.TimeEntries = TimeEntrySlice{o}
+		}
+		return nil
+	case "Todo":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("timeEntry cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todo = rel
+
+		if rel != nil {
+			rel.R.TimeEntries = TimeEntrySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("timeEntry has no relationship %q", name)
+	}
+}
+
+type timeEntryPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+	Todo func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildTimeEntryPreloader() timeEntryPreloader {
+	return timeEntryPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TimeEntries,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Todo: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Todo",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TimeEntries,
+						To:          Todos,
+						FromColumns: []string{"todo_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+	}
+}
+
+type timeEntryThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todo func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTimeEntryThenLoader[Q orm.Loadable]() timeEntryThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoLoadInterface interface {
+		LoadTodo(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return timeEntryThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		Todo: thenLoadBuilder[Q](
+			"Todo",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodo(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the timeEntry's User into the .R struct
+func (o *TimeEntry) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.TimeEntries = TimeEntrySlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the timeEntry's User into the .R struct
+func (os TimeEntrySlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.TimeEntries = append(rel.R.TimeEntries, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo loads the timeEntry's Todo into the .R struct
+func (o *TimeEntry) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todo = nil
+
+	related, err := o.Todo(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.TimeEntries = TimeEntrySlice{o}
+
+	o.R.Todo = related
+	return nil
+}
+
+// LoadTodo loads the timeEntry's Todo into the .R struct
+func (os TimeEntrySlice) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todo(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.TodoID == rel.ID) {
+				continue
+			}
+
+			rel.R.TimeEntries = append(rel.R.TimeEntries, o)
+
+			o.R.Todo = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type timeEntryJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+	Todo modAs[Q, todoColumns]
+}
+
+func (j timeEntryJoins[Q]) aliasedAs(alias string) timeEntryJoins[Q] {
+	return buildTimeEntryJoins[Q](buildTimeEntryColumns(alias), j.typ)
+}
+
+func buildTimeEntryJoins[Q dialect.Joinable](cols timeEntryColumns, typ string) timeEntryJoins[Q] {
+	return timeEntryJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todo: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,809 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// TodoDependency is an object representing the database table.
+type TodoDependency struct {
+	TodoID    int64     `db:"todo_id,pk" `
+	BlockerID int64     `db:"blocker_id,pk" `
+	CreatedAt time.Time `db:"created_at" `
+
+	R todoDependencyR `db:"-" `
+}
+
+// TodoDependencySlice is an alias for a slice of pointers to TodoDependency.
+// This should almost always be used instead of []*TodoDependency.
+type TodoDependencySlice []*TodoDependency
+
+// TodoDependencies contains methods to work with the todo_dependencies table
+var TodoDependencies = sqlite.NewTablex[*TodoDependency, TodoDependencySlice, *TodoDependencySetter]("", "todo_dependencies", buildTodoDependencyColumns("todo_dependencies"))
+
+// TodoDependenciesQuery is a query on the todo_dependencies table
+type TodoDependenciesQuery = *sqlite.ViewQuery[*TodoDependency, TodoDependencySlice]
+
+// todoDependencyR is where relationships are stored.
+type todoDependencyR struct {
+	Blocker *Todo // fk_todo_dependencies_0
+	Todo    *Todo // fk_todo_dependencies_1
+}
+
+func buildTodoDependencyColumns(alias string) todoDependencyColumns {
+	return todoDependencyColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"todo_id", "blocker_id", "created_at",
+		).WithParent("todo_dependencies"),
+		tableAlias: alias,
+		TodoID:     sqlite.Quote(alias, "todo_id"),
+		BlockerID:  sqlite.Quote(alias, "blocker_id"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type todoDependencyColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	TodoID     sqlite.Expression
+	BlockerID  sqlite.Expression
+	CreatedAt  sqlite.Expression
+}
+
+func (c todoDependencyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (todoDependencyColumns) AliasedAs(alias string) todoDependencyColumns {
+	return buildTodoDependencyColumns(alias)
+}
+
+// TodoDependencySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TodoDependencySetter struct {
+	TodoID    omit.Val[int64]     `db:"todo_id,pk" `
+	BlockerID omit.Val[int64]     `db:"blocker_id,pk" `
+	CreatedAt omit.Val[time.Time] `db:"created_at" `
+}
+
+func (s TodoDependencySetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.TodoID.IsValue() {
+		vals = append(vals, "todo_id")
+	}
+	if s.BlockerID.IsValue() {
+		vals = append(vals, "blocker_id")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s TodoDependencySetter) Overwrite(t *TodoDependency) {
+	if s.TodoID.IsValue() {
+		t.TodoID = s.TodoID.MustGet()
+	}
+	if s.BlockerID.IsValue() {
+		t.BlockerID = s.BlockerID.MustGet()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *TodoDependencySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return TodoDependencies.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"todo_id", "blocker_id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 3)
+		if s.TodoID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoID.MustGet()))
+		}
+
+		if s.BlockerID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.BlockerID.MustGet()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil), sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TodoDependencySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TodoDependencySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.TodoID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_id")...),
+			sqlite.Arg(s.TodoID),
+		}})
+	}
+
+	if s.BlockerID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "blocker_id")...),
+			sqlite.Arg(s.BlockerID),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTodoDependency retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTodoDependency(ctx context.Context, exec bob.Executor, TodoIDPK int64, BlockerIDPK int64, cols ...string) (*TodoDependency, error) {
+	if len(cols) == 0 {
+		return TodoDependencies.Query(
+			sm.Where(TodoDependencies.Columns.TodoID.EQ(sqlite.Arg(TodoIDPK))),
+			sm.Where(TodoDependencies.Columns.BlockerID.EQ(sqlite.Arg(BlockerIDPK))),
+		).One(ctx, exec)
+	}
+
+	return TodoDependencies.Query(
+		sm.Where(TodoDependencies.Columns.TodoID.EQ(sqlite.Arg(TodoIDPK))),
+		sm.Where(TodoDependencies.Columns.BlockerID.EQ(sqlite.Arg(BlockerIDPK))),
+		sm.Columns(TodoDependencies.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TodoDependencyExists checks the presence of a single record by primary key
+func TodoDependencyExists(ctx context.Context, exec bob.Executor, TodoIDPK int64, BlockerIDPK int64) (bool, error) {
+	return TodoDependencies.Query(
+		sm.Where(TodoDependencies.Columns.TodoID.EQ(sqlite.Arg(TodoIDPK))),
+		sm.Where(TodoDependencies.Columns.BlockerID.EQ(sqlite.Arg(BlockerIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after TodoDependency is retrieved from the database
+func (o *TodoDependency) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TodoDependencies.AfterSelectHooks.RunHooks(ctx, exec, TodoDependencySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = TodoDependencies.AfterInsertHooks.RunHooks(ctx, exec, TodoDependencySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = TodoDependencies.AfterUpdateHooks.RunHooks(ctx, exec, TodoDependencySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = TodoDependencies.AfterDeleteHooks.RunHooks(ctx, exec, TodoDependencySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the TodoDependency
+func (o *TodoDependency) primaryKeyVals() bob.Expression {
+	return sqlite.ArgGroup(
+		o.TodoID,
+		o.BlockerID,
+	)
+}
+
+func (o *TodoDependency) pkEQ() dialect.Expression {
+	return sqlite.Group(sqlite.Quote("todo_dependencies", "todo_id"), sqlite.Quote("todo_dependencies", "blocker_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the TodoDependency
+func (o *TodoDependency) Update(ctx context.Context, exec bob.Executor, s *TodoDependencySetter) error {
+	v, err := TodoDependencies.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single TodoDependency record with an executor
+func (o *TodoDependency) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := TodoDependencies.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the TodoDependency using the executor
+func (o *TodoDependency) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := TodoDependencies.Query(
+		sm.Where(TodoDependencies.Columns.TodoID.EQ(sqlite.Arg(o.TodoID))),
+		sm.Where(TodoDependencies.Columns.BlockerID.EQ(sqlite.Arg(o.BlockerID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TodoDependencySlice is retrieved from the database
+func (o TodoDependencySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TodoDependencies.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = TodoDependencies.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = TodoDependencies.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = TodoDependencies.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TodoDependencySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Group(sqlite.Quote("todo_dependencies", "todo_id"), sqlite.Quote("todo_dependencies", "blocker_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TodoDependencySlice) copyMatchingRows(from ...*TodoDependency) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.TodoID != old.TodoID {
+				continue
+			}
+			if new.BlockerID != old.BlockerID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TodoDependencySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TodoDependencies.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TodoDependency:
+				o.copyMatchingRows(retrieved)
+			case []*TodoDependency:
+				o.copyMatchingRows(retrieved...)
+			case TodoDependencySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TodoDependency or a slice of TodoDependency
+				// then run the AfterUpdateHooks on the slice
+				_, err = TodoDependencies.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TodoDependencySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TodoDependencies.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TodoDependency:
+				o.copyMatchingRows(retrieved)
+			case []*TodoDependency:
+				o.copyMatchingRows(retrieved...)
+			case TodoDependencySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TodoDependency or a slice of TodoDependency
+				// then run the AfterDeleteHooks on the slice
+				_, err = TodoDependencies.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TodoDependencySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TodoDependencySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TodoDependencies.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TodoDependencySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TodoDependencies.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TodoDependencySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := TodoDependencies.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Blocker starts a query for related objects on todos
+func (o *TodoDependency) Blocker(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.BlockerID))),
+	)...)
+}
+
+func (os TodoDependencySlice) Blocker(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.BlockerID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todo starts a query for related objects on todos
+func (o *TodoDependency) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.TodoID))),
+	)...)
+}
+
+func (os TodoDependencySlice) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TodoID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachTodoDependencyBlocker0(ctx context.Context, exec bob.Executor, count int, todoDependency0 *TodoDependency, todo1 *Todo) (*TodoDependency, error) {
+	setter := &TodoDependencySetter{
+		BlockerID: omit.From(todo1.ID),
+	}
+
+	err := todoDependency0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoDependencyBlocker0: %w", err)
+	}
+
+	return todoDependency0, nil
+}
+
+func (todoDependency0 *TodoDependency) InsertBlocker(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoDependencyBlocker0(ctx, exec, 1, todoDependency0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoDependency0.R.Blocker = todo1
+
+	todo1.R.Dependents = append(todo1.R.Dependents, todoDependency0)
+
+	return nil
+}
+
+func (todoDependency0 *TodoDependency) AttachBlocker(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachTodoDependencyBlocker0(ctx, exec, 1, todoDependency0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoDependency0.R.Blocker = todo1
+
+	todo1.R.Dependents = append(todo1.R.Dependents, todoDependency0)
+
+	return nil
+}
+
+func attachTodoDependencyTodo0(ctx context.Context, exec bob.Executor, count int, todoDependency0 *TodoDependency, todo1 *Todo) (*TodoDependency, error) {
+	setter := &TodoDependencySetter{
+		TodoID: omit.From(todo1.ID),
+	}
+
+	err := todoDependency0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoDependencyTodo0: %w", err)
+	}
+
+	return todoDependency0, nil
+}
+
+func (todoDependency0 *TodoDependency) InsertTodo(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoDependencyTodo0(ctx, exec, 1, todoDependency0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoDependency0.R.Todo = todo1
+
+	todo1.R.Dependencies = append(todo1.R.Dependencies, todoDependency0)
+
+	return nil
+}
+
+func (todoDependency0 *TodoDependency) AttachTodo(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachTodoDependencyTodo0(ctx, exec, 1, todoDependency0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoDependency0.R.Todo = todo1
+
+	todo1.R.Dependencies = append(todo1.R.Dependencies, todoDependency0)
+
+	return nil
+}
+
+type todoDependencyWhere[Q sqlite.Filterable] struct {
+	TodoID    sqlite.WhereMod[Q, int64]
+	BlockerID sqlite.WhereMod[Q, int64]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (todoDependencyWhere[Q]) AliasedAs(alias string) todoDependencyWhere[Q] {
+	return buildTodoDependencyWhere[Q](buildTodoDependencyColumns(alias))
+}
+
+func buildTodoDependencyWhere[Q sqlite.Filterable](cols todoDependencyColumns) todoDependencyWhere[Q] {
+	return todoDependencyWhere[Q]{
+		TodoID:    sqlite.Where[Q, int64](cols.TodoID),
+		BlockerID: sqlite.Where[Q, int64](cols.BlockerID),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *TodoDependency) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Blocker":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("todoDependency cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Blocker = rel
+
+		if rel != nil {
+			rel.R.Dependents = TodoDependencySlice{o}
+		}
+		return nil
+	case "Todo":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("todoDependency cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todo = rel
+
+		if rel != nil {
+			rel.R.Dependencies = TodoDependencySlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("todoDependency has no relationship %q", name)
+	}
+}
+
+type todoDependencyPreloader struct {
+	Blocker func(...sqlite.PreloadOption) sqlite.Preloader
+	Todo    func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildTodoDependencyPreloader() todoDependencyPreloader {
+	return todoDependencyPreloader{
+		Blocker: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Blocker",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TodoDependencies,
+						To:          Todos,
+						FromColumns: []string{"blocker_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+		Todo: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Todo",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TodoDependencies,
+						To:          Todos,
+						FromColumns: []string{"todo_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+	}
+}
+
+type todoDependencyThenLoader[Q orm.Loadable] struct {
+	Blocker func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todo    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTodoDependencyThenLoader[Q orm.Loadable]() todoDependencyThenLoader[Q] {
+	type BlockerLoadInterface interface {
+		LoadBlocker(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoLoadInterface interface {
+		LoadTodo(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return todoDependencyThenLoader[Q]{
+		Blocker: thenLoadBuilder[Q](
+			"Blocker",
+			func(ctx context.Context, exec bob.Executor, retrieved BlockerLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBlocker(ctx, exec, mods...)
+			},
+		),
+		Todo: thenLoadBuilder[Q](
+			"Todo",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodo(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadBlocker loads the todoDependency's Blocker into the .R struct
+func (o *TodoDependency) LoadBlocker(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Blocker = nil
+
+	related, err := o.Blocker(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Dependents = TodoDependencySlice{o}
+
+	o.R.Blocker = related
+	return nil
+}
+
+// LoadBlocker loads the todoDependency's Blocker into the .R struct
+func (os TodoDependencySlice) LoadBlocker(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Blocker(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.BlockerID == rel.ID) {
+				continue
+			}
+
+			rel.R.Dependents = append(rel.R.Dependents, o)
+
+			o.R.Blocker = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo loads the todoDependency's Todo into the .R struct
+func (o *TodoDependency) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todo = nil
+
+	related, err := o.Todo(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Dependencies = TodoDependencySlice{o}
+
+	o.R.Todo = related
+	return nil
+}
+
+// LoadTodo loads the todoDependency's Todo into the .R struct
+func (os TodoDependencySlice) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todo(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.TodoID == rel.ID) {
+				continue
+			}
+
+			rel.R.Dependencies = append(rel.R.Dependencies, o)
+
+			o.R.Todo = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type todoDependencyJoins[Q dialect.Joinable] struct {
+	typ     string
+	Blocker modAs[Q, todoColumns]
+	Todo    modAs[Q, todoColumns]
+}
+
+func (j todoDependencyJoins[Q]) aliasedAs(alias string) todoDependencyJoins[Q] {
+	return buildTodoDependencyJoins[Q](buildTodoDependencyColumns(alias), j.typ)
+}
+
+func buildTodoDependencyJoins[Q dialect.Joinable](cols todoDependencyColumns, typ string) todoDependencyJoins[Q] {
+	return todoDependencyJoins[Q]{
+		typ: typ,
+		Blocker: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.BlockerID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todo: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
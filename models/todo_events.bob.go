@@ -0,0 +1,896 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// TodoEvent is an object representing the database table.
+type TodoEvent struct {
+	ID        int64            `db:"id,pk" `
+	TodoID    int64            `db:"todo_id" `
+	UserID    null.Val[int64]  `db:"user_id" `
+	Kind      string           `db:"kind" `
+	OldValue  null.Val[string] `db:"old_value" `
+	NewValue  null.Val[string] `db:"new_value" `
+	CreatedAt time.Time        `db:"created_at" `
+
+	R todoEventR `db:"-" `
+}
+
+// TodoEventSlice is an alias for a slice of pointers to TodoEvent.
+// This should almost always be used instead of []*TodoEvent.
+type TodoEventSlice []*TodoEvent
+
+// TodoEvents contains methods to work with the todo_events table
+var TodoEvents = sqlite.NewTablex[*TodoEvent, TodoEventSlice, *TodoEventSetter]("", "todo_events", buildTodoEventColumns("todo_events"))
+
+// TodoEventsQuery is a query on the todo_events table
+type TodoEventsQuery = *sqlite.ViewQuery[*TodoEvent, TodoEventSlice]
+
+// todoEventR is where relationships are stored.
+type todoEventR struct {
+	User *User // fk_todo_events_0
+	Todo *Todo // fk_todo_events_1
+}
+
+func buildTodoEventColumns(alias string) todoEventColumns {
+	return todoEventColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "todo_id", "user_id", "kind", "old_value", "new_value", "created_at",
+		).WithParent("todo_events"),
+		tableAlias: alias,
+		ID:         sqlite.Quote(alias, "id"),
+		TodoID:     sqlite.Quote(alias, "todo_id"),
+		UserID:     sqlite.Quote(alias, "user_id"),
+		Kind:       sqlite.Quote(alias, "kind"),
+		OldValue:   sqlite.Quote(alias, "old_value"),
+		NewValue:   sqlite.Quote(alias, "new_value"),
+		CreatedAt:  sqlite.Quote(alias, "created_at"),
+	}
+}
+
+type todoEventColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         sqlite.Expression
+	TodoID     sqlite.Expression
+	UserID     sqlite.Expression
+	Kind       sqlite.Expression
+	OldValue   sqlite.Expression
+	NewValue   sqlite.Expression
+	CreatedAt  sqlite.Expression
+}
+
+func (c todoEventColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (todoEventColumns) AliasedAs(alias string) todoEventColumns {
+	return buildTodoEventColumns(alias)
+}
+
+// TodoEventSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TodoEventSetter struct {
+	ID        omit.Val[int64]      `db:"id,pk" `
+	TodoID    omit.Val[int64]      `db:"todo_id" `
+	UserID    omitnull.Val[int64]  `db:"user_id" `
+	Kind      omit.Val[string]     `db:"kind" `
+	OldValue  omitnull.Val[string] `db:"old_value" `
+	NewValue  omitnull.Val[string] `db:"new_value" `
+	CreatedAt omit.Val[time.Time]  `db:"created_at" `
+}
+
+func (s TodoEventSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.TodoID.IsValue() {
+		vals = append(vals, "todo_id")
+	}
+	if !s.UserID.IsUnset() {
+		vals = append(vals, "user_id")
+	}
+	if s.Kind.IsValue() {
+		vals = append(vals, "kind")
+	}
+	if !s.OldValue.IsUnset() {
+		vals = append(vals, "old_value")
+	}
+	if !s.NewValue.IsUnset() {
+		vals = append(vals, "new_value")
+	}
+	if s.CreatedAt.IsValue() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s TodoEventSetter) Overwrite(t *TodoEvent) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.TodoID.IsValue() {
+		t.TodoID = s.TodoID.MustGet()
+	}
+	if !s.UserID.IsUnset() {
+		t.UserID = s.UserID.MustGetNull()
+	}
+	if s.Kind.IsValue() {
+		t.Kind = s.Kind.MustGet()
+	}
+	if !s.OldValue.IsUnset() {
+		t.OldValue = s.OldValue.MustGetNull()
+	}
+	if !s.NewValue.IsUnset() {
+		t.NewValue = s.NewValue.MustGetNull()
+	}
+	if s.CreatedAt.IsValue() {
+		t.CreatedAt = s.CreatedAt.MustGet()
+	}
+}
+
+func (s *TodoEventSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return TodoEvents.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 7)
+		if s.ID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
+		}
+
+		if s.TodoID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoID.MustGet()))
+		}
+
+		if !s.UserID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGetNull()))
+		}
+
+		if s.Kind.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Kind.MustGet()))
+		}
+
+		if !s.OldValue.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.OldValue.MustGetNull()))
+		}
+
+		if !s.NewValue.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.NewValue.MustGetNull()))
+		}
+
+		if s.CreatedAt.IsValue() {
+			vals = append(vals, sqlite.Arg(s.CreatedAt.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TodoEventSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TodoEventSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "id")...),
+			sqlite.Arg(s.ID),
+		}})
+	}
+
+	if s.TodoID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_id")...),
+			sqlite.Arg(s.TodoID),
+		}})
+	}
+
+	if !s.UserID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
+	if s.Kind.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "kind")...),
+			sqlite.Arg(s.Kind),
+		}})
+	}
+
+	if !s.OldValue.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "old_value")...),
+			sqlite.Arg(s.OldValue),
+		}})
+	}
+
+	if !s.NewValue.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "new_value")...),
+			sqlite.Arg(s.NewValue),
+		}})
+	}
+
+	if s.CreatedAt.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "created_at")...),
+			sqlite.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTodoEvent retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTodoEvent(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*TodoEvent, error) {
+	if len(cols) == 0 {
+		return TodoEvents.Query(
+			sm.Where(TodoEvents.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return TodoEvents.Query(
+		sm.Where(TodoEvents.Columns.ID.EQ(sqlite.Arg(IDPK))),
+		sm.Columns(TodoEvents.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TodoEventExists checks the presence of a single record by primary key
+func TodoEventExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return TodoEvents.Query(
+		sm.Where(TodoEvents.Columns.ID.EQ(sqlite.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after TodoEvent is retrieved from the database
+func (o *TodoEvent) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TodoEvents.AfterSelectHooks.RunHooks(ctx, exec, TodoEventSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = TodoEvents.AfterInsertHooks.RunHooks(ctx, exec, TodoEventSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = TodoEvents.AfterUpdateHooks.RunHooks(ctx, exec, TodoEventSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = TodoEvents.AfterDeleteHooks.RunHooks(ctx, exec, TodoEventSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the TodoEvent
+func (o *TodoEvent) primaryKeyVals() bob.Expression {
+	return sqlite.Arg(o.ID)
+}
+
+func (o *TodoEvent) pkEQ() dialect.Expression {
+	return sqlite.Quote("todo_events", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the TodoEvent
+func (o *TodoEvent) Update(ctx context.Context, exec bob.Executor, s *TodoEventSetter) error {
+	v, err := TodoEvents.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single TodoEvent record with an executor
+func (o *TodoEvent) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := TodoEvents.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the TodoEvent using the executor
+func (o *TodoEvent) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := TodoEvents.Query(
+		sm.Where(TodoEvents.Columns.ID.EQ(sqlite.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TodoEventSlice is retrieved from the database
+func (o TodoEventSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TodoEvents.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = TodoEvents.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = TodoEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = TodoEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TodoEventSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Quote("todo_events", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TodoEventSlice) copyMatchingRows(from ...*TodoEvent) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TodoEventSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TodoEvents.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TodoEvent:
+				o.copyMatchingRows(retrieved)
+			case []*TodoEvent:
+				o.copyMatchingRows(retrieved...)
+			case TodoEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TodoEvent or a slice of TodoEvent
+				// then run the AfterUpdateHooks on the slice
+				_, err = TodoEvents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TodoEventSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TodoEvents.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TodoEvent:
+				o.copyMatchingRows(retrieved)
+			case []*TodoEvent:
+				o.copyMatchingRows(retrieved...)
+			case TodoEventSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TodoEvent or a slice of TodoEvent
+				// then run the AfterDeleteHooks on the slice
+				_, err = TodoEvents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TodoEventSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TodoEventSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TodoEvents.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TodoEventSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TodoEvents.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TodoEventSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := TodoEvents.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// User starts a query for related objects on users
+func (o *TodoEvent) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os TodoEventSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todo starts a query for related objects on todos
+func (o *TodoEvent) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.TodoID))),
+	)...)
+}
+
+func (os TodoEventSlice) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TodoID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachTodoEventUser0(ctx context.Context, exec bob.Executor, count int, todoEvent0 *TodoEvent, user1 *User) (*TodoEvent, error) {
+	setter := &TodoEventSetter{
+		UserID: omitnull.From(user1.ID),
+	}
+
+	err := todoEvent0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoEventUser0: %w", err)
+	}
+
+	return todoEvent0, nil
+}
+
+func (todoEvent0 *TodoEvent) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoEventUser0(ctx, exec, 1, todoEvent0, user1)
+	if err != nil {
+		return err
+	}
+
+	todoEvent0.R.User = user1
+
+	user1.R.TodoEvents = append(user1.R.TodoEvents, todoEvent0)
+
+	return nil
+}
+
+func (todoEvent0 *TodoEvent) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachTodoEventUser0(ctx, exec, 1, todoEvent0, user1)
+	if err != nil {
+		return err
+	}
+
+	todoEvent0.R.User = user1
+
+	user1.R.TodoEvents = append(user1.R.TodoEvents, todoEvent0)
+
+	return nil
+}
+
+func attachTodoEventTodo0(ctx context.Context, exec bob.Executor, count int, todoEvent0 *TodoEvent, todo1 *Todo) (*TodoEvent, error) {
+	setter := &TodoEventSetter{
+		TodoID: omit.From(todo1.ID),
+	}
+
+	err := todoEvent0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoEventTodo0: %w", err)
+	}
+
+	return todoEvent0, nil
+}
+
+func (todoEvent0 *TodoEvent) InsertTodo(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoEventTodo0(ctx, exec, 1, todoEvent0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoEvent0.R.Todo = todo1
+
+	todo1.R.TodoEvents = append(todo1.R.TodoEvents, todoEvent0)
+
+	return nil
+}
+
+func (todoEvent0 *TodoEvent) AttachTodo(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachTodoEventTodo0(ctx, exec, 1, todoEvent0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoEvent0.R.Todo = todo1
+
+	todo1.R.TodoEvents = append(todo1.R.TodoEvents, todoEvent0)
+
+	return nil
+}
+
+type todoEventWhere[Q sqlite.Filterable] struct {
+	ID        sqlite.WhereMod[Q, int64]
+	TodoID    sqlite.WhereMod[Q, int64]
+	UserID    sqlite.WhereNullMod[Q, int64]
+	Kind      sqlite.WhereMod[Q, string]
+	OldValue  sqlite.WhereNullMod[Q, string]
+	NewValue  sqlite.WhereNullMod[Q, string]
+	CreatedAt sqlite.WhereMod[Q, time.Time]
+}
+
+func (todoEventWhere[Q]) AliasedAs(alias string) todoEventWhere[Q] {
+	return buildTodoEventWhere[Q](buildTodoEventColumns(alias))
+}
+
+func buildTodoEventWhere[Q sqlite.Filterable](cols todoEventColumns) todoEventWhere[Q] {
+	return todoEventWhere[Q]{
+		ID:        sqlite.Where[Q, int64](cols.ID),
+		TodoID:    sqlite.Where[Q, int64](cols.TodoID),
+		UserID:    sqlite.WhereNull[Q, int64](cols.UserID),
+		Kind:      sqlite.Where[Q, string](cols.Kind),
+		OldValue:  sqlite.WhereNull[Q, string](cols.OldValue),
+		NewValue:  sqlite.WhereNull[Q, string](cols.NewValue),
+		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *TodoEvent) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("todoEvent cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.TodoEvents = TodoEventSlice{o}
+		}
+		return nil
+	case "Todo":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("todoEvent cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todo = rel
+
+		if rel != nil {
+			rel.R.TodoEvents = TodoEventSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("todoEvent has no relationship %q", name)
+	}
+}
+
+type todoEventPreloader struct {
+	User func(...sqlite.PreloadOption) sqlite.Preloader
+	Todo func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildTodoEventPreloader() todoEventPreloader {
+	return todoEventPreloader{
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TodoEvents,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Todo: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Todo",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TodoEvents,
+						To:          Todos,
+						FromColumns: []string{"todo_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+	}
+}
+
+type todoEventThenLoader[Q orm.Loadable] struct {
+	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todo func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTodoEventThenLoader[Q orm.Loadable]() todoEventThenLoader[Q] {
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoLoadInterface interface {
+		LoadTodo(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return todoEventThenLoader[Q]{
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		Todo: thenLoadBuilder[Q](
+			"Todo",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodo(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadUser loads the todoEvent's User into the .R struct
+func (o *TodoEvent) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.TodoEvents = TodoEventSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the todoEvent's User into the .R struct
+func (os TodoEventSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+			if !o.UserID.IsValue() {
+				continue
+			}
+
+			if !(o.UserID.IsValue() && o.UserID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.TodoEvents = append(rel.R.TodoEvents, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo loads the todoEvent's Todo into the .R struct
+func (o *TodoEvent) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todo = nil
+
+	related, err := o.Todo(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.TodoEvents = TodoEventSlice{o}
+
+	o.R.Todo = related
+	return nil
+}
+
+// LoadTodo loads the todoEvent's Todo into the .R struct
+func (os TodoEventSlice) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todo(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.TodoID == rel.ID) {
+				continue
+			}
+
+			rel.R.TodoEvents = append(rel.R.TodoEvents, o)
+
+			o.R.Todo = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type todoEventJoins[Q dialect.Joinable] struct {
+	typ  string
+	User modAs[Q, userColumns]
+	Todo modAs[Q, todoColumns]
+}
+
+func (j todoEventJoins[Q]) aliasedAs(alias string) todoEventJoins[Q] {
+	return buildTodoEventJoins[Q](buildTodoEventColumns(alias), j.typ)
+}
+
+func buildTodoEventJoins[Q dialect.Joinable](cols todoEventColumns, typ string) todoEventJoins[Q] {
+	return todoEventJoins[Q]{
+		typ: typ,
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todo: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,763 @@
+// Code generated by BobGen sqlite v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/dm"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+)
+
+// TodoTag is an object representing the database table.
+type TodoTag struct {
+	TodoID int64 `db:"todo_id,pk" `
+	TagID  int64 `db:"tag_id,pk" `
+
+	R todoTagR `db:"-" `
+}
+
+// TodoTagSlice is an alias for a slice of pointers to TodoTag.
+// This should almost always be used instead of []*TodoTag.
+type TodoTagSlice []*TodoTag
+
+// TodoTags contains methods to work with the todo_tags table
+var TodoTags = sqlite.NewTablex[*TodoTag, TodoTagSlice, *TodoTagSetter]("", "todo_tags", buildTodoTagColumns("todo_tags"))
+
+// TodoTagsQuery is a query on the todo_tags table
+type TodoTagsQuery = *sqlite.ViewQuery[*TodoTag, TodoTagSlice]
+
+// todoTagR is where relationships are stored.
+type todoTagR struct {
+	Tag  *Tag  // fk_todo_tags_0
+	Todo *Todo // fk_todo_tags_1
+}
+
+func buildTodoTagColumns(alias string) todoTagColumns {
+	return todoTagColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"todo_id", "tag_id",
+		).WithParent("todo_tags"),
+		tableAlias: alias,
+		TodoID:     sqlite.Quote(alias, "todo_id"),
+		TagID:      sqlite.Quote(alias, "tag_id"),
+	}
+}
+
+type todoTagColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	TodoID     sqlite.Expression
+	TagID      sqlite.Expression
+}
+
+func (c todoTagColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (todoTagColumns) AliasedAs(alias string) todoTagColumns {
+	return buildTodoTagColumns(alias)
+}
+
+// TodoTagSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TodoTagSetter struct {
+	TodoID omit.Val[int64] `db:"todo_id,pk" `
+	TagID  omit.Val[int64] `db:"tag_id,pk" `
+}
+
+func (s TodoTagSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if s.TodoID.IsValue() {
+		vals = append(vals, "todo_id")
+	}
+	if s.TagID.IsValue() {
+		vals = append(vals, "tag_id")
+	}
+	return vals
+}
+
+func (s TodoTagSetter) Overwrite(t *TodoTag) {
+	if s.TodoID.IsValue() {
+		t.TodoID = s.TodoID.MustGet()
+	}
+	if s.TagID.IsValue() {
+		t.TagID = s.TagID.MustGet()
+	}
+}
+
+func (s *TodoTagSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return TodoTags.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	if len(q.TableRef.Columns) == 0 {
+		q.TableRef.Columns = s.SetColumns()
+		if len(q.TableRef.Columns) == 0 {
+			q.TableRef.Columns = []string{"todo_id", "tag_id"}
+		}
+
+	}
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 0, 2)
+		if s.TodoID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoID.MustGet()))
+		}
+
+		if s.TagID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TagID.MustGet()))
+		}
+
+		if len(vals) == 0 {
+			vals = append(vals, sqlite.Arg(nil), sqlite.Arg(nil))
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TodoTagSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TodoTagSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if s.TodoID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_id")...),
+			sqlite.Arg(s.TodoID),
+		}})
+	}
+
+	if s.TagID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "tag_id")...),
+			sqlite.Arg(s.TagID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTodoTag retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTodoTag(ctx context.Context, exec bob.Executor, TodoIDPK int64, TagIDPK int64, cols ...string) (*TodoTag, error) {
+	if len(cols) == 0 {
+		return TodoTags.Query(
+			sm.Where(TodoTags.Columns.TodoID.EQ(sqlite.Arg(TodoIDPK))),
+			sm.Where(TodoTags.Columns.TagID.EQ(sqlite.Arg(TagIDPK))),
+		).One(ctx, exec)
+	}
+
+	return TodoTags.Query(
+		sm.Where(TodoTags.Columns.TodoID.EQ(sqlite.Arg(TodoIDPK))),
+		sm.Where(TodoTags.Columns.TagID.EQ(sqlite.Arg(TagIDPK))),
+		sm.Columns(TodoTags.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TodoTagExists checks the presence of a single record by primary key
+func TodoTagExists(ctx context.Context, exec bob.Executor, TodoIDPK int64, TagIDPK int64) (bool, error) {
+	return TodoTags.Query(
+		sm.Where(TodoTags.Columns.TodoID.EQ(sqlite.Arg(TodoIDPK))),
+		sm.Where(TodoTags.Columns.TagID.EQ(sqlite.Arg(TagIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after TodoTag is retrieved from the database
+func (o *TodoTag) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TodoTags.AfterSelectHooks.RunHooks(ctx, exec, TodoTagSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = TodoTags.AfterInsertHooks.RunHooks(ctx, exec, TodoTagSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = TodoTags.AfterUpdateHooks.RunHooks(ctx, exec, TodoTagSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = TodoTags.AfterDeleteHooks.RunHooks(ctx, exec, TodoTagSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the TodoTag
+func (o *TodoTag) primaryKeyVals() bob.Expression {
+	return sqlite.ArgGroup(
+		o.TodoID,
+		o.TagID,
+	)
+}
+
+func (o *TodoTag) pkEQ() dialect.Expression {
+	return sqlite.Group(sqlite.Quote("todo_tags", "todo_id"), sqlite.Quote("todo_tags", "tag_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the TodoTag
+func (o *TodoTag) Update(ctx context.Context, exec bob.Executor, s *TodoTagSetter) error {
+	v, err := TodoTags.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single TodoTag record with an executor
+func (o *TodoTag) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := TodoTags.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the TodoTag using the executor
+func (o *TodoTag) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := TodoTags.Query(
+		sm.Where(TodoTags.Columns.TodoID.EQ(sqlite.Arg(o.TodoID))),
+		sm.Where(TodoTags.Columns.TagID.EQ(sqlite.Arg(o.TagID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TodoTagSlice is retrieved from the database
+func (o TodoTagSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TodoTags.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = TodoTags.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = TodoTags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = TodoTags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TodoTagSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return sqlite.Raw("NULL")
+	}
+
+	return sqlite.Group(sqlite.Quote("todo_tags", "todo_id"), sqlite.Quote("todo_tags", "tag_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TodoTagSlice) copyMatchingRows(from ...*TodoTag) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.TodoID != old.TodoID {
+				continue
+			}
+			if new.TagID != old.TagID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TodoTagSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TodoTags.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TodoTag:
+				o.copyMatchingRows(retrieved)
+			case []*TodoTag:
+				o.copyMatchingRows(retrieved...)
+			case TodoTagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TodoTag or a slice of TodoTag
+				// then run the AfterUpdateHooks on the slice
+				_, err = TodoTags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TodoTagSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TodoTags.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TodoTag:
+				o.copyMatchingRows(retrieved)
+			case []*TodoTag:
+				o.copyMatchingRows(retrieved...)
+			case TodoTagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TodoTag or a slice of TodoTag
+				// then run the AfterDeleteHooks on the slice
+				_, err = TodoTags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TodoTagSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TodoTagSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TodoTags.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TodoTagSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TodoTags.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TodoTagSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := TodoTags.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Tag starts a query for related objects on tags
+func (o *TodoTag) Tag(mods ...bob.Mod[*dialect.SelectQuery]) TagsQuery {
+	return Tags.Query(append(mods,
+		sm.Where(Tags.Columns.ID.EQ(sqlite.Arg(o.TagID))),
+	)...)
+}
+
+func (os TodoTagSlice) Tag(mods ...bob.Mod[*dialect.SelectQuery]) TagsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TagID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Tags.Query(append(mods,
+		sm.Where(sqlite.Group(Tags.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todo starts a query for related objects on todos
+func (o *TodoTag) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.TodoID))),
+	)...)
+}
+
+func (os TodoTagSlice) Todo(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.TodoID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachTodoTagTag0(ctx context.Context, exec bob.Executor, count int, todoTag0 *TodoTag, tag1 *Tag) (*TodoTag, error) {
+	setter := &TodoTagSetter{
+		TagID: omit.From(tag1.ID),
+	}
+
+	err := todoTag0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoTagTag0: %w", err)
+	}
+
+	return todoTag0, nil
+}
+
+func (todoTag0 *TodoTag) InsertTag(ctx context.Context, exec bob.Executor, related *TagSetter) error {
+	var err error
+
+	tag1, err := Tags.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoTagTag0(ctx, exec, 1, todoTag0, tag1)
+	if err != nil {
+		return err
+	}
+
+	todoTag0.R.Tag = tag1
+
+	return nil
+}
+
+func (todoTag0 *TodoTag) AttachTag(ctx context.Context, exec bob.Executor, tag1 *Tag) error {
+	var err error
+
+	_, err = attachTodoTagTag0(ctx, exec, 1, todoTag0, tag1)
+	if err != nil {
+		return err
+	}
+
+	todoTag0.R.Tag = tag1
+
+	return nil
+}
+
+func attachTodoTagTodo0(ctx context.Context, exec bob.Executor, count int, todoTag0 *TodoTag, todo1 *Todo) (*TodoTag, error) {
+	setter := &TodoTagSetter{
+		TodoID: omit.From(todo1.ID),
+	}
+
+	err := todoTag0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoTagTodo0: %w", err)
+	}
+
+	return todoTag0, nil
+}
+
+func (todoTag0 *TodoTag) InsertTodo(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoTagTodo0(ctx, exec, 1, todoTag0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoTag0.R.Todo = todo1
+
+	return nil
+}
+
+func (todoTag0 *TodoTag) AttachTodo(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachTodoTagTodo0(ctx, exec, 1, todoTag0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todoTag0.R.Todo = todo1
+
+	return nil
+}
+
+type todoTagWhere[Q sqlite.Filterable] struct {
+	TodoID sqlite.WhereMod[Q, int64]
+	TagID  sqlite.WhereMod[Q, int64]
+}
+
+func (todoTagWhere[Q]) AliasedAs(alias string) todoTagWhere[Q] {
+	return buildTodoTagWhere[Q](buildTodoTagColumns(alias))
+}
+
+func buildTodoTagWhere[Q sqlite.Filterable](cols todoTagColumns) todoTagWhere[Q] {
+	return todoTagWhere[Q]{
+		TodoID: sqlite.Where[Q, int64](cols.TodoID),
+		TagID:  sqlite.Where[Q, int64](cols.TagID),
+	}
+}
+
+func (o *TodoTag) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Tag":
+		rel, ok := retrieved.(*Tag)
+		if !ok {
+			return fmt.Errorf("todoTag cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tag = rel
+
+		return nil
+	case "Todo":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("todoTag cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todo = rel
+
+		return nil
+	default:
+		return fmt.Errorf("todoTag has no relationship %q", name)
+	}
+}
+
+type todoTagPreloader struct {
+	Tag  func(...sqlite.PreloadOption) sqlite.Preloader
+	Todo func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildTodoTagPreloader() todoTagPreloader {
+	return todoTagPreloader{
+		Tag: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Tag, TagSlice](sqlite.PreloadRel{
+				Name: "Tag",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TodoTags,
+						To:          Tags,
+						FromColumns: []string{"tag_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tags.Columns.Names(), opts...)
+		},
+		Todo: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Todo",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        TodoTags,
+						To:          Todos,
+						FromColumns: []string{"todo_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+	}
+}
+
+type todoTagThenLoader[Q orm.Loadable] struct {
+	Tag  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todo func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTodoTagThenLoader[Q orm.Loadable]() todoTagThenLoader[Q] {
+	type TagLoadInterface interface {
+		LoadTag(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoLoadInterface interface {
+		LoadTodo(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return todoTagThenLoader[Q]{
+		Tag: thenLoadBuilder[Q](
+			"Tag",
+			func(ctx context.Context, exec bob.Executor, retrieved TagLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTag(ctx, exec, mods...)
+			},
+		),
+		Todo: thenLoadBuilder[Q](
+			"Todo",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodo(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadTag loads the todoTag's Tag into the .R struct
+func (o *TodoTag) LoadTag(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tag = nil
+
+	related, err := o.Tag(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R.Tag = related
+	return nil
+}
+
+// LoadTag loads the todoTag's Tag into the .R struct
+func (os TodoTagSlice) LoadTag(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tags, err := os.Tag(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tags {
+
+			if !(o.TagID == rel.ID) {
+				continue
+			}
+
+			o.R.Tag = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo loads the todoTag's Todo into the .R struct
+func (o *TodoTag) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todo = nil
+
+	related, err := o.Todo(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R.Todo = related
+	return nil
+}
+
+// LoadTodo loads the todoTag's Todo into the .R struct
+func (os TodoTagSlice) LoadTodo(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todo(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.TodoID == rel.ID) {
+				continue
+			}
+
+			o.R.Todo = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type todoTagJoins[Q dialect.Joinable] struct {
+	typ  string
+	Tag  modAs[Q, tagColumns]
+	Todo modAs[Q, todoColumns]
+}
+
+func (j todoTagJoins[Q]) aliasedAs(alias string) todoTagJoins[Q] {
+	return buildTodoTagJoins[Q](buildTodoTagColumns(alias), j.typ)
+}
+
+func buildTodoTagJoins[Q dialect.Joinable](cols todoTagColumns, typ string) todoTagJoins[Q] {
+	return todoTagJoins[Q]{
+		typ: typ,
+		Tag: modAs[Q, tagColumns]{
+			c: Tags.Columns,
+			f: func(to tagColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tags.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TagID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todo: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TodoID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -5,10 +5,14 @@ package models
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"strconv"
 	"time"
 
+	"github.com/aarondl/opt/null"
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/sqlite"
 	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
@@ -16,15 +20,32 @@ import (
 	"github.com/stephenafamo/bob/dialect/sqlite/sm"
 	"github.com/stephenafamo/bob/dialect/sqlite/um"
 	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/scan"
 )
 
 // Todo is an object representing the database table.
 type Todo struct {
-	ID        int64     `db:"id,pk" `
-	Title     string    `db:"title" `
-	Completed bool      `db:"completed" `
-	CreatedAt time.Time `db:"created_at" `
-	UpdatedAt time.Time `db:"updated_at" `
+	ID          int64               `db:"id,pk" `
+	UserID      int64               `db:"user_id" `
+	Title       string              `db:"title" `
+	Completed   bool                `db:"completed" `
+	CreatedAt   time.Time           `db:"created_at" `
+	UpdatedAt   time.Time           `db:"updated_at" `
+	ListID      null.Val[int64]     `db:"list_id" `
+	DueAt       null.Val[time.Time] `db:"due_at" `
+	Priority    int64               `db:"priority" `
+	ParentID    null.Val[int64]     `db:"parent_id" `
+	Recurrence  null.Val[string]    `db:"recurrence" `
+	Notes       string              `db:"notes" `
+	Position    string              `db:"position" `
+	DeletedAt   null.Val[time.Time] `db:"deleted_at" `
+	StatusID    null.Val[int64]     `db:"status_id" `
+	AssigneeID  null.Val[int64]     `db:"assignee_id" `
+	CompletedAt null.Val[time.Time] `db:"completed_at" `
+
+	R todoR `db:"-" `
 }
 
 // TodoSlice is an alias for a slice of pointers to Todo.
@@ -37,28 +58,70 @@ var Todos = sqlite.NewTablex[*Todo, TodoSlice, *TodoSetter]("", "todos", buildTo
 // TodosQuery is a query on the todos table
 type TodosQuery = *sqlite.ViewQuery[*Todo, TodoSlice]
 
+// todoR is where relationships are stored.
+type todoR struct {
+	Attachments   AttachmentSlice     // fk_attachments_1
+	Comments      CommentSlice        // fk_comments_2
+	Notifications NotificationSlice   // fk_notifications_1
+	TimeEntries   TimeEntrySlice      // fk_time_entries_1
+	Dependents    TodoDependencySlice // fk_todo_dependencies_0
+	Dependencies  TodoDependencySlice // fk_todo_dependencies_1
+	TodoEvents    TodoEventSlice      // fk_todo_events_1
+	Tags          TagSlice            // fk_todo_tags_0fk_todo_tags_1
+	Assignee      *User               // fk_todos_0
+	Status        *ListStatus         // fk_todos_1
+	Parent        *Todo               // fk_todos_2
+	Children      TodoSlice           // fk_todos_2__self_join_reverse
+	List          *List               // fk_todos_3
+	User          *User               // fk_todos_4
+}
+
 func buildTodoColumns(alias string) todoColumns {
 	return todoColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "title", "completed", "created_at", "updated_at",
+			"id", "user_id", "title", "completed", "created_at", "updated_at", "list_id", "due_at", "priority", "parent_id", "recurrence", "notes", "position", "deleted_at", "status_id", "assignee_id", "completed_at",
 		).WithParent("todos"),
-		tableAlias: alias,
-		ID:         sqlite.Quote(alias, "id"),
-		Title:      sqlite.Quote(alias, "title"),
-		Completed:  sqlite.Quote(alias, "completed"),
-		CreatedAt:  sqlite.Quote(alias, "created_at"),
-		UpdatedAt:  sqlite.Quote(alias, "updated_at"),
+		tableAlias:  alias,
+		ID:          sqlite.Quote(alias, "id"),
+		UserID:      sqlite.Quote(alias, "user_id"),
+		Title:       sqlite.Quote(alias, "title"),
+		Completed:   sqlite.Quote(alias, "completed"),
+		CreatedAt:   sqlite.Quote(alias, "created_at"),
+		UpdatedAt:   sqlite.Quote(alias, "updated_at"),
+		ListID:      sqlite.Quote(alias, "list_id"),
+		DueAt:       sqlite.Quote(alias, "due_at"),
+		Priority:    sqlite.Quote(alias, "priority"),
+		ParentID:    sqlite.Quote(alias, "parent_id"),
+		Recurrence:  sqlite.Quote(alias, "recurrence"),
+		Notes:       sqlite.Quote(alias, "notes"),
+		Position:    sqlite.Quote(alias, "position"),
+		DeletedAt:   sqlite.Quote(alias, "deleted_at"),
+		StatusID:    sqlite.Quote(alias, "status_id"),
+		AssigneeID:  sqlite.Quote(alias, "assignee_id"),
+		CompletedAt: sqlite.Quote(alias, "completed_at"),
 	}
 }
 
 type todoColumns struct {
 	expr.ColumnsExpr
-	tableAlias string
-	ID         sqlite.Expression
-	Title      sqlite.Expression
-	Completed  sqlite.Expression
-	CreatedAt  sqlite.Expression
-	UpdatedAt  sqlite.Expression
+	tableAlias  string
+	ID          sqlite.Expression
+	UserID      sqlite.Expression
+	Title       sqlite.Expression
+	Completed   sqlite.Expression
+	CreatedAt   sqlite.Expression
+	UpdatedAt   sqlite.Expression
+	ListID      sqlite.Expression
+	DueAt       sqlite.Expression
+	Priority    sqlite.Expression
+	ParentID    sqlite.Expression
+	Recurrence  sqlite.Expression
+	Notes       sqlite.Expression
+	Position    sqlite.Expression
+	DeletedAt   sqlite.Expression
+	StatusID    sqlite.Expression
+	AssigneeID  sqlite.Expression
+	CompletedAt sqlite.Expression
 }
 
 func (c todoColumns) Alias() string {
@@ -73,18 +136,33 @@ func (todoColumns) AliasedAs(alias string) todoColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type TodoSetter struct {
-	ID        omit.Val[int64]     `db:"id,pk" `
-	Title     omit.Val[string]    `db:"title" `
-	Completed omit.Val[bool]      `db:"completed" `
-	CreatedAt omit.Val[time.Time] `db:"created_at" `
-	UpdatedAt omit.Val[time.Time] `db:"updated_at" `
+	ID          omit.Val[int64]         `db:"id,pk" `
+	UserID      omit.Val[int64]         `db:"user_id" `
+	Title       omit.Val[string]        `db:"title" `
+	Completed   omit.Val[bool]          `db:"completed" `
+	CreatedAt   omit.Val[time.Time]     `db:"created_at" `
+	UpdatedAt   omit.Val[time.Time]     `db:"updated_at" `
+	ListID      omitnull.Val[int64]     `db:"list_id" `
+	DueAt       omitnull.Val[time.Time] `db:"due_at" `
+	Priority    omit.Val[int64]         `db:"priority" `
+	ParentID    omitnull.Val[int64]     `db:"parent_id" `
+	Recurrence  omitnull.Val[string]    `db:"recurrence" `
+	Notes       omit.Val[string]        `db:"notes" `
+	Position    omit.Val[string]        `db:"position" `
+	DeletedAt   omitnull.Val[time.Time] `db:"deleted_at" `
+	StatusID    omitnull.Val[int64]     `db:"status_id" `
+	AssigneeID  omitnull.Val[int64]     `db:"assignee_id" `
+	CompletedAt omitnull.Val[time.Time] `db:"completed_at" `
 }
 
 func (s TodoSetter) SetColumns() []string {
-	vals := make([]string, 0, 5)
+	vals := make([]string, 0, 17)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
 	if s.Title.IsValue() {
 		vals = append(vals, "title")
 	}
@@ -97,6 +175,39 @@ func (s TodoSetter) SetColumns() []string {
 	if s.UpdatedAt.IsValue() {
 		vals = append(vals, "updated_at")
 	}
+	if !s.ListID.IsUnset() {
+		vals = append(vals, "list_id")
+	}
+	if !s.DueAt.IsUnset() {
+		vals = append(vals, "due_at")
+	}
+	if s.Priority.IsValue() {
+		vals = append(vals, "priority")
+	}
+	if !s.ParentID.IsUnset() {
+		vals = append(vals, "parent_id")
+	}
+	if !s.Recurrence.IsUnset() {
+		vals = append(vals, "recurrence")
+	}
+	if s.Notes.IsValue() {
+		vals = append(vals, "notes")
+	}
+	if s.Position.IsValue() {
+		vals = append(vals, "position")
+	}
+	if !s.DeletedAt.IsUnset() {
+		vals = append(vals, "deleted_at")
+	}
+	if !s.StatusID.IsUnset() {
+		vals = append(vals, "status_id")
+	}
+	if !s.AssigneeID.IsUnset() {
+		vals = append(vals, "assignee_id")
+	}
+	if !s.CompletedAt.IsUnset() {
+		vals = append(vals, "completed_at")
+	}
 	return vals
 }
 
@@ -104,6 +215,9 @@ func (s TodoSetter) Overwrite(t *Todo) {
 	if s.ID.IsValue() {
 		t.ID = s.ID.MustGet()
 	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
 	if s.Title.IsValue() {
 		t.Title = s.Title.MustGet()
 	}
@@ -116,6 +230,39 @@ func (s TodoSetter) Overwrite(t *Todo) {
 	if s.UpdatedAt.IsValue() {
 		t.UpdatedAt = s.UpdatedAt.MustGet()
 	}
+	if !s.ListID.IsUnset() {
+		t.ListID = s.ListID.MustGetNull()
+	}
+	if !s.DueAt.IsUnset() {
+		t.DueAt = s.DueAt.MustGetNull()
+	}
+	if s.Priority.IsValue() {
+		t.Priority = s.Priority.MustGet()
+	}
+	if !s.ParentID.IsUnset() {
+		t.ParentID = s.ParentID.MustGetNull()
+	}
+	if !s.Recurrence.IsUnset() {
+		t.Recurrence = s.Recurrence.MustGetNull()
+	}
+	if s.Notes.IsValue() {
+		t.Notes = s.Notes.MustGet()
+	}
+	if s.Position.IsValue() {
+		t.Position = s.Position.MustGet()
+	}
+	if !s.DeletedAt.IsUnset() {
+		t.DeletedAt = s.DeletedAt.MustGetNull()
+	}
+	if !s.StatusID.IsUnset() {
+		t.StatusID = s.StatusID.MustGetNull()
+	}
+	if !s.AssigneeID.IsUnset() {
+		t.AssigneeID = s.AssigneeID.MustGetNull()
+	}
+	if !s.CompletedAt.IsUnset() {
+		t.CompletedAt = s.CompletedAt.MustGetNull()
+	}
 }
 
 func (s *TodoSetter) Apply(q *dialect.InsertQuery) {
@@ -132,11 +279,15 @@ func (s *TodoSetter) Apply(q *dialect.InsertQuery) {
 	}
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 0, 5)
+		vals := make([]bob.Expression, 0, 17)
 		if s.ID.IsValue() {
 			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
 		}
 
+		if s.UserID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.UserID.MustGet()))
+		}
+
 		if s.Title.IsValue() {
 			vals = append(vals, sqlite.Arg(s.Title.MustGet()))
 		}
@@ -153,6 +304,50 @@ func (s *TodoSetter) Apply(q *dialect.InsertQuery) {
 			vals = append(vals, sqlite.Arg(s.UpdatedAt.MustGet()))
 		}
 
+		if !s.ListID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.ListID.MustGetNull()))
+		}
+
+		if !s.DueAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.DueAt.MustGetNull()))
+		}
+
+		if s.Priority.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Priority.MustGet()))
+		}
+
+		if !s.ParentID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.ParentID.MustGetNull()))
+		}
+
+		if !s.Recurrence.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.Recurrence.MustGetNull()))
+		}
+
+		if s.Notes.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Notes.MustGet()))
+		}
+
+		if s.Position.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Position.MustGet()))
+		}
+
+		if !s.DeletedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.DeletedAt.MustGetNull()))
+		}
+
+		if !s.StatusID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.StatusID.MustGetNull()))
+		}
+
+		if !s.AssigneeID.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.AssigneeID.MustGetNull()))
+		}
+
+		if !s.CompletedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.CompletedAt.MustGetNull()))
+		}
+
 		if len(vals) == 0 {
 			vals = append(vals, sqlite.Arg(nil))
 		}
@@ -166,7 +361,7 @@ func (s TodoSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s TodoSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 5)
+	exprs := make([]bob.Expression, 0, 17)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -175,6 +370,13 @@ func (s TodoSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "user_id")...),
+			sqlite.Arg(s.UserID),
+		}})
+	}
+
 	if s.Title.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
 			sqlite.Quote(append(prefix, "title")...),
@@ -203,6 +405,83 @@ func (s TodoSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.ListID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "list_id")...),
+			sqlite.Arg(s.ListID),
+		}})
+	}
+
+	if !s.DueAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "due_at")...),
+			sqlite.Arg(s.DueAt),
+		}})
+	}
+
+	if s.Priority.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "priority")...),
+			sqlite.Arg(s.Priority),
+		}})
+	}
+
+	if !s.ParentID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "parent_id")...),
+			sqlite.Arg(s.ParentID),
+		}})
+	}
+
+	if !s.Recurrence.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "recurrence")...),
+			sqlite.Arg(s.Recurrence),
+		}})
+	}
+
+	if s.Notes.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "notes")...),
+			sqlite.Arg(s.Notes),
+		}})
+	}
+
+	if s.Position.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "position")...),
+			sqlite.Arg(s.Position),
+		}})
+	}
+
+	if !s.DeletedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "deleted_at")...),
+			sqlite.Arg(s.DeletedAt),
+		}})
+	}
+
+	if !s.StatusID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "status_id")...),
+			sqlite.Arg(s.StatusID),
+		}})
+	}
+
+	if !s.AssigneeID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "assignee_id")...),
+			sqlite.Arg(s.AssigneeID),
+		}})
+	}
+
+	if !s.CompletedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "completed_at")...),
+			sqlite.Arg(s.CompletedAt),
+		}})
+	}
+
 	return exprs
 }
 
@@ -264,6 +543,7 @@ func (o *Todo) Update(ctx context.Context, exec bob.Executor, s *TodoSetter) err
 		return err
 	}
 
+	o.R = v.R
 	*o = *v
 
 	return nil
@@ -283,7 +563,7 @@ func (o *Todo) Reload(ctx context.Context, exec bob.Executor) error {
 	if err != nil {
 		return err
 	}
-
+	o2.R = o.R
 	*o = *o2
 
 	return nil
@@ -330,7 +610,7 @@ func (o TodoSlice) copyMatchingRows(from ...*Todo) {
 			if new.ID != old.ID {
 				continue
 			}
-
+			new.R = old.R
 			o[i] = new
 			break
 		}
@@ -428,24 +708,2668 @@ func (o TodoSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
-type todoWhere[Q sqlite.Filterable] struct {
-	ID        sqlite.WhereMod[Q, int64]
-	Title     sqlite.WhereMod[Q, string]
-	Completed sqlite.WhereMod[Q, bool]
-	CreatedAt sqlite.WhereMod[Q, time.Time]
-	UpdatedAt sqlite.WhereMod[Q, time.Time]
+// Attachments starts a query for related objects on attachments
+func (o *Todo) Attachments(mods ...bob.Mod[*dialect.SelectQuery]) AttachmentsQuery {
+	return Attachments.Query(append(mods,
+		sm.Where(Attachments.Columns.TodoID.EQ(sqlite.Arg(o.ID))),
+	)...)
 }
 
-func (todoWhere[Q]) AliasedAs(alias string) todoWhere[Q] {
-	return buildTodoWhere[Q](buildTodoColumns(alias))
+func (os TodoSlice) Attachments(mods ...bob.Mod[*dialect.SelectQuery]) AttachmentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Attachments.Query(append(mods,
+		sm.Where(sqlite.Group(Attachments.Columns.TodoID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func buildTodoWhere[Q sqlite.Filterable](cols todoColumns) todoWhere[Q] {
-	return todoWhere[Q]{
-		ID:        sqlite.Where[Q, int64](cols.ID),
-		Title:     sqlite.Where[Q, string](cols.Title),
-		Completed: sqlite.Where[Q, bool](cols.Completed),
-		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
-		UpdatedAt: sqlite.Where[Q, time.Time](cols.UpdatedAt),
+// Comments starts a query for related objects on comments
+func (o *Todo) Comments(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	return Comments.Query(append(mods,
+		sm.Where(Comments.Columns.TodoID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) Comments(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Comments.Query(append(mods,
+		sm.Where(sqlite.Group(Comments.Columns.TodoID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Notifications starts a query for related objects on notifications
+func (o *Todo) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.TodoID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Notifications.Query(append(mods,
+		sm.Where(sqlite.Group(Notifications.Columns.TodoID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TimeEntries starts a query for related objects on time_entries
+func (o *Todo) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	return TimeEntries.Query(append(mods,
+		sm.Where(TimeEntries.Columns.TodoID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return TimeEntries.Query(append(mods,
+		sm.Where(sqlite.Group(TimeEntries.Columns.TodoID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Dependents starts a query for related objects on todo_dependencies
+func (o *Todo) Dependents(mods ...bob.Mod[*dialect.SelectQuery]) TodoDependenciesQuery {
+	return TodoDependencies.Query(append(mods,
+		sm.Where(TodoDependencies.Columns.BlockerID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) Dependents(mods ...bob.Mod[*dialect.SelectQuery]) TodoDependenciesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return TodoDependencies.Query(append(mods,
+		sm.Where(sqlite.Group(TodoDependencies.Columns.BlockerID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Dependencies starts a query for related objects on todo_dependencies
+func (o *Todo) Dependencies(mods ...bob.Mod[*dialect.SelectQuery]) TodoDependenciesQuery {
+	return TodoDependencies.Query(append(mods,
+		sm.Where(TodoDependencies.Columns.TodoID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) Dependencies(mods ...bob.Mod[*dialect.SelectQuery]) TodoDependenciesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return TodoDependencies.Query(append(mods,
+		sm.Where(sqlite.Group(TodoDependencies.Columns.TodoID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TodoEvents starts a query for related objects on todo_events
+func (o *Todo) TodoEvents(mods ...bob.Mod[*dialect.SelectQuery]) TodoEventsQuery {
+	return TodoEvents.Query(append(mods,
+		sm.Where(TodoEvents.Columns.TodoID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) TodoEvents(mods ...bob.Mod[*dialect.SelectQuery]) TodoEventsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return TodoEvents.Query(append(mods,
+		sm.Where(sqlite.Group(TodoEvents.Columns.TodoID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Tags starts a query for related objects on tags
+func (o *Todo) Tags(mods ...bob.Mod[*dialect.SelectQuery]) TagsQuery {
+	return Tags.Query(append(mods,
+		sm.InnerJoin(TodoTags.NameAs()).On(
+			Tags.Columns.ID.EQ(TodoTags.Columns.TagID)),
+		sm.Where(TodoTags.Columns.TodoID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) Tags(mods ...bob.Mod[*dialect.SelectQuery]) TagsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Tags.Query(append(mods,
+		sm.InnerJoin(TodoTags.NameAs()).On(
+			Tags.Columns.ID.EQ(TodoTags.Columns.TagID),
+		),
+		sm.Where(sqlite.Group(TodoTags.Columns.TodoID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Assignee starts a query for related objects on users
+func (o *Todo) Assignee(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.AssigneeID))),
+	)...)
+}
+
+func (os TodoSlice) Assignee(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.AssigneeID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Status starts a query for related objects on list_statuses
+func (o *Todo) Status(mods ...bob.Mod[*dialect.SelectQuery]) ListStatusesQuery {
+	return ListStatuses.Query(append(mods,
+		sm.Where(ListStatuses.Columns.ID.EQ(sqlite.Arg(o.StatusID))),
+	)...)
+}
+
+func (os TodoSlice) Status(mods ...bob.Mod[*dialect.SelectQuery]) ListStatusesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.StatusID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return ListStatuses.Query(append(mods,
+		sm.Where(sqlite.Group(ListStatuses.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Parent starts a query for related objects on todos
+func (o *Todo) Parent(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ID.EQ(sqlite.Arg(o.ParentID))),
+	)...)
+}
+
+func (os TodoSlice) Parent(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ParentID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Children starts a query for related objects on todos
+func (o *Todo) Children(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.ParentID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os TodoSlice) Children(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.ParentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// List starts a query for related objects on lists
+func (o *Todo) List(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	return Lists.Query(append(mods,
+		sm.Where(Lists.Columns.ID.EQ(sqlite.Arg(o.ListID))),
+	)...)
+}
+
+func (os TodoSlice) List(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ListID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Lists.Query(append(mods,
+		sm.Where(sqlite.Group(Lists.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Todo) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(sqlite.Arg(o.UserID))),
+	)...)
+}
+
+func (os TodoSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.UserID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Users.Query(append(mods,
+		sm.Where(sqlite.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertTodoAttachments0(ctx context.Context, exec bob.Executor, attachments1 []*AttachmentSetter, todo0 *Todo) (AttachmentSlice, error) {
+	for i := range attachments1 {
+		attachments1[i].TodoID = omit.From(todo0.ID)
+	}
+
+	ret, err := Attachments.Insert(bob.ToMods(attachments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoAttachments0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoAttachments0(ctx context.Context, exec bob.Executor, count int, attachments1 AttachmentSlice, todo0 *Todo) (AttachmentSlice, error) {
+	setter := &AttachmentSetter{
+		TodoID: omit.From(todo0.ID),
+	}
+
+	err := attachments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoAttachments0: %w", err)
+	}
+
+	return attachments1, nil
+}
+
+func (todo0 *Todo) InsertAttachments(ctx context.Context, exec bob.Executor, related ...*AttachmentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	attachments1, err := insertTodoAttachments0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Attachments = append(todo0.R.Attachments, attachments1...)
+
+	for _, rel := range attachments1 {
+		rel.R.Todo = todo0
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachAttachments(ctx context.Context, exec bob.Executor, related ...*Attachment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	attachments1 := AttachmentSlice(related)
+
+	_, err = attachTodoAttachments0(ctx, exec, len(related), attachments1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Attachments = append(todo0.R.Attachments, attachments1...)
+
+	for _, rel := range related {
+		rel.R.Todo = todo0
+	}
+
+	return nil
+}
+
+func insertTodoComments0(ctx context.Context, exec bob.Executor, comments1 []*CommentSetter, todo0 *Todo) (CommentSlice, error) {
+	for i := range comments1 {
+		comments1[i].TodoID = omit.From(todo0.ID)
+	}
+
+	ret, err := Comments.Insert(bob.ToMods(comments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoComments0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoComments0(ctx context.Context, exec bob.Executor, count int, comments1 CommentSlice, todo0 *Todo) (CommentSlice, error) {
+	setter := &CommentSetter{
+		TodoID: omit.From(todo0.ID),
+	}
+
+	err := comments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoComments0: %w", err)
+	}
+
+	return comments1, nil
+}
+
+func (todo0 *Todo) InsertComments(ctx context.Context, exec bob.Executor, related ...*CommentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	comments1, err := insertTodoComments0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Comments = append(todo0.R.Comments, comments1...)
+
+	for _, rel := range comments1 {
+		rel.R.Todo = todo0
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachComments(ctx context.Context, exec bob.Executor, related ...*Comment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	comments1 := CommentSlice(related)
+
+	_, err = attachTodoComments0(ctx, exec, len(related), comments1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Comments = append(todo0.R.Comments, comments1...)
+
+	for _, rel := range related {
+		rel.R.Todo = todo0
+	}
+
+	return nil
+}
+
+func insertTodoNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, todo0 *Todo) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].TodoID = omit.From(todo0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, todo0 *Todo) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		TodoID: omit.From(todo0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (todo0 *Todo) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertTodoNotifications0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Notifications = append(todo0.R.Notifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.Todo = todo0
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachTodoNotifications0(ctx, exec, len(related), notifications1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Notifications = append(todo0.R.Notifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.Todo = todo0
+	}
+
+	return nil
+}
+
+func insertTodoTimeEntries0(ctx context.Context, exec bob.Executor, timeEntries1 []*TimeEntrySetter, todo0 *Todo) (TimeEntrySlice, error) {
+	for i := range timeEntries1 {
+		timeEntries1[i].TodoID = omit.From(todo0.ID)
+	}
+
+	ret, err := TimeEntries.Insert(bob.ToMods(timeEntries1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoTimeEntries0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoTimeEntries0(ctx context.Context, exec bob.Executor, count int, timeEntries1 TimeEntrySlice, todo0 *Todo) (TimeEntrySlice, error) {
+	setter := &TimeEntrySetter{
+		TodoID: omit.From(todo0.ID),
+	}
+
+	err := timeEntries1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoTimeEntries0: %w", err)
+	}
+
+	return timeEntries1, nil
+}
+
+func (todo0 *Todo) InsertTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntrySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	timeEntries1, err := insertTodoTimeEntries0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.TimeEntries = append(todo0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range timeEntries1 {
+		rel.R.Todo = todo0
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntry) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	timeEntries1 := TimeEntrySlice(related)
+
+	_, err = attachTodoTimeEntries0(ctx, exec, len(related), timeEntries1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.TimeEntries = append(todo0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range related {
+		rel.R.Todo = todo0
+	}
+
+	return nil
+}
+
+func insertTodoDependents0(ctx context.Context, exec bob.Executor, todoDependencies1 []*TodoDependencySetter, todo0 *Todo) (TodoDependencySlice, error) {
+	for i := range todoDependencies1 {
+		todoDependencies1[i].BlockerID = omit.From(todo0.ID)
+	}
+
+	ret, err := TodoDependencies.Insert(bob.ToMods(todoDependencies1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoDependents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoDependents0(ctx context.Context, exec bob.Executor, count int, todoDependencies1 TodoDependencySlice, todo0 *Todo) (TodoDependencySlice, error) {
+	setter := &TodoDependencySetter{
+		BlockerID: omit.From(todo0.ID),
+	}
+
+	err := todoDependencies1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoDependents0: %w", err)
+	}
+
+	return todoDependencies1, nil
+}
+
+func (todo0 *Todo) InsertDependents(ctx context.Context, exec bob.Executor, related ...*TodoDependencySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todoDependencies1, err := insertTodoDependents0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Dependents = append(todo0.R.Dependents, todoDependencies1...)
+
+	for _, rel := range todoDependencies1 {
+		rel.R.Blocker = todo0
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachDependents(ctx context.Context, exec bob.Executor, related ...*TodoDependency) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todoDependencies1 := TodoDependencySlice(related)
+
+	_, err = attachTodoDependents0(ctx, exec, len(related), todoDependencies1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Dependents = append(todo0.R.Dependents, todoDependencies1...)
+
+	for _, rel := range related {
+		rel.R.Blocker = todo0
+	}
+
+	return nil
+}
+
+func insertTodoDependencies0(ctx context.Context, exec bob.Executor, todoDependencies1 []*TodoDependencySetter, todo0 *Todo) (TodoDependencySlice, error) {
+	for i := range todoDependencies1 {
+		todoDependencies1[i].TodoID = omit.From(todo0.ID)
+	}
+
+	ret, err := TodoDependencies.Insert(bob.ToMods(todoDependencies1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoDependencies0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoDependencies0(ctx context.Context, exec bob.Executor, count int, todoDependencies1 TodoDependencySlice, todo0 *Todo) (TodoDependencySlice, error) {
+	setter := &TodoDependencySetter{
+		TodoID: omit.From(todo0.ID),
+	}
+
+	err := todoDependencies1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoDependencies0: %w", err)
+	}
+
+	return todoDependencies1, nil
+}
+
+func (todo0 *Todo) InsertDependencies(ctx context.Context, exec bob.Executor, related ...*TodoDependencySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todoDependencies1, err := insertTodoDependencies0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Dependencies = append(todo0.R.Dependencies, todoDependencies1...)
+
+	for _, rel := range todoDependencies1 {
+		rel.R.Todo = todo0
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachDependencies(ctx context.Context, exec bob.Executor, related ...*TodoDependency) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todoDependencies1 := TodoDependencySlice(related)
+
+	_, err = attachTodoDependencies0(ctx, exec, len(related), todoDependencies1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Dependencies = append(todo0.R.Dependencies, todoDependencies1...)
+
+	for _, rel := range related {
+		rel.R.Todo = todo0
+	}
+
+	return nil
+}
+
+func insertTodoTodoEvents0(ctx context.Context, exec bob.Executor, todoEvents1 []*TodoEventSetter, todo0 *Todo) (TodoEventSlice, error) {
+	for i := range todoEvents1 {
+		todoEvents1[i].TodoID = omit.From(todo0.ID)
+	}
+
+	ret, err := TodoEvents.Insert(bob.ToMods(todoEvents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoTodoEvents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoTodoEvents0(ctx context.Context, exec bob.Executor, count int, todoEvents1 TodoEventSlice, todo0 *Todo) (TodoEventSlice, error) {
+	setter := &TodoEventSetter{
+		TodoID: omit.From(todo0.ID),
+	}
+
+	err := todoEvents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoTodoEvents0: %w", err)
+	}
+
+	return todoEvents1, nil
+}
+
+func (todo0 *Todo) InsertTodoEvents(ctx context.Context, exec bob.Executor, related ...*TodoEventSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todoEvents1, err := insertTodoTodoEvents0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.TodoEvents = append(todo0.R.TodoEvents, todoEvents1...)
+
+	for _, rel := range todoEvents1 {
+		rel.R.Todo = todo0
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachTodoEvents(ctx context.Context, exec bob.Executor, related ...*TodoEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todoEvents1 := TodoEventSlice(related)
+
+	_, err = attachTodoTodoEvents0(ctx, exec, len(related), todoEvents1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.TodoEvents = append(todo0.R.TodoEvents, todoEvents1...)
+
+	for _, rel := range related {
+		rel.R.Todo = todo0
+	}
+
+	return nil
+}
+
+func attachTodoTags0(ctx context.Context, exec bob.Executor, count int, todo0 *Todo, tags2 TagSlice) (TodoTagSlice, error) {
+	setters := make([]*TodoTagSetter, count)
+	for i := range count {
+		setters[i] = &TodoTagSetter{
+			TodoID: omit.From(todo0.ID),
+			TagID:  omit.From(tags2[i].ID),
+		}
+	}
+
+	todoTags1, err := TodoTags.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoTags0: %w", err)
+	}
+
+	return todoTags1, nil
+}
+
+func (todo0 *Todo) InsertTags(ctx context.Context, exec bob.Executor, related ...*TagSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Tags.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	tags2 := TagSlice(inserted)
+
+	_, err = attachTodoTags0(ctx, exec, len(related), todo0, tags2)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Tags = append(todo0.R.Tags, tags2...)
+
+	for _, rel := range tags2 {
+		rel.R.Todos = append(rel.R.Todos, todo0)
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachTags(ctx context.Context, exec bob.Executor, related ...*Tag) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	tags2 := TagSlice(related)
+
+	_, err = attachTodoTags0(ctx, exec, len(related), todo0, tags2)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Tags = append(todo0.R.Tags, tags2...)
+
+	for _, rel := range related {
+		rel.R.Todos = append(rel.R.Todos, todo0)
+	}
+
+	return nil
+}
+
+func attachTodoAssignee0(ctx context.Context, exec bob.Executor, count int, todo0 *Todo, user1 *User) (*Todo, error) {
+	setter := &TodoSetter{
+		AssigneeID: omitnull.From(user1.ID),
+	}
+
+	err := todo0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoAssignee0: %w", err)
+	}
+
+	return todo0, nil
+}
+
+func (todo0 *Todo) InsertAssignee(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoAssignee0(ctx, exec, 1, todo0, user1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Assignee = user1
+
+	user1.R.AssignedTodos = append(user1.R.AssignedTodos, todo0)
+
+	return nil
+}
+
+func (todo0 *Todo) AttachAssignee(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachTodoAssignee0(ctx, exec, 1, todo0, user1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Assignee = user1
+
+	user1.R.AssignedTodos = append(user1.R.AssignedTodos, todo0)
+
+	return nil
+}
+
+func attachTodoStatus0(ctx context.Context, exec bob.Executor, count int, todo0 *Todo, listStatus1 *ListStatus) (*Todo, error) {
+	setter := &TodoSetter{
+		StatusID: omitnull.From(listStatus1.ID),
+	}
+
+	err := todo0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoStatus0: %w", err)
+	}
+
+	return todo0, nil
+}
+
+func (todo0 *Todo) InsertStatus(ctx context.Context, exec bob.Executor, related *ListStatusSetter) error {
+	var err error
+
+	listStatus1, err := ListStatuses.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoStatus0(ctx, exec, 1, todo0, listStatus1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Status = listStatus1
+
+	listStatus1.R.Todos = append(listStatus1.R.Todos, todo0)
+
+	return nil
+}
+
+func (todo0 *Todo) AttachStatus(ctx context.Context, exec bob.Executor, listStatus1 *ListStatus) error {
+	var err error
+
+	_, err = attachTodoStatus0(ctx, exec, 1, todo0, listStatus1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Status = listStatus1
+
+	listStatus1.R.Todos = append(listStatus1.R.Todos, todo0)
+
+	return nil
+}
+
+func attachTodoParent0(ctx context.Context, exec bob.Executor, count int, todo0 *Todo, todo1 *Todo) (*Todo, error) {
+	setter := &TodoSetter{
+		ParentID: omitnull.From(todo1.ID),
+	}
+
+	err := todo0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoParent0: %w", err)
+	}
+
+	return todo0, nil
+}
+
+func (todo0 *Todo) InsertParent(ctx context.Context, exec bob.Executor, related *TodoSetter) error {
+	var err error
+
+	todo1, err := Todos.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoParent0(ctx, exec, 1, todo0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Parent = todo1
+
+	todo1.R.Parent = todo0
+
+	return nil
+}
+
+func (todo0 *Todo) AttachParent(ctx context.Context, exec bob.Executor, todo1 *Todo) error {
+	var err error
+
+	_, err = attachTodoParent0(ctx, exec, 1, todo0, todo1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Parent = todo1
+
+	todo1.R.Parent = todo0
+
+	return nil
+}
+
+func insertTodoChildren0(ctx context.Context, exec bob.Executor, todos1 []*TodoSetter, todo0 *Todo) (TodoSlice, error) {
+	for i := range todos1 {
+		todos1[i].ParentID = omitnull.From(todo0.ID)
+	}
+
+	ret, err := Todos.Insert(bob.ToMods(todos1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTodoChildren0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTodoChildren0(ctx context.Context, exec bob.Executor, count int, todos1 TodoSlice, todo0 *Todo) (TodoSlice, error) {
+	setter := &TodoSetter{
+		ParentID: omitnull.From(todo0.ID),
+	}
+
+	err := todos1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoChildren0: %w", err)
+	}
+
+	return todos1, nil
+}
+
+func (todo0 *Todo) InsertChildren(ctx context.Context, exec bob.Executor, related ...*TodoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todos1, err := insertTodoChildren0(ctx, exec, related, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Children = append(todo0.R.Children, todos1...)
+
+	for _, rel := range todos1 {
+		rel.R.Children = append(rel.R.Children, todo0)
+	}
+	return nil
+}
+
+func (todo0 *Todo) AttachChildren(ctx context.Context, exec bob.Executor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todos1 := TodoSlice(related)
+
+	_, err = attachTodoChildren0(ctx, exec, len(related), todos1, todo0)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.Children = append(todo0.R.Children, todos1...)
+
+	for _, rel := range related {
+		rel.R.Children = append(rel.R.Children, todo0)
+	}
+
+	return nil
+}
+
+func attachTodoList0(ctx context.Context, exec bob.Executor, count int, todo0 *Todo, list1 *List) (*Todo, error) {
+	setter := &TodoSetter{
+		ListID: omitnull.From(list1.ID),
+	}
+
+	err := todo0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoList0: %w", err)
+	}
+
+	return todo0, nil
+}
+
+func (todo0 *Todo) InsertList(ctx context.Context, exec bob.Executor, related *ListSetter) error {
+	var err error
+
+	list1, err := Lists.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoList0(ctx, exec, 1, todo0, list1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.List = list1
+
+	list1.R.Todos = append(list1.R.Todos, todo0)
+
+	return nil
+}
+
+func (todo0 *Todo) AttachList(ctx context.Context, exec bob.Executor, list1 *List) error {
+	var err error
+
+	_, err = attachTodoList0(ctx, exec, 1, todo0, list1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.List = list1
+
+	list1.R.Todos = append(list1.R.Todos, todo0)
+
+	return nil
+}
+
+func attachTodoUser0(ctx context.Context, exec bob.Executor, count int, todo0 *Todo, user1 *User) (*Todo, error) {
+	setter := &TodoSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := todo0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTodoUser0: %w", err)
+	}
+
+	return todo0, nil
+}
+
+func (todo0 *Todo) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTodoUser0(ctx, exec, 1, todo0, user1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.User = user1
+
+	user1.R.Todos = append(user1.R.Todos, todo0)
+
+	return nil
+}
+
+func (todo0 *Todo) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachTodoUser0(ctx, exec, 1, todo0, user1)
+	if err != nil {
+		return err
+	}
+
+	todo0.R.User = user1
+
+	user1.R.Todos = append(user1.R.Todos, todo0)
+
+	return nil
+}
+
+type todoWhere[Q sqlite.Filterable] struct {
+	ID          sqlite.WhereMod[Q, int64]
+	UserID      sqlite.WhereMod[Q, int64]
+	Title       sqlite.WhereMod[Q, string]
+	Completed   sqlite.WhereMod[Q, bool]
+	CreatedAt   sqlite.WhereMod[Q, time.Time]
+	UpdatedAt   sqlite.WhereMod[Q, time.Time]
+	ListID      sqlite.WhereNullMod[Q, int64]
+	DueAt       sqlite.WhereNullMod[Q, time.Time]
+	Priority    sqlite.WhereMod[Q, int64]
+	ParentID    sqlite.WhereNullMod[Q, int64]
+	Recurrence  sqlite.WhereNullMod[Q, string]
+	Notes       sqlite.WhereMod[Q, string]
+	Position    sqlite.WhereMod[Q, string]
+	DeletedAt   sqlite.WhereNullMod[Q, time.Time]
+	StatusID    sqlite.WhereNullMod[Q, int64]
+	AssigneeID  sqlite.WhereNullMod[Q, int64]
+	CompletedAt sqlite.WhereNullMod[Q, time.Time]
+}
+
+func (todoWhere[Q]) AliasedAs(alias string) todoWhere[Q] {
+	return buildTodoWhere[Q](buildTodoColumns(alias))
+}
+
+func buildTodoWhere[Q sqlite.Filterable](cols todoColumns) todoWhere[Q] {
+	return todoWhere[Q]{
+		ID:          sqlite.Where[Q, int64](cols.ID),
+		UserID:      sqlite.Where[Q, int64](cols.UserID),
+		Title:       sqlite.Where[Q, string](cols.Title),
+		Completed:   sqlite.Where[Q, bool](cols.Completed),
+		CreatedAt:   sqlite.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:   sqlite.Where[Q, time.Time](cols.UpdatedAt),
+		ListID:      sqlite.WhereNull[Q, int64](cols.ListID),
+		DueAt:       sqlite.WhereNull[Q, time.Time](cols.DueAt),
+		Priority:    sqlite.Where[Q, int64](cols.Priority),
+		ParentID:    sqlite.WhereNull[Q, int64](cols.ParentID),
+		Recurrence:  sqlite.WhereNull[Q, string](cols.Recurrence),
+		Notes:       sqlite.Where[Q, string](cols.Notes),
+		Position:    sqlite.Where[Q, string](cols.Position),
+		DeletedAt:   sqlite.WhereNull[Q, time.Time](cols.DeletedAt),
+		StatusID:    sqlite.WhereNull[Q, int64](cols.StatusID),
+		AssigneeID:  sqlite.WhereNull[Q, int64](cols.AssigneeID),
+		CompletedAt: sqlite.WhereNull[Q, time.Time](cols.CompletedAt),
+	}
+}
+
+func (o *Todo) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Attachments":
+		rels, ok := retrieved.(AttachmentSlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Attachments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Todo = o
+			}
+		}
+		return nil
+	case "Comments":
+		rels, ok := retrieved.(CommentSlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Comments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Todo = o
+			}
+		}
+		return nil
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Todo = o
+			}
+		}
+		return nil
+	case "TimeEntries":
+		rels, ok := retrieved.(TimeEntrySlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TimeEntries = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Todo = o
+			}
+		}
+		return nil
+	case "Dependents":
+		rels, ok := retrieved.(TodoDependencySlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Dependents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Blocker = o
+			}
+		}
+		return nil
+	case "Dependencies":
+		rels, ok := retrieved.(TodoDependencySlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Dependencies = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Todo = o
+			}
+		}
+		return nil
+	case "TodoEvents":
+		rels, ok := retrieved.(TodoEventSlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TodoEvents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Todo = o
+			}
+		}
+		return nil
+	case "Tags":
+		rels, ok := retrieved.(TagSlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tags = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Todos = TodoSlice{o}
+			}
+		}
+		return nil
+	case "Assignee":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Assignee = rel
+
+		if rel != nil {
+			rel.R.AssignedTodos = TodoSlice{o}
+		}
+		return nil
+	case "Status":
+		rel, ok := retrieved.(*ListStatus)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Status = rel
+
+		if rel != nil {
+			rel.R.Todos = TodoSlice{o}
+		}
+		return nil
+	case "Parent":
+		rel, ok := retrieved.(*Todo)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Parent = rel
+
+		if rel != nil {
+			rel.R.Parent = o
+		}
+		return nil
+	case "Children":
+		rels, ok := retrieved.(TodoSlice)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Children = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Children = TodoSlice{o}
+			}
+		}
+		return nil
+	case "List":
+		rel, ok := retrieved.(*List)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.List = rel
+
+		if rel != nil {
+			rel.R.Todos = TodoSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("todo cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Todos = TodoSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("todo has no relationship %q", name)
+	}
+}
+
+type todoPreloader struct {
+	Assignee func(...sqlite.PreloadOption) sqlite.Preloader
+	Status   func(...sqlite.PreloadOption) sqlite.Preloader
+	Parent   func(...sqlite.PreloadOption) sqlite.Preloader
+	List     func(...sqlite.PreloadOption) sqlite.Preloader
+	User     func(...sqlite.PreloadOption) sqlite.Preloader
+}
+
+func buildTodoPreloader() todoPreloader {
+	return todoPreloader{
+		Assignee: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "Assignee",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Todos,
+						To:          Users,
+						FromColumns: []string{"assignee_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Status: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*ListStatus, ListStatusSlice](sqlite.PreloadRel{
+				Name: "Status",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Todos,
+						To:          ListStatuses,
+						FromColumns: []string{"status_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, ListStatuses.Columns.Names(), opts...)
+		},
+		Parent: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*Todo, TodoSlice](sqlite.PreloadRel{
+				Name: "Parent",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Todos,
+						To:          Todos,
+						FromColumns: []string{"parent_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Todos.Columns.Names(), opts...)
+		},
+		List: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*List, ListSlice](sqlite.PreloadRel{
+				Name: "List",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Todos,
+						To:          Lists,
+						FromColumns: []string{"list_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Lists.Columns.Names(), opts...)
+		},
+		User: func(opts ...sqlite.PreloadOption) sqlite.Preloader {
+			return sqlite.Preload[*User, UserSlice](sqlite.PreloadRel{
+				Name: "User",
+				Sides: []sqlite.PreloadSide{
+					{
+						From:        Todos,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type todoThenLoader[Q orm.Loadable] struct {
+	Attachments   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Comments      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Notifications func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TimeEntries   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Dependents    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Dependencies  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TodoEvents    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Tags          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Assignee      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Status        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Parent        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Children      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	List          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTodoThenLoader[Q orm.Loadable]() todoThenLoader[Q] {
+	type AttachmentsLoadInterface interface {
+		LoadAttachments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type CommentsLoadInterface interface {
+		LoadComments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TimeEntriesLoadInterface interface {
+		LoadTimeEntries(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type DependentsLoadInterface interface {
+		LoadDependents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type DependenciesLoadInterface interface {
+		LoadDependencies(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoEventsLoadInterface interface {
+		LoadTodoEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TagsLoadInterface interface {
+		LoadTags(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AssigneeLoadInterface interface {
+		LoadAssignee(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type StatusLoadInterface interface {
+		LoadStatus(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ParentLoadInterface interface {
+		LoadParent(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ChildrenLoadInterface interface {
+		LoadChildren(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ListLoadInterface interface {
+		LoadList(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return todoThenLoader[Q]{
+		Attachments: thenLoadBuilder[Q](
+			"Attachments",
+			func(ctx context.Context, exec bob.Executor, retrieved AttachmentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAttachments(ctx, exec, mods...)
+			},
+		),
+		Comments: thenLoadBuilder[Q](
+			"Comments",
+			func(ctx context.Context, exec bob.Executor, retrieved CommentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadComments(ctx, exec, mods...)
+			},
+		),
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+		TimeEntries: thenLoadBuilder[Q](
+			"TimeEntries",
+			func(ctx context.Context, exec bob.Executor, retrieved TimeEntriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTimeEntries(ctx, exec, mods...)
+			},
+		),
+		Dependents: thenLoadBuilder[Q](
+			"Dependents",
+			func(ctx context.Context, exec bob.Executor, retrieved DependentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadDependents(ctx, exec, mods...)
+			},
+		),
+		Dependencies: thenLoadBuilder[Q](
+			"Dependencies",
+			func(ctx context.Context, exec bob.Executor, retrieved DependenciesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadDependencies(ctx, exec, mods...)
+			},
+		),
+		TodoEvents: thenLoadBuilder[Q](
+			"TodoEvents",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoEventsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodoEvents(ctx, exec, mods...)
+			},
+		),
+		Tags: thenLoadBuilder[Q](
+			"Tags",
+			func(ctx context.Context, exec bob.Executor, retrieved TagsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTags(ctx, exec, mods...)
+			},
+		),
+		Assignee: thenLoadBuilder[Q](
+			"Assignee",
+			func(ctx context.Context, exec bob.Executor, retrieved AssigneeLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAssignee(ctx, exec, mods...)
+			},
+		),
+		Status: thenLoadBuilder[Q](
+			"Status",
+			func(ctx context.Context, exec bob.Executor, retrieved StatusLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadStatus(ctx, exec, mods...)
+			},
+		),
+		Parent: thenLoadBuilder[Q](
+			"Parent",
+			func(ctx context.Context, exec bob.Executor, retrieved ParentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadParent(ctx, exec, mods...)
+			},
+		),
+		Children: thenLoadBuilder[Q](
+			"Children",
+			func(ctx context.Context, exec bob.Executor, retrieved ChildrenLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadChildren(ctx, exec, mods...)
+			},
+		),
+		List: thenLoadBuilder[Q](
+			"List",
+			func(ctx context.Context, exec bob.Executor, retrieved ListLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadList(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAttachments loads the todo's Attachments into the .R struct
+func (o *Todo) LoadAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Attachments = nil
+
+	related, err := o.Attachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Todo = o
+	}
+
+	o.R.Attachments = related
+	return nil
+}
+
+// LoadAttachments loads the todo's Attachments into the .R struct
+func (os TodoSlice) LoadAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	attachments, err := os.Attachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Attachments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range attachments {
+
+			if !(o.ID == rel.TodoID) {
+				continue
+			}
+
+			rel.R.Todo = o
+
+			o.R.Attachments = append(o.R.Attachments, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadComments loads the todo's Comments into the .R struct
+func (o *Todo) LoadComments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Comments = nil
+
+	related, err := o.Comments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Todo = o
+	}
+
+	o.R.Comments = related
+	return nil
+}
+
+// LoadComments loads the todo's Comments into the .R struct
+func (os TodoSlice) LoadComments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	comments, err := os.Comments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Comments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range comments {
+
+			if !(o.ID == rel.TodoID) {
+				continue
+			}
+
+			rel.R.Todo = o
+
+			o.R.Comments = append(o.R.Comments, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications loads the todo's Notifications into the .R struct
+func (o *Todo) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Todo = o
+	}
+
+	o.R.Notifications = related
+	return nil
+}
+
+// LoadNotifications loads the todo's Notifications into the .R struct
+func (os TodoSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !(o.ID == rel.TodoID) {
+				continue
+			}
+
+			rel.R.Todo = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTimeEntries loads the todo's TimeEntries into the .R struct
+func (o *Todo) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TimeEntries = nil
+
+	related, err := o.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Todo = o
+	}
+
+	o.R.TimeEntries = related
+	return nil
+}
+
+// LoadTimeEntries loads the todo's TimeEntries into the .R struct
+func (os TodoSlice) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	timeEntries, err := os.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TimeEntries = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range timeEntries {
+
+			if !(o.ID == rel.TodoID) {
+				continue
+			}
+
+			rel.R.Todo = o
+
+			o.R.TimeEntries = append(o.R.TimeEntries, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadDependents loads the todo's Dependents into the .R struct
+func (o *Todo) LoadDependents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Dependents = nil
+
+	related, err := o.Dependents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Blocker = o
+	}
+
+	o.R.Dependents = related
+	return nil
+}
+
+// LoadDependents loads the todo's Dependents into the .R struct
+func (os TodoSlice) LoadDependents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todoDependencies, err := os.Dependents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Dependents = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todoDependencies {
+
+			if !(o.ID == rel.BlockerID) {
+				continue
+			}
+
+			rel.R.Blocker = o
+
+			o.R.Dependents = append(o.R.Dependents, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadDependencies loads the todo's Dependencies into the .R struct
+func (o *Todo) LoadDependencies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Dependencies = nil
+
+	related, err := o.Dependencies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Todo = o
+	}
+
+	o.R.Dependencies = related
+	return nil
+}
+
+// LoadDependencies loads the todo's Dependencies into the .R struct
+func (os TodoSlice) LoadDependencies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todoDependencies, err := os.Dependencies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Dependencies = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todoDependencies {
+
+			if !(o.ID == rel.TodoID) {
+				continue
+			}
+
+			rel.R.Todo = o
+
+			o.R.Dependencies = append(o.R.Dependencies, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoEvents loads the todo's TodoEvents into the .R struct
+func (o *Todo) LoadTodoEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TodoEvents = nil
+
+	related, err := o.TodoEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Todo = o
+	}
+
+	o.R.TodoEvents = related
+	return nil
+}
+
+// LoadTodoEvents loads the todo's TodoEvents into the .R struct
+func (os TodoSlice) LoadTodoEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todoEvents, err := os.TodoEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TodoEvents = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todoEvents {
+
+			if !(o.ID == rel.TodoID) {
+				continue
+			}
+
+			rel.R.Todo = o
+
+			o.R.TodoEvents = append(o.R.TodoEvents, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTags loads the todo's Tags into the .R struct
+func (o *Todo) LoadTags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tags = nil
+
+	related, err := o.Tags(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Todos = TodoSlice{o}
+	}
+
+	o.R.Tags = related
+	return nil
+}
+
+// LoadTags loads the todo's Tags into the .R struct
+func (os TodoSlice) LoadTags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Tags.Columns))
+	}
+
+	q := os.Tags(append(
+		mods,
+		sm.Columns(TodoTags.Columns.TodoID.As("related_todos.ID")),
+	)...)
+
+	IDSlice := []int64{}
+
+	mapper := scan.Mod(scan.StructMapper[*Tag](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(int64))
+				row.ScheduleScanByName("related_todos.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	tags, err := bob.Allx[bob.SliceTransformer[*Tag, TagSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Tags = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range tags {
+			if !(o.ID == IDSlice[i]) {
+				continue
+			}
+
+			rel.R.Todos = append(rel.R.Todos, o)
+
+			o.R.Tags = append(o.R.Tags, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAssignee loads the todo's Assignee into the .R struct
+func (o *Todo) LoadAssignee(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Assignee = nil
+
+	related, err := o.Assignee(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.AssignedTodos = TodoSlice{o}
+
+	o.R.Assignee = related
+	return nil
+}
+
+// LoadAssignee loads the todo's Assignee into the .R struct
+func (os TodoSlice) LoadAssignee(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.Assignee(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+			if !o.AssigneeID.IsValue() {
+				continue
+			}
+
+			if !(o.AssigneeID.IsValue() && o.AssigneeID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.AssignedTodos = append(rel.R.AssignedTodos, o)
+
+			o.R.Assignee = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadStatus loads the todo's Status into the .R struct
+func (o *Todo) LoadStatus(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Status = nil
+
+	related, err := o.Status(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Todos = TodoSlice{o}
+
+	o.R.Status = related
+	return nil
+}
+
+// LoadStatus loads the todo's Status into the .R struct
+func (os TodoSlice) LoadStatus(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	listStatuses, err := os.Status(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range listStatuses {
+			if !o.StatusID.IsValue() {
+				continue
+			}
+
+			if !(o.StatusID.IsValue() && o.StatusID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.Todos = append(rel.R.Todos, o)
+
+			o.R.Status = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadParent loads the todo's Parent into the .R struct
+func (o *Todo) LoadParent(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Parent = nil
+
+	related, err := o.Parent(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Parent = o
+
+	o.R.Parent = related
+	return nil
+}
+
+// LoadParent loads the todo's Parent into the .R struct
+func (os TodoSlice) LoadParent(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Parent(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+			if !o.ParentID.IsValue() {
+				continue
+			}
+
+			if !(o.ParentID.IsValue() && o.ParentID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.Parent = o
+
+			o.R.Parent = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadChildren loads the todo's Children into the .R struct
+func (o *Todo) LoadChildren(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Children = nil
+
+	related, err := o.Children(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Children = TodoSlice{o}
+	}
+
+	o.R.Children = related
+	return nil
+}
+
+// LoadChildren loads the todo's Children into the .R struct
+func (os TodoSlice) LoadChildren(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Children(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Children = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !rel.ParentID.IsValue() {
+				continue
+			}
+			if !(rel.ParentID.IsValue() && o.ID == rel.ParentID.MustGet()) {
+				continue
+			}
+
+			rel.R.Children = append(rel.R.Children, o)
+
+			o.R.Children = append(o.R.Children, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadList loads the todo's List into the .R struct
+func (o *Todo) LoadList(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.List = nil
+
+	related, err := o.List(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Todos = TodoSlice{o}
+
+	o.R.List = related
+	return nil
+}
+
+// LoadList loads the todo's List into the .R struct
+func (os TodoSlice) LoadList(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	lists, err := os.List(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range lists {
+			if !o.ListID.IsValue() {
+				continue
+			}
+
+			if !(o.ListID.IsValue() && o.ListID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.Todos = append(rel.R.Todos, o)
+
+			o.R.List = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the todo's User into the .R struct
+func (o *Todo) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Todos = TodoSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the todo's User into the .R struct
+func (os TodoSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Todos = append(rel.R.Todos, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type todoJoins[Q dialect.Joinable] struct {
+	typ           string
+	Attachments   modAs[Q, attachmentColumns]
+	Comments      modAs[Q, commentColumns]
+	Notifications modAs[Q, notificationColumns]
+	TimeEntries   modAs[Q, timeEntryColumns]
+	Dependents    modAs[Q, todoDependencyColumns]
+	Dependencies  modAs[Q, todoDependencyColumns]
+	TodoEvents    modAs[Q, todoEventColumns]
+	Tags          modAs[Q, tagColumns]
+	Assignee      modAs[Q, userColumns]
+	Status        modAs[Q, listStatusColumns]
+	Parent        modAs[Q, todoColumns]
+	Children      modAs[Q, todoColumns]
+	List          modAs[Q, listColumns]
+	User          modAs[Q, userColumns]
+}
+
+func (j todoJoins[Q]) aliasedAs(alias string) todoJoins[Q] {
+	return buildTodoJoins[Q](buildTodoColumns(alias), j.typ)
+}
+
+func buildTodoJoins[Q dialect.Joinable](cols todoColumns, typ string) todoJoins[Q] {
+	return todoJoins[Q]{
+		typ: typ,
+		Attachments: modAs[Q, attachmentColumns]{
+			c: Attachments.Columns,
+			f: func(to attachmentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Attachments.Name().As(to.Alias())).On(
+						to.TodoID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Comments: modAs[Q, commentColumns]{
+			c: Comments.Columns,
+			f: func(to commentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Comments.Name().As(to.Alias())).On(
+						to.TodoID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.TodoID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TimeEntries: modAs[Q, timeEntryColumns]{
+			c: TimeEntries.Columns,
+			f: func(to timeEntryColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TimeEntries.Name().As(to.Alias())).On(
+						to.TodoID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Dependents: modAs[Q, todoDependencyColumns]{
+			c: TodoDependencies.Columns,
+			f: func(to todoDependencyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TodoDependencies.Name().As(to.Alias())).On(
+						to.BlockerID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Dependencies: modAs[Q, todoDependencyColumns]{
+			c: TodoDependencies.Columns,
+			f: func(to todoDependencyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TodoDependencies.Name().As(to.Alias())).On(
+						to.TodoID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TodoEvents: modAs[Q, todoEventColumns]{
+			c: TodoEvents.Columns,
+			f: func(to todoEventColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TodoEvents.Name().As(to.Alias())).On(
+						to.TodoID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Tags: modAs[Q, tagColumns]{
+			c: Tags.Columns,
+			f: func(to tagColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := TodoTags.Columns.AliasedAs(TodoTags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, TodoTags.Name().As(to.Alias())).On(
+						to.TodoID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := TodoTags.Columns.AliasedAs(TodoTags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Tags.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TagID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Assignee: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.AssigneeID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Status: modAs[Q, listStatusColumns]{
+			c: ListStatuses.Columns,
+			f: func(to listStatusColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ListStatuses.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.StatusID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Parent: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ParentID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Children: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.ParentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		List: modAs[Q, listColumns]{
+			c: Lists.Columns,
+			f: func(to listColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Lists.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ListID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
 	}
 }
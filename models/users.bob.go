@@ -5,10 +5,12 @@ package models
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/sqlite"
 	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
@@ -16,15 +18,22 @@ import (
 	"github.com/stephenafamo/bob/dialect/sqlite/sm"
 	"github.com/stephenafamo/bob/dialect/sqlite/um"
 	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
 )
 
 // User is an object representing the database table.
 type User struct {
-	ID        int64     `db:"id,pk" `
-	Email     string    `db:"email" `
-	Password  string    `db:"password" `
-	CreatedAt time.Time `db:"created_at" `
-	UpdatedAt time.Time `db:"updated_at" `
+	ID          int64     `db:"id,pk" `
+	Email       string    `db:"email" `
+	Password    string    `db:"password" `
+	CreatedAt   time.Time `db:"created_at" `
+	UpdatedAt   time.Time `db:"updated_at" `
+	Timezone    string    `db:"timezone" `
+	TodoSort    string    `db:"todo_sort" `
+	TodoSortDir string    `db:"todo_sort_dir" `
+
+	R userR `db:"-" `
 }
 
 // UserSlice is an alias for a slice of pointers to User.
@@ -37,28 +46,50 @@ var Users = sqlite.NewTablex[*User, UserSlice, *UserSetter]("", "users", buildUs
 // UsersQuery is a query on the users table
 type UsersQuery = *sqlite.ViewQuery[*User, UserSlice]
 
+// userR is where relationships are stored.
+type userR struct {
+	Attachments        AttachmentSlice        // fk_attachments_0
+	ChecklistTemplates ChecklistTemplateSlice // fk_checklist_templates_0
+	Comments           CommentSlice           // fk_comments_1
+	ListMembers        ListMemberSlice        // fk_list_members_0
+	Lists              ListSlice              // fk_lists_0
+	ActorNotifications NotificationSlice      // fk_notifications_2
+	Notifications      NotificationSlice      // fk_notifications_3
+	Tags               TagSlice               // fk_tags_0
+	TimeEntries        TimeEntrySlice         // fk_time_entries_0
+	TodoEvents         TodoEventSlice         // fk_todo_events_0
+	AssignedTodos      TodoSlice              // fk_todos_0
+	Todos              TodoSlice              // fk_todos_4
+}
+
 func buildUserColumns(alias string) userColumns {
 	return userColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "email", "password", "created_at", "updated_at",
+			"id", "email", "password", "created_at", "updated_at", "timezone", "todo_sort", "todo_sort_dir",
 		).WithParent("users"),
-		tableAlias: alias,
-		ID:         sqlite.Quote(alias, "id"),
-		Email:      sqlite.Quote(alias, "email"),
-		Password:   sqlite.Quote(alias, "password"),
-		CreatedAt:  sqlite.Quote(alias, "created_at"),
-		UpdatedAt:  sqlite.Quote(alias, "updated_at"),
+		tableAlias:  alias,
+		ID:          sqlite.Quote(alias, "id"),
+		Email:       sqlite.Quote(alias, "email"),
+		Password:    sqlite.Quote(alias, "password"),
+		CreatedAt:   sqlite.Quote(alias, "created_at"),
+		UpdatedAt:   sqlite.Quote(alias, "updated_at"),
+		Timezone:    sqlite.Quote(alias, "timezone"),
+		TodoSort:    sqlite.Quote(alias, "todo_sort"),
+		TodoSortDir: sqlite.Quote(alias, "todo_sort_dir"),
 	}
 }
 
 type userColumns struct {
 	expr.ColumnsExpr
-	tableAlias string
-	ID         sqlite.Expression
-	Email      sqlite.Expression
-	Password   sqlite.Expression
-	CreatedAt  sqlite.Expression
-	UpdatedAt  sqlite.Expression
+	tableAlias  string
+	ID          sqlite.Expression
+	Email       sqlite.Expression
+	Password    sqlite.Expression
+	CreatedAt   sqlite.Expression
+	UpdatedAt   sqlite.Expression
+	Timezone    sqlite.Expression
+	TodoSort    sqlite.Expression
+	TodoSortDir sqlite.Expression
 }
 
 func (c userColumns) Alias() string {
@@ -73,15 +104,18 @@ func (userColumns) AliasedAs(alias string) userColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type UserSetter struct {
-	ID        omit.Val[int64]     `db:"id,pk" `
-	Email     omit.Val[string]    `db:"email" `
-	Password  omit.Val[string]    `db:"password" `
-	CreatedAt omit.Val[time.Time] `db:"created_at" `
-	UpdatedAt omit.Val[time.Time] `db:"updated_at" `
+	ID          omit.Val[int64]     `db:"id,pk" `
+	Email       omit.Val[string]    `db:"email" `
+	Password    omit.Val[string]    `db:"password" `
+	CreatedAt   omit.Val[time.Time] `db:"created_at" `
+	UpdatedAt   omit.Val[time.Time] `db:"updated_at" `
+	Timezone    omit.Val[string]    `db:"timezone" `
+	TodoSort    omit.Val[string]    `db:"todo_sort" `
+	TodoSortDir omit.Val[string]    `db:"todo_sort_dir" `
 }
 
 func (s UserSetter) SetColumns() []string {
-	vals := make([]string, 0, 5)
+	vals := make([]string, 0, 8)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -97,6 +131,15 @@ func (s UserSetter) SetColumns() []string {
 	if s.UpdatedAt.IsValue() {
 		vals = append(vals, "updated_at")
 	}
+	if s.Timezone.IsValue() {
+		vals = append(vals, "timezone")
+	}
+	if s.TodoSort.IsValue() {
+		vals = append(vals, "todo_sort")
+	}
+	if s.TodoSortDir.IsValue() {
+		vals = append(vals, "todo_sort_dir")
+	}
 	return vals
 }
 
@@ -116,6 +159,15 @@ func (s UserSetter) Overwrite(t *User) {
 	if s.UpdatedAt.IsValue() {
 		t.UpdatedAt = s.UpdatedAt.MustGet()
 	}
+	if s.Timezone.IsValue() {
+		t.Timezone = s.Timezone.MustGet()
+	}
+	if s.TodoSort.IsValue() {
+		t.TodoSort = s.TodoSort.MustGet()
+	}
+	if s.TodoSortDir.IsValue() {
+		t.TodoSortDir = s.TodoSortDir.MustGet()
+	}
 }
 
 func (s *UserSetter) Apply(q *dialect.InsertQuery) {
@@ -132,7 +184,7 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 	}
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 0, 5)
+		vals := make([]bob.Expression, 0, 8)
 		if s.ID.IsValue() {
 			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
 		}
@@ -153,6 +205,18 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 			vals = append(vals, sqlite.Arg(s.UpdatedAt.MustGet()))
 		}
 
+		if s.Timezone.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Timezone.MustGet()))
+		}
+
+		if s.TodoSort.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoSort.MustGet()))
+		}
+
+		if s.TodoSortDir.IsValue() {
+			vals = append(vals, sqlite.Arg(s.TodoSortDir.MustGet()))
+		}
+
 		if len(vals) == 0 {
 			vals = append(vals, sqlite.Arg(nil))
 		}
@@ -166,7 +230,7 @@ func (s UserSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 5)
+	exprs := make([]bob.Expression, 0, 8)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -203,6 +267,27 @@ func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.Timezone.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "timezone")...),
+			sqlite.Arg(s.Timezone),
+		}})
+	}
+
+	if s.TodoSort.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_sort")...),
+			sqlite.Arg(s.TodoSort),
+		}})
+	}
+
+	if s.TodoSortDir.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "todo_sort_dir")...),
+			sqlite.Arg(s.TodoSortDir),
+		}})
+	}
+
 	return exprs
 }
 
@@ -264,6 +349,7 @@ func (o *User) Update(ctx context.Context, exec bob.Executor, s *UserSetter) err
 		return err
 	}
 
+	o.R = v.R
 	*o = *v
 
 	return nil
@@ -283,7 +369,7 @@ func (o *User) Reload(ctx context.Context, exec bob.Executor) error {
 	if err != nil {
 		return err
 	}
-
+	o2.R = o.R
 	*o = *o2
 
 	return nil
@@ -330,7 +416,7 @@ func (o UserSlice) copyMatchingRows(from ...*User) {
 			if new.ID != old.ID {
 				continue
 			}
-
+			new.R = old.R
 			o[i] = new
 			break
 		}
@@ -428,24 +514,2320 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
-type userWhere[Q sqlite.Filterable] struct {
-	ID        sqlite.WhereMod[Q, int64]
-	Email     sqlite.WhereMod[Q, string]
-	Password  sqlite.WhereMod[Q, string]
-	CreatedAt sqlite.WhereMod[Q, time.Time]
-	UpdatedAt sqlite.WhereMod[Q, time.Time]
+// Attachments starts a query for related objects on attachments
+func (o *User) Attachments(mods ...bob.Mod[*dialect.SelectQuery]) AttachmentsQuery {
+	return Attachments.Query(append(mods,
+		sm.Where(Attachments.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
 }
 
-func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
-	return buildUserWhere[Q](buildUserColumns(alias))
+func (os UserSlice) Attachments(mods ...bob.Mod[*dialect.SelectQuery]) AttachmentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Attachments.Query(append(mods,
+		sm.Where(sqlite.Group(Attachments.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func buildUserWhere[Q sqlite.Filterable](cols userColumns) userWhere[Q] {
-	return userWhere[Q]{
-		ID:        sqlite.Where[Q, int64](cols.ID),
-		Email:     sqlite.Where[Q, string](cols.Email),
-		Password:  sqlite.Where[Q, string](cols.Password),
-		CreatedAt: sqlite.Where[Q, time.Time](cols.CreatedAt),
-		UpdatedAt: sqlite.Where[Q, time.Time](cols.UpdatedAt),
+// ChecklistTemplates starts a query for related objects on checklist_templates
+func (o *User) ChecklistTemplates(mods ...bob.Mod[*dialect.SelectQuery]) ChecklistTemplatesQuery {
+	return ChecklistTemplates.Query(append(mods,
+		sm.Where(ChecklistTemplates.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ChecklistTemplates(mods ...bob.Mod[*dialect.SelectQuery]) ChecklistTemplatesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return ChecklistTemplates.Query(append(mods,
+		sm.Where(sqlite.Group(ChecklistTemplates.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Comments starts a query for related objects on comments
+func (o *User) Comments(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	return Comments.Query(append(mods,
+		sm.Where(Comments.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Comments(mods ...bob.Mod[*dialect.SelectQuery]) CommentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Comments.Query(append(mods,
+		sm.Where(sqlite.Group(Comments.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ListMembers starts a query for related objects on list_members
+func (o *User) ListMembers(mods ...bob.Mod[*dialect.SelectQuery]) ListMembersQuery {
+	return ListMembers.Query(append(mods,
+		sm.Where(ListMembers.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ListMembers(mods ...bob.Mod[*dialect.SelectQuery]) ListMembersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return ListMembers.Query(append(mods,
+		sm.Where(sqlite.Group(ListMembers.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Lists starts a query for related objects on lists
+func (o *User) Lists(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	return Lists.Query(append(mods,
+		sm.Where(Lists.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Lists(mods ...bob.Mod[*dialect.SelectQuery]) ListsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Lists.Query(append(mods,
+		sm.Where(sqlite.Group(Lists.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ActorNotifications starts a query for related objects on notifications
+func (o *User) ActorNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.ActorID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ActorNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Notifications.Query(append(mods,
+		sm.Where(sqlite.Group(Notifications.Columns.ActorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Notifications starts a query for related objects on notifications
+func (o *User) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Notifications.Query(append(mods,
+		sm.Where(sqlite.Group(Notifications.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Tags starts a query for related objects on tags
+func (o *User) Tags(mods ...bob.Mod[*dialect.SelectQuery]) TagsQuery {
+	return Tags.Query(append(mods,
+		sm.Where(Tags.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Tags(mods ...bob.Mod[*dialect.SelectQuery]) TagsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Tags.Query(append(mods,
+		sm.Where(sqlite.Group(Tags.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TimeEntries starts a query for related objects on time_entries
+func (o *User) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	return TimeEntries.Query(append(mods,
+		sm.Where(TimeEntries.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return TimeEntries.Query(append(mods,
+		sm.Where(sqlite.Group(TimeEntries.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TodoEvents starts a query for related objects on todo_events
+func (o *User) TodoEvents(mods ...bob.Mod[*dialect.SelectQuery]) TodoEventsQuery {
+	return TodoEvents.Query(append(mods,
+		sm.Where(TodoEvents.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TodoEvents(mods ...bob.Mod[*dialect.SelectQuery]) TodoEventsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return TodoEvents.Query(append(mods,
+		sm.Where(sqlite.Group(TodoEvents.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// AssignedTodos starts a query for related objects on todos
+func (o *User) AssignedTodos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.AssigneeID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) AssignedTodos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.AssigneeID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Todos starts a query for related objects on todos
+func (o *User) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	return Todos.Query(append(mods,
+		sm.Where(Todos.Columns.UserID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Todos(mods ...bob.Mod[*dialect.SelectQuery]) TodosQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return Todos.Query(append(mods,
+		sm.Where(sqlite.Group(Todos.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertUserAttachments0(ctx context.Context, exec bob.Executor, attachments1 []*AttachmentSetter, user0 *User) (AttachmentSlice, error) {
+	for i := range attachments1 {
+		attachments1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Attachments.Insert(bob.ToMods(attachments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAttachments0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAttachments0(ctx context.Context, exec bob.Executor, count int, attachments1 AttachmentSlice, user0 *User) (AttachmentSlice, error) {
+	setter := &AttachmentSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := attachments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAttachments0: %w", err)
+	}
+
+	return attachments1, nil
+}
+
+func (user0 *User) InsertAttachments(ctx context.Context, exec bob.Executor, related ...*AttachmentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	attachments1, err := insertUserAttachments0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Attachments = append(user0.R.Attachments, attachments1...)
+
+	for _, rel := range attachments1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAttachments(ctx context.Context, exec bob.Executor, related ...*Attachment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	attachments1 := AttachmentSlice(related)
+
+	_, err = attachUserAttachments0(ctx, exec, len(related), attachments1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Attachments = append(user0.R.Attachments, attachments1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserChecklistTemplates0(ctx context.Context, exec bob.Executor, checklistTemplates1 []*ChecklistTemplateSetter, user0 *User) (ChecklistTemplateSlice, error) {
+	for i := range checklistTemplates1 {
+		checklistTemplates1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := ChecklistTemplates.Insert(bob.ToMods(checklistTemplates1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserChecklistTemplates0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserChecklistTemplates0(ctx context.Context, exec bob.Executor, count int, checklistTemplates1 ChecklistTemplateSlice, user0 *User) (ChecklistTemplateSlice, error) {
+	setter := &ChecklistTemplateSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := checklistTemplates1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserChecklistTemplates0: %w", err)
+	}
+
+	return checklistTemplates1, nil
+}
+
+func (user0 *User) InsertChecklistTemplates(ctx context.Context, exec bob.Executor, related ...*ChecklistTemplateSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	checklistTemplates1, err := insertUserChecklistTemplates0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ChecklistTemplates = append(user0.R.ChecklistTemplates, checklistTemplates1...)
+
+	for _, rel := range checklistTemplates1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachChecklistTemplates(ctx context.Context, exec bob.Executor, related ...*ChecklistTemplate) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	checklistTemplates1 := ChecklistTemplateSlice(related)
+
+	_, err = attachUserChecklistTemplates0(ctx, exec, len(related), checklistTemplates1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ChecklistTemplates = append(user0.R.ChecklistTemplates, checklistTemplates1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserComments0(ctx context.Context, exec bob.Executor, comments1 []*CommentSetter, user0 *User) (CommentSlice, error) {
+	for i := range comments1 {
+		comments1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Comments.Insert(bob.ToMods(comments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserComments0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserComments0(ctx context.Context, exec bob.Executor, count int, comments1 CommentSlice, user0 *User) (CommentSlice, error) {
+	setter := &CommentSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := comments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserComments0: %w", err)
+	}
+
+	return comments1, nil
+}
+
+func (user0 *User) InsertComments(ctx context.Context, exec bob.Executor, related ...*CommentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	comments1, err := insertUserComments0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Comments = append(user0.R.Comments, comments1...)
+
+	for _, rel := range comments1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachComments(ctx context.Context, exec bob.Executor, related ...*Comment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	comments1 := CommentSlice(related)
+
+	_, err = attachUserComments0(ctx, exec, len(related), comments1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Comments = append(user0.R.Comments, comments1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserListMembers0(ctx context.Context, exec bob.Executor, listMembers1 []*ListMemberSetter, user0 *User) (ListMemberSlice, error) {
+	for i := range listMembers1 {
+		listMembers1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := ListMembers.Insert(bob.ToMods(listMembers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserListMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserListMembers0(ctx context.Context, exec bob.Executor, count int, listMembers1 ListMemberSlice, user0 *User) (ListMemberSlice, error) {
+	setter := &ListMemberSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := listMembers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserListMembers0: %w", err)
+	}
+
+	return listMembers1, nil
+}
+
+func (user0 *User) InsertListMembers(ctx context.Context, exec bob.Executor, related ...*ListMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	listMembers1, err := insertUserListMembers0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ListMembers = append(user0.R.ListMembers, listMembers1...)
+
+	for _, rel := range listMembers1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachListMembers(ctx context.Context, exec bob.Executor, related ...*ListMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	listMembers1 := ListMemberSlice(related)
+
+	_, err = attachUserListMembers0(ctx, exec, len(related), listMembers1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ListMembers = append(user0.R.ListMembers, listMembers1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserLists0(ctx context.Context, exec bob.Executor, lists1 []*ListSetter, user0 *User) (ListSlice, error) {
+	for i := range lists1 {
+		lists1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Lists.Insert(bob.ToMods(lists1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserLists0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserLists0(ctx context.Context, exec bob.Executor, count int, lists1 ListSlice, user0 *User) (ListSlice, error) {
+	setter := &ListSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := lists1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserLists0: %w", err)
+	}
+
+	return lists1, nil
+}
+
+func (user0 *User) InsertLists(ctx context.Context, exec bob.Executor, related ...*ListSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	lists1, err := insertUserLists0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Lists = append(user0.R.Lists, lists1...)
+
+	for _, rel := range lists1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachLists(ctx context.Context, exec bob.Executor, related ...*List) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	lists1 := ListSlice(related)
+
+	_, err = attachUserLists0(ctx, exec, len(related), lists1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Lists = append(user0.R.Lists, lists1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserActorNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].ActorID = omit.From(user0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserActorNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserActorNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		ActorID: omit.From(user0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserActorNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (user0 *User) InsertActorNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertUserActorNotifications0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorNotifications = append(user0.R.ActorNotifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.Actor = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachActorNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachUserActorNotifications0(ctx, exec, len(related), notifications1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorNotifications = append(user0.R.ActorNotifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.Actor = user0
+	}
+
+	return nil
+}
+
+func insertUserNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (user0 *User) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertUserNotifications0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachUserNotifications0(ctx, exec, len(related), notifications1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTags0(ctx context.Context, exec bob.Executor, tags1 []*TagSetter, user0 *User) (TagSlice, error) {
+	for i := range tags1 {
+		tags1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Tags.Insert(bob.ToMods(tags1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTags0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTags0(ctx context.Context, exec bob.Executor, count int, tags1 TagSlice, user0 *User) (TagSlice, error) {
+	setter := &TagSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := tags1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTags0: %w", err)
+	}
+
+	return tags1, nil
+}
+
+func (user0 *User) InsertTags(ctx context.Context, exec bob.Executor, related ...*TagSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	tags1, err := insertUserTags0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Tags = append(user0.R.Tags, tags1...)
+
+	for _, rel := range tags1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTags(ctx context.Context, exec bob.Executor, related ...*Tag) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	tags1 := TagSlice(related)
+
+	_, err = attachUserTags0(ctx, exec, len(related), tags1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Tags = append(user0.R.Tags, tags1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTimeEntries0(ctx context.Context, exec bob.Executor, timeEntries1 []*TimeEntrySetter, user0 *User) (TimeEntrySlice, error) {
+	for i := range timeEntries1 {
+		timeEntries1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TimeEntries.Insert(bob.ToMods(timeEntries1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTimeEntries0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTimeEntries0(ctx context.Context, exec bob.Executor, count int, timeEntries1 TimeEntrySlice, user0 *User) (TimeEntrySlice, error) {
+	setter := &TimeEntrySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := timeEntries1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTimeEntries0: %w", err)
+	}
+
+	return timeEntries1, nil
+}
+
+func (user0 *User) InsertTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntrySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	timeEntries1, err := insertUserTimeEntries0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TimeEntries = append(user0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range timeEntries1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntry) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	timeEntries1 := TimeEntrySlice(related)
+
+	_, err = attachUserTimeEntries0(ctx, exec, len(related), timeEntries1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TimeEntries = append(user0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTodoEvents0(ctx context.Context, exec bob.Executor, todoEvents1 []*TodoEventSetter, user0 *User) (TodoEventSlice, error) {
+	for i := range todoEvents1 {
+		todoEvents1[i].UserID = omitnull.From(user0.ID)
+	}
+
+	ret, err := TodoEvents.Insert(bob.ToMods(todoEvents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTodoEvents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTodoEvents0(ctx context.Context, exec bob.Executor, count int, todoEvents1 TodoEventSlice, user0 *User) (TodoEventSlice, error) {
+	setter := &TodoEventSetter{
+		UserID: omitnull.From(user0.ID),
+	}
+
+	err := todoEvents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTodoEvents0: %w", err)
+	}
+
+	return todoEvents1, nil
+}
+
+func (user0 *User) InsertTodoEvents(ctx context.Context, exec bob.Executor, related ...*TodoEventSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todoEvents1, err := insertUserTodoEvents0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TodoEvents = append(user0.R.TodoEvents, todoEvents1...)
+
+	for _, rel := range todoEvents1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTodoEvents(ctx context.Context, exec bob.Executor, related ...*TodoEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todoEvents1 := TodoEventSlice(related)
+
+	_, err = attachUserTodoEvents0(ctx, exec, len(related), todoEvents1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TodoEvents = append(user0.R.TodoEvents, todoEvents1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserAssignedTodos0(ctx context.Context, exec bob.Executor, todos1 []*TodoSetter, user0 *User) (TodoSlice, error) {
+	for i := range todos1 {
+		todos1[i].AssigneeID = omitnull.From(user0.ID)
+	}
+
+	ret, err := Todos.Insert(bob.ToMods(todos1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserAssignedTodos0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserAssignedTodos0(ctx context.Context, exec bob.Executor, count int, todos1 TodoSlice, user0 *User) (TodoSlice, error) {
+	setter := &TodoSetter{
+		AssigneeID: omitnull.From(user0.ID),
+	}
+
+	err := todos1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserAssignedTodos0: %w", err)
+	}
+
+	return todos1, nil
+}
+
+func (user0 *User) InsertAssignedTodos(ctx context.Context, exec bob.Executor, related ...*TodoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todos1, err := insertUserAssignedTodos0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AssignedTodos = append(user0.R.AssignedTodos, todos1...)
+
+	for _, rel := range todos1 {
+		rel.R.Assignee = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachAssignedTodos(ctx context.Context, exec bob.Executor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todos1 := TodoSlice(related)
+
+	_, err = attachUserAssignedTodos0(ctx, exec, len(related), todos1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.AssignedTodos = append(user0.R.AssignedTodos, todos1...)
+
+	for _, rel := range related {
+		rel.R.Assignee = user0
+	}
+
+	return nil
+}
+
+func insertUserTodos0(ctx context.Context, exec bob.Executor, todos1 []*TodoSetter, user0 *User) (TodoSlice, error) {
+	for i := range todos1 {
+		todos1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Todos.Insert(bob.ToMods(todos1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTodos0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTodos0(ctx context.Context, exec bob.Executor, count int, todos1 TodoSlice, user0 *User) (TodoSlice, error) {
+	setter := &TodoSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := todos1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTodos0: %w", err)
+	}
+
+	return todos1, nil
+}
+
+func (user0 *User) InsertTodos(ctx context.Context, exec bob.Executor, related ...*TodoSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	todos1, err := insertUserTodos0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Todos = append(user0.R.Todos, todos1...)
+
+	for _, rel := range todos1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTodos(ctx context.Context, exec bob.Executor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	todos1 := TodoSlice(related)
+
+	_, err = attachUserTodos0(ctx, exec, len(related), todos1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Todos = append(user0.R.Todos, todos1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+type userWhere[Q sqlite.Filterable] struct {
+	ID          sqlite.WhereMod[Q, int64]
+	Email       sqlite.WhereMod[Q, string]
+	Password    sqlite.WhereMod[Q, string]
+	CreatedAt   sqlite.WhereMod[Q, time.Time]
+	UpdatedAt   sqlite.WhereMod[Q, time.Time]
+	Timezone    sqlite.WhereMod[Q, string]
+	TodoSort    sqlite.WhereMod[Q, string]
+	TodoSortDir sqlite.WhereMod[Q, string]
+}
+
+func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
+	return buildUserWhere[Q](buildUserColumns(alias))
+}
+
+func buildUserWhere[Q sqlite.Filterable](cols userColumns) userWhere[Q] {
+	return userWhere[Q]{
+		ID:          sqlite.Where[Q, int64](cols.ID),
+		Email:       sqlite.Where[Q, string](cols.Email),
+		Password:    sqlite.Where[Q, string](cols.Password),
+		CreatedAt:   sqlite.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:   sqlite.Where[Q, time.Time](cols.UpdatedAt),
+		Timezone:    sqlite.Where[Q, string](cols.Timezone),
+		TodoSort:    sqlite.Where[Q, string](cols.TodoSort),
+		TodoSortDir: sqlite.Where[Q, string](cols.TodoSortDir),
+	}
+}
+
+func (o *User) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Attachments":
+		rels, ok := retrieved.(AttachmentSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Attachments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ChecklistTemplates":
+		rels, ok := retrieved.(ChecklistTemplateSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ChecklistTemplates = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Comments":
+		rels, ok := retrieved.(CommentSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Comments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ListMembers":
+		rels, ok := retrieved.(ListMemberSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ListMembers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Lists":
+		rels, ok := retrieved.(ListSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Lists = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ActorNotifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ActorNotifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Actor = o
+			}
+		}
+		return nil
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Tags":
+		rels, ok := retrieved.(TagSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tags = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TimeEntries":
+		rels, ok := retrieved.(TimeEntrySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TimeEntries = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TodoEvents":
+		rels, ok := retrieved.(TodoEventSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TodoEvents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "AssignedTodos":
+		rels, ok := retrieved.(TodoSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AssignedTodos = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Assignee = o
+			}
+		}
+		return nil
+	case "Todos":
+		rels, ok := retrieved.(TodoSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Todos = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("user has no relationship %q", name)
+	}
+}
+
+type userPreloader struct{}
+
+func buildUserPreloader() userPreloader {
+	return userPreloader{}
+}
+
+type userThenLoader[Q orm.Loadable] struct {
+	Attachments        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ChecklistTemplates func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Comments           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ListMembers        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Lists              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ActorNotifications func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Notifications      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Tags               func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TimeEntries        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TodoEvents         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AssignedTodos      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Todos              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
+	type AttachmentsLoadInterface interface {
+		LoadAttachments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ChecklistTemplatesLoadInterface interface {
+		LoadChecklistTemplates(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type CommentsLoadInterface interface {
+		LoadComments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ListMembersLoadInterface interface {
+		LoadListMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ListsLoadInterface interface {
+		LoadLists(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ActorNotificationsLoadInterface interface {
+		LoadActorNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TagsLoadInterface interface {
+		LoadTags(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TimeEntriesLoadInterface interface {
+		LoadTimeEntries(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodoEventsLoadInterface interface {
+		LoadTodoEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AssignedTodosLoadInterface interface {
+		LoadAssignedTodos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TodosLoadInterface interface {
+		LoadTodos(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userThenLoader[Q]{
+		Attachments: thenLoadBuilder[Q](
+			"Attachments",
+			func(ctx context.Context, exec bob.Executor, retrieved AttachmentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAttachments(ctx, exec, mods...)
+			},
+		),
+		ChecklistTemplates: thenLoadBuilder[Q](
+			"ChecklistTemplates",
+			func(ctx context.Context, exec bob.Executor, retrieved ChecklistTemplatesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadChecklistTemplates(ctx, exec, mods...)
+			},
+		),
+		Comments: thenLoadBuilder[Q](
+			"Comments",
+			func(ctx context.Context, exec bob.Executor, retrieved CommentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadComments(ctx, exec, mods...)
+			},
+		),
+		ListMembers: thenLoadBuilder[Q](
+			"ListMembers",
+			func(ctx context.Context, exec bob.Executor, retrieved ListMembersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadListMembers(ctx, exec, mods...)
+			},
+		),
+		Lists: thenLoadBuilder[Q](
+			"Lists",
+			func(ctx context.Context, exec bob.Executor, retrieved ListsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLists(ctx, exec, mods...)
+			},
+		),
+		ActorNotifications: thenLoadBuilder[Q](
+			"ActorNotifications",
+			func(ctx context.Context, exec bob.Executor, retrieved ActorNotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadActorNotifications(ctx, exec, mods...)
+			},
+		),
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+		Tags: thenLoadBuilder[Q](
+			"Tags",
+			func(ctx context.Context, exec bob.Executor, retrieved TagsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTags(ctx, exec, mods...)
+			},
+		),
+		TimeEntries: thenLoadBuilder[Q](
+			"TimeEntries",
+			func(ctx context.Context, exec bob.Executor, retrieved TimeEntriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTimeEntries(ctx, exec, mods...)
+			},
+		),
+		TodoEvents: thenLoadBuilder[Q](
+			"TodoEvents",
+			func(ctx context.Context, exec bob.Executor, retrieved TodoEventsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodoEvents(ctx, exec, mods...)
+			},
+		),
+		AssignedTodos: thenLoadBuilder[Q](
+			"AssignedTodos",
+			func(ctx context.Context, exec bob.Executor, retrieved AssignedTodosLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAssignedTodos(ctx, exec, mods...)
+			},
+		),
+		Todos: thenLoadBuilder[Q](
+			"Todos",
+			func(ctx context.Context, exec bob.Executor, retrieved TodosLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTodos(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAttachments loads the user's Attachments into the .R struct
+func (o *User) LoadAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Attachments = nil
+
+	related, err := o.Attachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Attachments = related
+	return nil
+}
+
+// LoadAttachments loads the user's Attachments into the .R struct
+func (os UserSlice) LoadAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	attachments, err := os.Attachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Attachments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range attachments {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Attachments = append(o.R.Attachments, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadChecklistTemplates loads the user's ChecklistTemplates into the .R struct
+func (o *User) LoadChecklistTemplates(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ChecklistTemplates = nil
+
+	related, err := o.ChecklistTemplates(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.ChecklistTemplates = related
+	return nil
+}
+
+// LoadChecklistTemplates loads the user's ChecklistTemplates into the .R struct
+func (os UserSlice) LoadChecklistTemplates(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	checklistTemplates, err := os.ChecklistTemplates(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ChecklistTemplates = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range checklistTemplates {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.ChecklistTemplates = append(o.R.ChecklistTemplates, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadComments loads the user's Comments into the .R struct
+func (o *User) LoadComments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Comments = nil
+
+	related, err := o.Comments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Comments = related
+	return nil
+}
+
+// LoadComments loads the user's Comments into the .R struct
+func (os UserSlice) LoadComments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	comments, err := os.Comments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Comments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range comments {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Comments = append(o.R.Comments, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadListMembers loads the user's ListMembers into the .R struct
+func (o *User) LoadListMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ListMembers = nil
+
+	related, err := o.ListMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.ListMembers = related
+	return nil
+}
+
+// LoadListMembers loads the user's ListMembers into the .R struct
+func (os UserSlice) LoadListMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	listMembers, err := os.ListMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ListMembers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range listMembers {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.ListMembers = append(o.R.ListMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadLists loads the user's Lists into the .R struct
+func (o *User) LoadLists(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Lists = nil
+
+	related, err := o.Lists(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Lists = related
+	return nil
+}
+
+// LoadLists loads the user's Lists into the .R struct
+func (os UserSlice) LoadLists(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	lists, err := os.Lists(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Lists = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range lists {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Lists = append(o.R.Lists, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadActorNotifications loads the user's ActorNotifications into the .R struct
+func (o *User) LoadActorNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ActorNotifications = nil
+
+	related, err := o.ActorNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Actor = o
+	}
+
+	o.R.ActorNotifications = related
+	return nil
+}
+
+// LoadActorNotifications loads the user's ActorNotifications into the .R struct
+func (os UserSlice) LoadActorNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.ActorNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ActorNotifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !(o.ID == rel.ActorID) {
+				continue
+			}
+
+			rel.R.Actor = o
+
+			o.R.ActorNotifications = append(o.R.ActorNotifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (o *User) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Notifications = related
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (os UserSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTags loads the user's Tags into the .R struct
+func (o *User) LoadTags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tags = nil
+
+	related, err := o.Tags(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Tags = related
+	return nil
+}
+
+// LoadTags loads the user's Tags into the .R struct
+func (os UserSlice) LoadTags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tags, err := os.Tags(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Tags = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tags {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Tags = append(o.R.Tags, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTimeEntries loads the user's TimeEntries into the .R struct
+func (o *User) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TimeEntries = nil
+
+	related, err := o.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TimeEntries = related
+	return nil
+}
+
+// LoadTimeEntries loads the user's TimeEntries into the .R struct
+func (os UserSlice) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	timeEntries, err := os.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TimeEntries = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range timeEntries {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.TimeEntries = append(o.R.TimeEntries, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoEvents loads the user's TodoEvents into the .R struct
+func (o *User) LoadTodoEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TodoEvents = nil
+
+	related, err := o.TodoEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TodoEvents = related
+	return nil
+}
+
+// LoadTodoEvents loads the user's TodoEvents into the .R struct
+func (os UserSlice) LoadTodoEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todoEvents, err := os.TodoEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TodoEvents = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todoEvents {
+
+			if !rel.UserID.IsValue() {
+				continue
+			}
+			if !(rel.UserID.IsValue() && o.ID == rel.UserID.MustGet()) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.TodoEvents = append(o.R.TodoEvents, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadAssignedTodos loads the user's AssignedTodos into the .R struct
+func (o *User) LoadAssignedTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AssignedTodos = nil
+
+	related, err := o.AssignedTodos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Assignee = o
+	}
+
+	o.R.AssignedTodos = related
+	return nil
+}
+
+// LoadAssignedTodos loads the user's AssignedTodos into the .R struct
+func (os UserSlice) LoadAssignedTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.AssignedTodos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AssignedTodos = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !rel.AssigneeID.IsValue() {
+				continue
+			}
+			if !(rel.AssigneeID.IsValue() && o.ID == rel.AssigneeID.MustGet()) {
+				continue
+			}
+
+			rel.R.Assignee = o
+
+			o.R.AssignedTodos = append(o.R.AssignedTodos, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTodos loads the user's Todos into the .R struct
+func (o *User) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Todos = nil
+
+	related, err := o.Todos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Todos = related
+	return nil
+}
+
+// LoadTodos loads the user's Todos into the .R struct
+func (os UserSlice) LoadTodos(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	todos, err := os.Todos(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Todos = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range todos {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Todos = append(o.R.Todos, rel)
+		}
+	}
+
+	return nil
+}
+
+type userJoins[Q dialect.Joinable] struct {
+	typ                string
+	Attachments        modAs[Q, attachmentColumns]
+	ChecklistTemplates modAs[Q, checklistTemplateColumns]
+	Comments           modAs[Q, commentColumns]
+	ListMembers        modAs[Q, listMemberColumns]
+	Lists              modAs[Q, listColumns]
+	ActorNotifications modAs[Q, notificationColumns]
+	Notifications      modAs[Q, notificationColumns]
+	Tags               modAs[Q, tagColumns]
+	TimeEntries        modAs[Q, timeEntryColumns]
+	TodoEvents         modAs[Q, todoEventColumns]
+	AssignedTodos      modAs[Q, todoColumns]
+	Todos              modAs[Q, todoColumns]
+}
+
+func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
+	return buildUserJoins[Q](buildUserColumns(alias), j.typ)
+}
+
+func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[Q] {
+	return userJoins[Q]{
+		typ: typ,
+		Attachments: modAs[Q, attachmentColumns]{
+			c: Attachments.Columns,
+			f: func(to attachmentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Attachments.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ChecklistTemplates: modAs[Q, checklistTemplateColumns]{
+			c: ChecklistTemplates.Columns,
+			f: func(to checklistTemplateColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ChecklistTemplates.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Comments: modAs[Q, commentColumns]{
+			c: Comments.Columns,
+			f: func(to commentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Comments.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ListMembers: modAs[Q, listMemberColumns]{
+			c: ListMembers.Columns,
+			f: func(to listMemberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ListMembers.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Lists: modAs[Q, listColumns]{
+			c: Lists.Columns,
+			f: func(to listColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Lists.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ActorNotifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.ActorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Tags: modAs[Q, tagColumns]{
+			c: Tags.Columns,
+			f: func(to tagColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tags.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TimeEntries: modAs[Q, timeEntryColumns]{
+			c: TimeEntries.Columns,
+			f: func(to timeEntryColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TimeEntries.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TodoEvents: modAs[Q, todoEventColumns]{
+			c: TodoEvents.Columns,
+			f: func(to todoEventColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TodoEvents.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		AssignedTodos: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.AssigneeID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Todos: modAs[Q, todoColumns]{
+			c: Todos.Columns,
+			f: func(to todoColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Todos.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 	}
 }
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/a-h/templ"
+	"github.com/aarondl/opt/omit"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/markdown"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// maxNotesLength はメモの最大文字数
+const maxNotesLength = 10000
+
+// registerNoteRoutes はTodoのメモ（Markdown）のルートを登録する
+// メモは添付ファイル・コメントとともに詳細ペイン（#todo-detail）に表示し、操作のたびに詳細ペインを描画し直す
+func registerNoteRoutes(g *echo.Group, db bob.DB) {
+	// 詳細ペイン
+	g.GET("/:id/notes", func(c echo.Context) error {
+		return renderTodoDetail(c, db, c.Get("todo").(*models.Todo))
+	}, requireTodoRole(db, RoleViewer))
+
+	// メモの保存
+	g.POST("/:id/notes", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+
+		notes := c.FormValue("notes")
+		if utf8.RuneCountInString(notes) > maxNotesLength {
+			return echo.NewHTTPError(http.StatusBadRequest, "メモは"+strconv.Itoa(maxNotesLength)+"文字以内で入力してください")
+		}
+		if err := todo.Update(ctx, db, &models.TodoSetter{
+			Notes:     omit.From(notes),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		}); err != nil {
+			return err
+		}
+		return renderTodoDetail(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+
+	// タスクリストのチェック状態の保存
+	// フォームにはチェックされているタスクの番号だけが送られてくるので、それ以外は未チェックにする
+	g.POST("/:id/notes/tasks", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+
+		form, err := c.FormParams()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "フォームの形式が正しくありません")
+		}
+		checked := map[int]bool{}
+		for _, v := range form[markdown.TaskFieldName] {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "タスクの番号が正しくありません")
+			}
+			checked[n] = true
+		}
+
+		notes := markdown.SetTasks(todo.Notes, checked)
+		if notes != todo.Notes {
+			if err := todo.Update(ctx, db, &models.TodoSetter{
+				Notes:     omit.From(notes),
+				UpdatedAt: omit.From(time.Now().UTC()),
+			}); err != nil {
+				return err
+			}
+		}
+		return renderTodoDetail(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// renderTodoDetail はメモをHTMLに変換し、添付ファイル・コメント・変更履歴とあわせて詳細ペインを返す
+// 編集できないユーザーにはタスクリストのチェックボックスを操作できない状態で表示する
+func renderTodoDetail(c echo.Context, db bob.DB, todo *models.Todo) error {
+	detail, err := todoDetail(c, db, todo)
+	if err != nil {
+		return err
+	}
+	return render(c, http.StatusOK, detail)
+}
+
+// todoDetail は renderTodoDetail が返す詳細ペインを、一覧の行など別の部品と並べられるようにコンポーネントとして返す
+func todoDetail(c echo.Context, db bob.DB, todo *models.Todo) (templ.Component, error) {
+	ctx := c.Request().Context()
+	if err := todo.LoadAttachments(ctx, db, sm.OrderBy(models.Attachments.Columns.ID)); err != nil {
+		return nil, err
+	}
+	err := todo.LoadComments(ctx, db,
+		models.SelectWhere.Comments.ParentID.IsNull(),
+		sm.OrderBy(models.Comments.Columns.ID),
+		models.SelectThenLoad.Comment.User(),
+		models.SelectThenLoad.Comment.Replies(
+			sm.OrderBy(models.Comments.Columns.ID),
+			models.SelectThenLoad.Comment.User(),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = todo.LoadTodoEvents(ctx, db,
+		sm.OrderBy(models.TodoEvents.Columns.ID).Desc(),
+		models.SelectThenLoad.TodoEvent.User(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := todo.LoadDependencies(ctx, db, models.SelectThenLoad.TodoDependency.Blocker()); err != nil {
+		return nil, err
+	}
+	if todo.AssigneeID.IsValue() {
+		if err := todo.LoadAssignee(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	var candidates models.TodoSlice
+	var assignees models.UserSlice
+	if views.PermissionFromContext(ctx).CanEdit {
+		if candidates, err = dependencyCandidates(ctx, db, todo); err != nil {
+			return nil, err
+		}
+		if listID, ok := todo.ListID.Get(); ok {
+			if assignees, err = listAssignees(ctx, db, listID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	renderNotes := markdown.RenderReadOnly
+	if views.PermissionFromContext(ctx).CanEdit {
+		renderNotes = markdown.Render
+	}
+	notes, err := renderNotes(todo.Notes)
+	if err != nil {
+		return nil, err
+	}
+	csrfToken := c.Get("csrf").(string)
+	return views.TodoDetail(todo, notes, candidates, assignees, csrfToken), nil
+}
+
+// renderTodoDetailChange は詳細ペインで変更したTodoの詳細ペインと、一覧の行（サブタスクなら親の行）を返す
+func renderTodoDetailChange(c echo.Context, db bob.DB, todo *models.Todo) error {
+	ctx := c.Request().Context()
+	detail, err := todoDetail(c, db, todo)
+	if err != nil {
+		return err
+	}
+	row := todo
+	if parent, err := subtaskParent(ctx, db, todo); err != nil {
+		return err
+	} else if parent != nil {
+		row = parent
+	}
+	if err := loadTodoItem(ctx, db, row); err != nil {
+		return err
+	}
+	return render(c, http.StatusOK, templ.Join(detail, views.TodoItemOOB(row, c.Get("csrf").(string))))
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+)
+
+func TestTodoNotes(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/notes"
+
+	notes := "## 手順\n\n- [ ] 見積もり\n- [ ] 発注\n\n<script>alert(1)</script>\n"
+	rec := tc.do(http.MethodPost, path, url.Values{"notes": {notes}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("save notes: status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "<h2") || !strings.Contains(body, `name="task" value="1"`) {
+		t.Errorf("detail does not render markdown: %s", body)
+	}
+	if strings.Contains(body, "<script>alert") {
+		t.Error("detail renders raw script")
+	}
+	if err := todo.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if todo.Notes != notes {
+		t.Errorf("notes = %q, want %q", todo.Notes, notes)
+	}
+
+	// チェックされたタスクだけが送られてくる
+	rec = tc.do(http.MethodPost, path+"/tasks", url.Values{"task": {"1"}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("toggle task: status = %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `value="1" checked=""`) {
+		t.Error("detail does not show the checked task")
+	}
+	if err := todo.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(todo.Notes, "- [ ] 見積もり\n- [x] 発注\n") {
+		t.Errorf("notes = %q, want the second task checked", todo.Notes)
+	}
+
+	if rec := tc.do(http.MethodPost, path, url.Values{"notes": {strings.Repeat("あ", maxNotesLength+1)}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("too long notes: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestTodoNotesReadOnlyForViewer(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	viewer := createTestUser(t, db, "viewer@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(viewer),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(owner),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Notes("- [x] 共有メモ\n"),
+	).CreateOrFail(ctx, t, db)
+	tc := login(t, e, viewer)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/notes"
+
+	rec := tc.do(http.MethodGet, path, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET notes: status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `checked="" disabled=""`) || strings.Contains(body, "<textarea") {
+		t.Errorf("viewer can edit notes: %s", body)
+	}
+
+	for _, target := range []string{path, path + "/tasks"} {
+		if rec := tc.do(http.MethodPost, target, url.Values{"notes": {"x"}}); rec.Code != http.StatusForbidden {
+			t.Errorf("POST %s: status = %d, want %d", target, rec.Code, http.StatusForbidden)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// 通知の種類（notifications.kind の値）
+const (
+	notificationMention  = "mention"  // コメントでメンションされた
+	notificationAssigned = "assigned" // Todoの担当者にされた
+)
+
+// notificationPageSize は通知一覧に表示する件数
+const notificationPageSize = 50
+
+// registerNotificationRoutes は通知のルートを登録する
+func registerNotificationRoutes(g *echo.Group, db bob.DB) {
+	// 通知一覧（新しい順）
+	g.GET("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		notifications, err := models.Notifications.Query(
+			models.SelectWhere.Notifications.UserID.EQ(c.Get("user_id").(int64)),
+			sm.OrderBy(models.Notifications.Columns.ID).Desc(),
+			sm.Limit(notificationPageSize),
+			models.SelectThenLoad.Notification.Actor(),
+			models.SelectThenLoad.Notification.Todo(),
+			models.SelectThenLoad.Notification.Comment(),
+		).All(ctx, db)
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.NotificationIndex(notifications, csrfToken))
+	})
+
+	// すべて既読にする
+	g.POST("/read", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		_, err := models.Notifications.Update(
+			models.NotificationSetter{ReadAt: omitnull.From(time.Now().UTC())}.UpdateMod(),
+			models.UpdateWhere.Notifications.UserID.EQ(c.Get("user_id").(int64)),
+			models.UpdateWhere.Notifications.ReadAt.IsNull(),
+		).Exec(ctx, db)
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/notifications")
+	})
+}
+
+// notify は userID のユーザーへの通知を作る。commentID が0ならコメントに紐付けない
+func notify(ctx context.Context, exec bob.Executor, userID, actorID int64, kind string, todoID, commentID int64) error {
+	setter := &models.NotificationSetter{
+		UserID:  omit.From(userID),
+		ActorID: omit.From(actorID),
+		Kind:    omit.From(kind),
+		TodoID:  omit.From(todoID),
+	}
+	if commentID != 0 {
+		setter.CommentID = omitnull.From(commentID)
+	}
+	_, err := models.Notifications.Insert(setter).One(ctx, exec)
+	return err
+}
+
+// unreadNotificationCount は未読の通知の件数を返す
+func unreadNotificationCount(ctx context.Context, exec bob.Executor, userID int64) (int64, error) {
+	return models.Notifications.Query(
+		models.SelectWhere.Notifications.UserID.EQ(userID),
+		models.SelectWhere.Notifications.ReadAt.IsNull(),
+	).Count(ctx, exec)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// todoPageSize はTodo一覧で1回に読み込む件数
+const todoPageSize = 50
+
+// Todo一覧の状態による絞り込み（クエリパラメータ status の値）
+const (
+	todoStatusAll       = "all"
+	todoStatusActive    = "active"
+	todoStatusCompleted = "completed"
+)
+
+// todoIndexFilter はクエリパラメータからTodo一覧の絞り込み条件を作る。不正な状態は「すべて」として扱う
+func todoIndexFilter(c echo.Context) views.TodoFilter {
+	filter := views.TodoFilter{Tag: c.QueryParam("tag"), Status: c.QueryParam("status")}
+	if filter.Status != todoStatusActive && filter.Status != todoStatusCompleted {
+		filter.Status = todoStatusAll
+	}
+	return filter
+}
+
+// todoStatusFilter は状態による絞り込みの条件。「すべて」なら絞り込まない
+func todoStatusFilter(status string) bob.Mod[*dialect.SelectQuery] {
+	switch status {
+	case todoStatusActive:
+		return models.SelectWhere.Todos.Completed.EQ(false)
+	case todoStatusCompleted:
+		return models.SelectWhere.Todos.Completed.EQ(true)
+	}
+	return bob.Mods[*dialect.SelectQuery]{}
+}
+
+// loadTodoPage はTodo一覧の1ページ分を読み込む
+// scope は受信箱かリストかの条件。after が空でなければ、並び順でその位置より後ろのTodoから読み込む
+// 次のページがあるかどうかは1件多く読んで確かめる
+func loadTodoPage(ctx context.Context, db bob.DB, scope bob.Mod[*dialect.SelectQuery], sort views.TodoSort, filter views.TodoFilter, after *todoCursor) (views.TodoPage, error) {
+	mods := bob.Mods[*dialect.SelectQuery]{
+		scope,
+		todoIndexMods(sort, filter),
+		sm.Limit(todoPageSize + 1),
+	}
+	if after != nil {
+		mods = append(mods, todoKeyset(sort, after))
+	}
+	todos, err := models.Todos.Query(mods).All(ctx, db)
+	if err != nil {
+		return views.TodoPage{}, err
+	}
+
+	page := views.TodoPage{Todos: todos}
+	if len(todos) > todoPageSize {
+		page.Todos = todos[:todoPageSize]
+		page.Next = newTodoCursor(sort, page.Todos[todoPageSize-1]).encode()
+	}
+	return page, nil
+}
+
+// countTodos は状態ごとのTodoの件数を数える。状態以外の絞り込み（タグ）は反映する
+func countTodos(ctx context.Context, db bob.DB, scope bob.Mod[*dialect.SelectQuery], filter views.TodoFilter) (views.TodoCounts, error) {
+	count := func(status string) (int64, error) {
+		mods := bob.Mods[*dialect.SelectQuery]{
+			scope,
+			models.SelectWhere.Todos.ParentID.IsNull(),
+			todoStatusFilter(status),
+		}
+		if filter.Tag != "" {
+			mods = append(mods, todoTagFilter(filter.Tag))
+		}
+		return models.Todos.Query(mods).Count(ctx, db)
+	}
+
+	var counts views.TodoCounts
+	var err error
+	if counts.Active, err = count(todoStatusActive); err != nil {
+		return counts, err
+	}
+	if counts.Completed, err = count(todoStatusCompleted); err != nil {
+		return counts, err
+	}
+	return counts, nil
+}
+
+// todoCursor は次のページを読む位置。前のページの最後のTodoの、並び替えの列の値とID
+// 値を持ち歩くので、そのTodoが削除されたり並び替えの値が変わったりしても同じ位置から続きを読める
+// Key は todoSortColumns の式がDBで返すのと同じ値（整数・文字列・NULL）
+type todoCursor struct {
+	Key any   `json:"k"`
+	ID  int64 `json:"id"`
+}
+
+// newTodoCursor はTodoの位置を表すカーソルを作る
+// 日時は todoSortTime と同じく、DBに書いたときのタイムゾーンのまま秒までの文字列にする
+func newTodoCursor(sort views.TodoSort, todo *models.Todo) *todoCursor {
+	var key any
+	switch sort.Field {
+	case "updated":
+		key = todo.UpdatedAt.Format(time.DateTime)
+	case "priority":
+		key = todo.Priority
+	case "due":
+		if due, ok := todo.DueAt.Get(); ok {
+			key = due.Format(time.DateTime)
+		}
+	case "title":
+		key = todo.Title
+	case "position":
+		key = todo.Position
+	default:
+		key = todo.CreatedAt.Format(time.DateTime)
+	}
+	return &todoCursor{Key: key, ID: todo.ID}
+}
+
+// encode はカーソルをクエリパラメータ after の値にする
+func (cursor *todoCursor) encode() string {
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeTodoCursor は encode したカーソルを読む。値が整数・文字列・NULLのどれでもなければエラーにする
+func decodeTodoCursor(s string) (*todoCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var cursor todoCursor
+	if err := d.Decode(&cursor); err != nil {
+		return nil, err
+	}
+	switch key := cursor.Key.(type) {
+	case json.Number:
+		if cursor.Key, err = key.Int64(); err != nil {
+			return nil, err
+		}
+	case string, nil:
+	default:
+		return nil, errors.New("invalid cursor key")
+	}
+	return &cursor, nil
+}
+
+// todoKeyset は並び順で after の位置より後ろにあるTodoを選ぶ条件（キーセットページネーション）
+// 並び替えの列とIDの組で比べるので、OFFSETと違って読み飛ばす行が増えても遅くならない
+func todoKeyset(sort views.TodoSort, after *todoCursor) bob.Mod[*dialect.SelectQuery] {
+	column, ok := todoSortColumns[sort.Field]
+	if !ok {
+		column = todoSortColumns["created"]
+	}
+
+	// 手動の並び順は常に昇順（todoOrderBy と同じ）
+	desc := sort.Dir == "desc" && sort.Field != "position"
+	row := sqlite.Group(column, models.Todos.Columns.ID)
+	cursor := sqlite.Group(sqlite.Arg(after.Key), sqlite.Arg(after.ID))
+	// 行値の比較だけでは式の索引で読み始める位置を探せないので、並び替えの値だけの比較も添える
+	next := sqlite.And(column.GTE(sqlite.Arg(after.Key)), row.GT(cursor))
+	nextID := models.Todos.Columns.ID.GT(sqlite.Arg(after.ID))
+	if desc {
+		next = sqlite.And(column.LTE(sqlite.Arg(after.Key)), row.LT(cursor))
+		nextID = models.Todos.Columns.ID.LT(sqlite.Arg(after.ID))
+	}
+	if sort.Field != "due" {
+		return sm.Where(next)
+	}
+
+	// 期限のないTodoは向きにかかわらず最後に並ぶので、after に期限があるかどうかで分ける
+	if after.Key != nil {
+		return sm.Where(sqlite.Or(column.IsNull(), next))
+	}
+	return sm.Where(sqlite.And(column.IsNull(), nextID))
+}
+
+// todoIndexAfter はクエリパラメータ after（前のページの最後の位置）を読む。なければ nil を返す
+func todoIndexAfter(c echo.Context) (*todoCursor, error) {
+	v := c.QueryParam("after")
+	if v == "" {
+		return nil, nil
+	}
+	cursor, err := decodeTodoCursor(v)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "ページの指定が正しくありません")
+	}
+	return cursor, nil
+}
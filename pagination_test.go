@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+var (
+	todoItemIDPattern  = regexp.MustCompile(`<li id="todo-(\d+)"`)
+	nextPageURLPattern = regexp.MustCompile(`hx-get="([^"]*after=[^"]*)"`)
+)
+
+func TestTodoIndexPagination(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range todoPageSize*2 + 10 {
+		// 並び替えの列が同じ値のTodoや期限のないTodoも混ぜる
+		due := null.From(base.AddDate(0, 0, i%7))
+		if i%3 == 0 {
+			due = null.FromPtr[time.Time](nil)
+		}
+		f.NewTodoWithContext(ctx,
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.Title(fmt.Sprintf("page-%d", i%5)),
+			factory.TodoMods.DueAt(due),
+			factory.TodoMods.CreatedAt(base.Add(time.Duration(i%40)*time.Hour)),
+			// 以前のバージョンが書いたUTC以外の日時も混ぜる
+			factory.TodoMods.UpdatedAt(base.Add(time.Duration(i%30)*time.Minute).In(time.FixedZone("JST", 9*60*60))),
+			factory.TodoMods.Position(fmt.Sprintf("p%d", i%9)),
+		).CreateOrFail(ctx, t, db)
+	}
+	tc := login(t, e, alice)
+
+	for _, sort := range []views.TodoSort{
+		{Field: "created", Dir: "asc"},
+		{Field: "created", Dir: "desc"},
+		{Field: "due", Dir: "asc"},
+		{Field: "due", Dir: "desc"},
+		{Field: "updated", Dir: "desc"},
+		{Field: "title", Dir: "desc"},
+		{Field: "priority", Dir: "desc"},
+		{Field: "position", Dir: "asc"},
+	} {
+		want, err := models.Todos.Query(
+			models.SelectWhere.Todos.UserID.EQ(alice.ID),
+			todoOrderBy(sort),
+		).All(ctx, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// 最初のページから、目印が無くなるまで続きを読み込む
+		body := tc.do(http.MethodGet, "/todos?sort="+sort.Field+"&dir="+sort.Dir, nil).Body.String()
+		var got []int64
+		pages := 0
+		for {
+			pages++
+			for _, m := range todoItemIDPattern.FindAllStringSubmatch(body, -1) {
+				id, _ := strconv.ParseInt(m[1], 10, 64)
+				got = append(got, id)
+			}
+			next := nextPageURLPattern.FindStringSubmatch(body)
+			if next == nil || pages > 10 {
+				break
+			}
+			rec := tc.doWithHeader(http.MethodGet, html.UnescapeString(next[1]), nil, http.Header{"Hx-Target": {"todo-items"}})
+			if rec.Code != http.StatusOK {
+				t.Fatalf("%v: next page: status = %d", sort, rec.Code)
+			}
+			body = rec.Body.String()
+			if strings.Contains(body, "<html") || strings.Contains(body, `id="todo-list"`) {
+				t.Fatalf("%v: next page returned the whole list", sort)
+			}
+		}
+
+		if pages != 3 {
+			t.Errorf("%v: pages = %d, want 3", sort, pages)
+		}
+		wantIDs := make([]int64, len(want))
+		for i, todo := range want {
+			wantIDs[i] = todo.ID
+		}
+		if !slices.Equal(got, wantIDs) {
+			t.Errorf("%v: ids = %v, want %v", sort, got, wantIDs)
+		}
+	}
+}
+
+func TestTodoIndexPaginationAfterDeletedTodo(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range todoPageSize + 5 {
+		f.NewTodoWithContext(ctx,
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.CreatedAt(base.Add(time.Duration(i)*time.Hour)),
+		).CreateOrFail(ctx, t, db)
+	}
+	tc := login(t, e, alice)
+
+	body := tc.do(http.MethodGet, "/todos?sort=created&dir=asc", nil).Body.String()
+	ids := todoItemIDPattern.FindAllStringSubmatch(body, -1)
+	next := nextPageURLPattern.FindStringSubmatch(body)
+	if len(ids) != todoPageSize || next == nil {
+		t.Fatalf("first page: %d todos, next = %v", len(ids), next)
+	}
+
+	// 前のページの最後のTodoが消えても、続きはその位置から読める
+	last, _ := strconv.ParseInt(ids[len(ids)-1][1], 10, 64)
+	if _, err := models.Todos.Delete(models.DeleteWhere.Todos.ID.EQ(last)).Exec(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	rec := tc.doWithHeader(http.MethodGet, html.UnescapeString(next[1]), nil, http.Header{"Hx-Target": {"todo-items"}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("next page: status = %d", rec.Code)
+	}
+	if got := len(todoItemIDPattern.FindAllStringSubmatch(rec.Body.String(), -1)); got != 5 {
+		t.Errorf("next page after deleting the cursor todo: %d todos, want 5", got)
+	}
+
+	if code := tc.do(http.MethodGet, "/todos?after=bogus", nil).Code; code != http.StatusBadRequest {
+		t.Errorf("invalid cursor: status = %d, want %d", code, http.StatusBadRequest)
+	}
+}
+
+func TestTodoIndexStatusFilter(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	for _, tt := range []struct {
+		title     string
+		completed bool
+	}{
+		{"status-open-1", false},
+		{"status-open-2", false},
+		{"status-done", true},
+	} {
+		f.NewTodoWithContext(ctx,
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.Title(tt.title),
+			factory.TodoMods.Completed(tt.completed),
+		).CreateOrFail(ctx, t, db)
+	}
+	tc := login(t, e, alice)
+
+	for _, tt := range []struct {
+		status string
+		want   []string
+		not    []string
+	}{
+		{"active", []string{"status-open-1", "status-open-2"}, []string{"status-done"}},
+		{"completed", []string{"status-done"}, []string{"status-open-1"}},
+		{"all", []string{"status-open-1", "status-done"}, nil},
+		{"bogus", []string{"status-open-1", "status-done"}, nil},
+	} {
+		body := tc.do(http.MethodGet, "/todos?status="+tt.status, nil).Body.String()
+		for _, s := range tt.want {
+			if !strings.Contains(body, s) {
+				t.Errorf("status=%s: %q not found", tt.status, s)
+			}
+		}
+		for _, s := range tt.not {
+			if strings.Contains(body, s) {
+				t.Errorf("status=%s: %q found", tt.status, s)
+			}
+		}
+		// タブには絞り込みにかかわらず状態ごとの件数を出す
+		for _, tab := range []string{"未完了 (2)", "完了 (1)", "すべて (3)"} {
+			if !strings.Contains(body, tab) {
+				t.Errorf("status=%s: tab %q not found", tt.status, tab)
+			}
+		}
+	}
+}
+
+func TestTodoIndexSortsUseIndexes(t *testing.T) {
+	_, db := newTestServer(t)
+	ctx := t.Context()
+
+	// 受信箱とリストのどちらでも、どの並び順でも並べ替えのための一時的なB木を作らずに索引でたどる
+	scopes := map[string]bob.Mod[*dialect.SelectQuery]{
+		"inbox": bob.Mods[*dialect.SelectQuery]{
+			models.SelectWhere.Todos.UserID.EQ(1),
+			models.SelectWhere.Todos.ListID.IsNull(),
+		},
+		"list": models.SelectWhere.Todos.ListID.EQ(1),
+	}
+	for name, scope := range scopes {
+		for field := range todoSortColumns {
+			for _, dir := range []string{"asc", "desc"} {
+				sort := views.TodoSort{Field: field, Dir: dir}
+				query, args, err := models.Todos.Query(
+					scope,
+					models.SelectWhere.Todos.ParentID.IsNull(),
+					todoOrderBy(sort),
+					todoKeyset(sort, newTodoCursor(sort, &models.Todo{Title: "a", Position: "a", DueAt: null.From(time.Now())})),
+					sm.Limit(todoPageSize+1),
+				).Build(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				plan, err := scan.All(context.WithValue(ctx, scan.CtxKeyAllowUnknownColumns, true), db, scan.ColumnMapper[string]("detail"), "EXPLAIN QUERY PLAN "+query, args...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if detail := strings.Join(plan, "; "); strings.Contains(detail, "TEMP B-TREE") || !strings.Contains(detail, "USING INDEX") {
+					t.Errorf("%s %v: plan = %s", name, sort, detail)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// ListRole はリストに対するユーザーの権限。値が大きいほど強い
+type ListRole int
+
+const (
+	RoleNone   ListRole = iota // アクセス不可
+	RoleViewer                 // 閲覧のみ
+	RoleEditor                 // Todoの作成・変更・削除
+	RoleAdmin                  // メンバー管理・リスト名変更・アーカイブ
+	RoleOwner                  // リストの削除を含むすべての操作
+)
+
+// list_members.role に保存する値
+const (
+	memberRoleViewer = "viewer"
+	memberRoleEditor = "editor"
+	memberRoleAdmin  = "admin"
+)
+
+// parseMemberRole は list_members.role の値をListRoleに変換する
+func parseMemberRole(role string) (ListRole, bool) {
+	switch role {
+	case memberRoleViewer:
+		return RoleViewer, true
+	case memberRoleEditor:
+		return RoleEditor, true
+	case memberRoleAdmin:
+		return RoleAdmin, true
+	}
+	return RoleNone, false
+}
+
+// listRole はユーザーのリストに対する権限を返す
+func listRole(ctx context.Context, db bob.Executor, userID int64, list *models.List) (ListRole, error) {
+	if list.UserID == userID {
+		return RoleOwner, nil
+	}
+	member, err := models.FindListMember(ctx, db, list.ID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return RoleNone, nil
+	}
+	if err != nil {
+		return RoleNone, err
+	}
+	role, _ := parseMemberRole(member.Role)
+	return role, nil
+}
+
+// todoRole はユーザーのTodoに対する権限を返す
+// リストに属するTodoはリストの権限に従い、受信箱のTodoは作成者だけが所有者として扱える
+func todoRole(ctx context.Context, db bob.Executor, userID int64, todo *models.Todo) (ListRole, error) {
+	listID, ok := todo.ListID.Get()
+	if !ok {
+		if todo.UserID == userID {
+			return RoleOwner, nil
+		}
+		return RoleNone, nil
+	}
+	list, err := models.FindList(ctx, db, listID)
+	if err != nil {
+		return RoleNone, err
+	}
+	return listRole(ctx, db, userID, list)
+}
+
+// checkRole は権限が足りなければエラーを返す
+// 閲覧すらできない場合は存在を漏らさないよう404、閲覧はできるが操作できない場合は403とする
+func checkRole(role, required ListRole) error {
+	switch {
+	case role == RoleNone:
+		return echo.NewHTTPError(http.StatusNotFound)
+	case role < required:
+		return echo.NewHTTPError(http.StatusForbidden)
+	}
+	return nil
+}
+
+// findListWithRole はリストを取得し、ユーザーが required 以上の権限を持つことを確認する
+func findListWithRole(ctx context.Context, db bob.Executor, userID, listID int64, required ListRole) (*models.List, ListRole, error) {
+	list, err := models.FindList(ctx, db, listID)
+	if err != nil {
+		return nil, RoleNone, notFoundIfNoRows(err)
+	}
+	role, err := listRole(ctx, db, userID, list)
+	if err != nil {
+		return nil, RoleNone, err
+	}
+	if err := checkRole(role, required); err != nil {
+		return nil, RoleNone, err
+	}
+	return list, role, nil
+}
+
+// requireListRole はパスパラメータ :id のリストに required 以上の権限を要求するミドルウェア
+// requireAuth の後ろに置く。取得したリストと権限はContextの "list" と "list_role" に格納される
+func requireListRole(db bob.DB, required ListRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			id, err := paramID(c)
+			if err != nil {
+				return err
+			}
+			list, role, err := findListWithRole(ctx, db, c.Get("user_id").(int64), id, required)
+			if err != nil {
+				return err
+			}
+			c.Set("list", list)
+			c.Set("list_role", role)
+			c.SetRequest(c.Request().WithContext(views.PermissionToContext(ctx, views.Permission{
+				CanEdit:   role >= RoleEditor,
+				CanManage: role >= RoleAdmin,
+			})))
+			return next(c)
+		}
+	}
+}
+
+// requireTodoRole はパスパラメータ :id のTodoに required 以上の権限を要求するミドルウェア
+// requireAuth の後ろに置く。取得したTodoはContextの "todo" に格納され、編集できるかどうかはビューに渡される
+func requireTodoRole(db bob.DB, required ListRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			id, err := paramID(c)
+			if err != nil {
+				return err
+			}
+			todo, err := models.FindTodo(ctx, db, id)
+			if err != nil {
+				return notFoundIfNoRows(err)
+			}
+			role, err := todoRole(ctx, db, c.Get("user_id").(int64), todo)
+			if err != nil {
+				return err
+			}
+			if err := checkRole(role, required); err != nil {
+				return err
+			}
+			c.Set("todo", todo)
+			c.SetRequest(c.Request().WithContext(views.PermissionToContext(ctx, views.Permission{
+				CanEdit:   role >= RoleEditor,
+				CanManage: role >= RoleAdmin,
+			})))
+			return next(c)
+		}
+	}
+}
+
+// accessibleListIDs はユーザーが所有またはメンバーとして参加しているリストのIDを返すサブクエリ
+// col.OP("IN", accessibleListIDs(userID)) の形で使う
+func accessibleListIDs(userID int64) bob.Expression {
+	return sqlite.Select(
+		sm.Columns(models.Lists.Columns.ID),
+		sm.From(models.Lists.Name()),
+		sm.Where(sqlite.Or(
+			models.Lists.Columns.UserID.EQ(sqlite.Arg(userID)),
+			models.Lists.Columns.ID.OP("IN", sqlite.Select(
+				sm.Columns(models.ListMembers.Columns.ListID),
+				sm.From(models.ListMembers.Name()),
+				models.SelectWhere.ListMembers.UserID.EQ(userID),
+			)),
+		)),
+	)
+}
+
+// accessibleTodos はユーザーが見られるTodo（自分の受信箱のTodoと、参加しているリストのTodo）に絞り込む条件
+// sm.Where(accessibleTodos(userID)) の形で使う
+func accessibleTodos(userID int64) bob.Expression {
+	return sqlite.Or(
+		models.Todos.Columns.ListID.OP("IN", accessibleListIDs(userID)),
+		sqlite.And(
+			models.Todos.Columns.UserID.EQ(sqlite.Arg(userID)),
+			models.Todos.Columns.ListID.IsNull(),
+		),
+	)
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/rank"
+)
+
+// positionScope は手動の並び順を比べる範囲。リストのTodoはリストごと、受信箱のTodoはユーザーごとに並べる
+// サブタスクは親の中でID順に並べるので範囲に含めない
+type positionScope struct {
+	ListID null.Val[int64]
+	UserID int64
+}
+
+func todoPositionScope(todo *models.Todo) positionScope {
+	return positionScope{ListID: todo.ListID, UserID: todo.UserID}
+}
+
+// where は範囲内のTodoに絞り込む条件
+func (s positionScope) where() bob.Mod[*dialect.SelectQuery] {
+	mods := bob.Mods[*dialect.SelectQuery]{models.SelectWhere.Todos.ParentID.IsNull()}
+	if listID, ok := s.ListID.Get(); ok {
+		return append(mods, models.SelectWhere.Todos.ListID.EQ(listID))
+	}
+	return append(mods, models.SelectWhere.Todos.ListID.IsNull(), models.SelectWhere.Todos.UserID.EQ(s.UserID))
+}
+
+// positionOrder は手動の並び順。同じ位置のTodoはIDで並べ、どのリクエストから見ても同じ順になるようにする
+func positionOrder() bob.Mod[*dialect.SelectQuery] {
+	return bob.Mods[*dialect.SelectQuery]{
+		sm.OrderBy(models.Todos.Columns.Position).Asc(),
+		sm.OrderBy(models.Todos.Columns.ID).Asc(),
+	}
+}
+
+// registerReorderRoutes は手動の並び替えのルートを登録する
+func registerReorderRoutes(g *echo.Group, db bob.DB) {
+	// 並び替え。id のTodoを、前のTodo（before_id）の直後、または次のTodo（after_id）の直前に移す
+	// 画面が古く前後のTodoがすでに隣り合っていない場合は before_id を優先し、その直後に置く
+	// 前後のTodoの位置の間に余裕がなければ、範囲内のTodoの位置をまとめて振り直す
+	g.POST("/reorder", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+
+		id, err := strconv.ParseInt(c.FormValue("id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "並び替えるTodoが正しくありません")
+		}
+		beforeID, err := optionalID(c.FormValue("before_id"))
+		if err != nil {
+			return err
+		}
+		afterID, err := optionalID(c.FormValue("after_id"))
+		if err != nil {
+			return err
+		}
+		if beforeID == 0 && afterID == 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "移動先を指定してください")
+		}
+
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			todo, err := models.FindTodo(ctx, exec, id)
+			if err != nil {
+				return notFoundIfNoRows(err)
+			}
+			role, err := todoRole(ctx, exec, userID, todo)
+			if err != nil {
+				return err
+			}
+			if err := checkRole(role, RoleEditor); err != nil {
+				return err
+			}
+			if todo.ParentID.IsValue() {
+				return echo.NewHTTPError(http.StatusBadRequest, "サブタスクは並び替えられません")
+			}
+			return reorderTodo(ctx, exec, todo, beforeID, afterID)
+		})
+		if err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+// reorderTodo はTodoを、前のTodo（beforeID）の直後、または次のTodo（afterID）の直前に移す
+// 隣のTodoの位置を読んでから書くまでに別の並び替えが割り込まないよう、トランザクションの中で呼ぶこと
+func reorderTodo(ctx context.Context, exec bob.Executor, todo *models.Todo, beforeID, afterID int64) error {
+	siblings, err := models.Todos.Query(
+		todoPositionScope(todo).where(),
+		models.SelectWhere.Todos.ID.NE(todo.ID),
+		positionOrder(),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	index := -1
+	for i, sibling := range siblings {
+		if beforeID != 0 && sibling.ID == beforeID {
+			index = i + 1
+			break
+		}
+		if beforeID == 0 && sibling.ID == afterID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "移動先のTodoが同じリストにありません")
+	}
+
+	var prev, next string
+	if index > 0 {
+		prev = siblings[index-1].Position
+	}
+	if index < len(siblings) {
+		next = siblings[index].Position
+	}
+	position, err := rank.Between(prev, next)
+	if err != nil || len(position) > rank.MaxLength {
+		// 位置が詰まっているので、移動後の並びのまま全体を振り直す
+		siblings = slices.Insert(siblings, index, todo)
+		return rebalancePositions(ctx, exec, siblings)
+	}
+	return todo.Update(ctx, exec, &models.TodoSetter{
+		Position:  omit.From(position),
+		UpdatedAt: omit.From(time.Now().UTC()),
+	})
+}
+
+// optionalID はフォームの任意のID。空なら0を返す
+func optionalID(v string) (int64, error) {
+	if v == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "移動先のTodoが正しくありません")
+	}
+	return id, nil
+}
+
+// appendPosition は範囲の末尾に追加するTodoの位置を返す
+// 末尾の位置を読んでから書くまでに別の追加が割り込まないよう、書き込みと同じトランザクションの中で呼ぶこと
+func appendPosition(ctx context.Context, exec bob.Executor, scope positionScope) (string, error) {
+	positions, err := appendPositions(ctx, exec, scope, 1)
+	if err != nil {
+		return "", err
+	}
+	return positions[0], nil
+}
+
+// appendPositions は範囲の末尾に n 件のTodoを順に並べる位置を返す
+// exclude のTodoは末尾を求めるときに数えない。範囲の中のTodoを末尾へ移すときに渡す
+func appendPositions(ctx context.Context, exec bob.Executor, scope positionScope, n int, exclude ...int64) ([]string, error) {
+	where := bob.Mods[*dialect.SelectQuery]{scope.where()}
+	if len(exclude) > 0 {
+		where = append(where, models.SelectWhere.Todos.ID.NotIn(exclude...))
+	}
+	var prev string
+	last, err := models.Todos.Query(
+		where,
+		sm.OrderBy(models.Todos.Columns.Position).Desc(),
+		sm.OrderBy(models.Todos.Columns.ID).Desc(),
+		sm.Limit(1),
+	).One(ctx, exec)
+	switch {
+	case err == nil:
+		prev = last.Position
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	}
+	if positions, ok := positionsAfter(prev, n); ok {
+		return positions, nil
+	}
+
+	// 末尾の位置が長くなりすぎたか不正なので、範囲内を振り直してから追加する
+	todos, err := models.Todos.Query(where, positionOrder()).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if err := rebalancePositions(ctx, exec, todos); err != nil {
+		return nil, err
+	}
+	prev = ""
+	if len(todos) > 0 {
+		prev = todos[len(todos)-1].Position
+	}
+	positions, ok := positionsAfter(prev, n)
+	if !ok {
+		return nil, errors.New("末尾に並べる位置を作れません")
+	}
+	return positions, nil
+}
+
+// positionAfter は todo の直後に並べるTodoの位置を返す。間に余裕がなければ範囲内を振り直す
+// サブタスクは範囲に含めないので、todo がサブタスクなら範囲の末尾の位置を返す
+// 読んでから書くまでに別の並び替えが割り込まないよう、書き込みと同じトランザクションの中で呼ぶこと
+func positionAfter(ctx context.Context, exec bob.Executor, todo *models.Todo) (string, error) {
+	scope := todoPositionScope(todo)
+	if todo.ParentID.IsValue() {
+		return appendPosition(ctx, exec, scope)
+	}
+	next, err := models.Todos.Query(
+		scope.where(),
+		sm.Where(sqlite.Or(
+			models.Todos.Columns.Position.GT(sqlite.Arg(todo.Position)),
+			sqlite.And(
+				models.Todos.Columns.Position.EQ(sqlite.Arg(todo.Position)),
+				models.Todos.Columns.ID.GT(sqlite.Arg(todo.ID)),
+			),
+		)),
+		positionOrder(),
+		sm.Limit(1),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return appendPosition(ctx, exec, scope)
+	}
+	if err != nil {
+		return "", err
+	}
+	position, err := rank.Between(todo.Position, next.Position)
+	if err == nil && len(position) <= rank.MaxLength {
+		return position, nil
+	}
+
+	// 位置が詰まっているので、全体を振り直してから間に置く
+	todos, err := models.Todos.Query(scope.where(), positionOrder()).All(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+	if err := rebalancePositions(ctx, exec, todos); err != nil {
+		return "", err
+	}
+	index := slices.IndexFunc(todos, func(t *models.Todo) bool { return t.ID == todo.ID })
+	todo.Position = todos[index].Position
+	return rank.Between(todos[index].Position, todos[index+1].Position)
+}
+
+// positionsAfter は prev の後ろに n 件を順に並べる位置を返す。位置が長くなりすぎる場合は ok が false
+func positionsAfter(prev string, n int) (positions []string, ok bool) {
+	positions = make([]string, n)
+	for i := range positions {
+		position, err := rank.Between(prev, "")
+		if err != nil || len(position) > rank.MaxLength {
+			return nil, false
+		}
+		positions[i] = position
+		prev = position
+	}
+	return positions, true
+}
+
+// rebalancePositions は todos をこの順に均等な間隔の位置へ振り直す。位置が変わらないTodoは更新しない
+func rebalancePositions(ctx context.Context, exec bob.Executor, todos models.TodoSlice) error {
+	positions := rank.Spread(len(todos))
+	for i, todo := range todos {
+		if todo.Position == positions[i] {
+			continue
+		}
+		_, err := models.Todos.Update(
+			models.TodoSetter{Position: omit.From(positions[i])}.UpdateMod(),
+			models.UpdateWhere.Todos.ID.EQ(todo.ID),
+		).Exec(ctx, exec)
+		if err != nil {
+			return err
+		}
+		todo.Position = positions[i]
+	}
+	return nil
+}
+
+// positionScopeForList は新しく作るTodoの範囲。listID が0なら userID の受信箱
+func positionScopeForList(listID, userID int64) positionScope {
+	if listID == 0 {
+		return positionScope{UserID: userID}
+	}
+	return positionScope{ListID: null.From(listID), UserID: userID}
+}
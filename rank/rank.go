@@ -0,0 +1,140 @@
+// Package rank は手動の並び順を表す位置（辞書順で比較する文字列）を作る
+// 2つの位置の間にいつでも新しい位置を作れるので、1件の移動で他の行の位置を書き換えずに済む
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+// digits は位置に使う文字。ASCII順（= SQLiteのBINARY照合順）に並んでいる
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const base = len(digits)
+
+// MaxLength はこれより長い位置ができたら振り直す目安の長さ
+const MaxLength = 16
+
+// ErrNoRoom は指定した2つの位置の間に位置を作れないことを表す
+// 位置の順序が逆のときや同じ位置のときも返す。振り直してから作り直すこと
+var ErrNoRoom = errors.New("rank: no room between positions")
+
+// ErrInvalid は位置に使えない文字を含むことを表す
+var ErrInvalid = errors.New("rank: invalid position")
+
+// Between は a と b の間に並ぶ位置を返す。a が空なら先頭より前、b が空なら末尾より後ろを表す
+// 末尾に追加し続けても位置が長くなりにくいよう、b が空のときはなるべく短い位置を返す
+func Between(a, b string) (string, error) {
+	if !valid(a) || !valid(b) {
+		return "", ErrInvalid
+	}
+	if b != "" && a >= b {
+		return "", ErrNoRoom
+	}
+	var r string
+	if b == "" {
+		r = after(a)
+	} else {
+		r = midpoint(a, b)
+	}
+	// b が a の後ろに "0" を足しただけの場合など、間に文字列が存在しないときはここで弾く
+	if r <= a || (b != "" && r >= b) {
+		return "", ErrNoRoom
+	}
+	return r, nil
+}
+
+// Spread は n 件を均等な間隔で並べる位置を、同じ長さの文字列として返す
+// 隣り合う位置の間には少なくとも base 個分の余裕を残す
+func Spread(n int) []string {
+	width := 1
+	for capacity := base; capacity < (n+1)*base; capacity *= base {
+		width++
+	}
+	capacity := 1
+	for range width {
+		capacity *= base
+	}
+	step := capacity / (n + 1)
+
+	positions := make([]string, n)
+	for i := range n {
+		positions[i] = encode((i+1)*step, width)
+	}
+	return positions
+}
+
+// after は a より後ろに並ぶなるべく短い位置を返す
+// 先頭から見て最初の "z" でない文字を1つ進め、それより後ろは切り捨てる
+func after(a string) string {
+	for i := 0; i < len(a); i++ {
+		if d := strings.IndexByte(digits, a[i]); d < base-1 {
+			return a[:i] + string(digits[d+1])
+		}
+	}
+	return a + string(digits[base/2])
+}
+
+// midpoint は a と b（a < b、b は空でない）のおおよそ中間の位置を返す
+func midpoint(a, b string) string {
+	// 共通の先頭部分はそのまま使い、残りの中間を求める
+	n := 0
+	for n < len(b) && digitAt(a, n) == b[n] {
+		n++
+	}
+	if n > 0 {
+		rest := ""
+		if n < len(a) {
+			rest = a[n:]
+		}
+		if n == len(b) {
+			// b が a の先頭部分に一致する（a >= b）。呼び出し元で弾かれる
+			return b
+		}
+		return b[:n] + midpoint(rest, b[n:])
+	}
+
+	da := 0
+	if a != "" {
+		da = strings.IndexByte(digits, a[0])
+	}
+	db := strings.IndexByte(digits, b[0])
+	if db-da > 1 {
+		return string(digits[(da+db)/2])
+	}
+	// 先頭の文字が隣り合っているときは、b が長ければ b の先頭1文字が間に入る
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(digits[da]) + after(rest)
+}
+
+// digitAt は s の i 文字目を返す。s より後ろは最小の文字 "0" が続くものとみなす
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+func encode(v, width int) string {
+	b := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		b[i] = digits[v%base]
+		v /= base
+	}
+	return string(b)
+}
+
+func valid(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(digits, s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
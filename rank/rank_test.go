@@ -0,0 +1,129 @@
+package rank
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"", ""},
+		{"", "V"},
+		{"V", ""},
+		{"A", "B"},
+		{"A", "A1"},
+		{"A5", "B"},
+		{"", "1"},
+		{"", "0V"},
+		{"zz", ""},
+		{"0000000009", "0000000010"},
+		{"0000000001", "0000000010"},
+		{"A0", "A1"},
+	}
+	for _, tt := range tests {
+		got, err := Between(tt.a, tt.b)
+		if err != nil {
+			t.Errorf("Between(%q, %q): %v", tt.a, tt.b, err)
+			continue
+		}
+		if got <= tt.a || (tt.b != "" && got >= tt.b) {
+			t.Errorf("Between(%q, %q) = %q, not between", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestBetweenNoRoom(t *testing.T) {
+	for _, tt := range []struct{ a, b string }{
+		{"B", "A"},
+		{"A", "A"},
+		{"A", "A0"},
+		{"", "0"},
+		{"A", "A00"},
+	} {
+		if got, err := Between(tt.a, tt.b); !errors.Is(err, ErrNoRoom) {
+			t.Errorf("Between(%q, %q) = %q, %v; want ErrNoRoom", tt.a, tt.b, got, err)
+		}
+	}
+	if _, err := Between("a-b", ""); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Between with invalid char: err = %v, want ErrInvalid", err)
+	}
+}
+
+func TestBetweenAppendStaysShort(t *testing.T) {
+	last := ""
+	for i := range 200 {
+		next, err := Between(last, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next <= last {
+			t.Fatalf("append %d: %q <= %q", i, next, last)
+		}
+		last = next
+	}
+	if len(last) > 8 {
+		t.Errorf("after 200 appends len = %d (%q), want <= 8", len(last), last)
+	}
+}
+
+func TestSpread(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 61, 62, 100, 3843, 10000} {
+		positions := Spread(n)
+		if len(positions) != n {
+			t.Fatalf("Spread(%d) returned %d positions", n, len(positions))
+		}
+		for i, p := range positions {
+			if len(p) != len(positions[0]) {
+				t.Fatalf("Spread(%d)[%d] = %q, want width %d", n, i, p, len(positions[0]))
+			}
+			if i > 0 && positions[i-1] >= p {
+				t.Fatalf("Spread(%d) not increasing at %d: %q >= %q", n, i, positions[i-1], p)
+			}
+		}
+		// 振り直した直後は隣り合う位置の間に挿入できる
+		for i := 1; i < n; i++ {
+			if _, err := Between(positions[i-1], positions[i]); err != nil {
+				t.Fatalf("Spread(%d): Between(%q, %q): %v", n, positions[i-1], positions[i], err)
+			}
+		}
+	}
+}
+
+// 任意の場所への挿入を繰り返し、足りなくなったら振り直しても順序が保たれることを確かめる
+func TestRandomInsertions(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	var positions []string
+	rebalances := 0
+	for range 5000 {
+		i := r.IntN(len(positions) + 1)
+		var prev, next string
+		if i > 0 {
+			prev = positions[i-1]
+		}
+		if i < len(positions) {
+			next = positions[i]
+		}
+		p, err := Between(prev, next)
+		if err != nil || len(p) > MaxLength {
+			positions = Spread(len(positions))
+			rebalances++
+			continue
+		}
+		positions = slices.Insert(positions, i, p)
+		if !slices.IsSorted(positions) {
+			t.Fatalf("positions not sorted after inserting %q at %d", p, i)
+		}
+	}
+	for i := 1; i < len(positions); i++ {
+		if positions[i-1] == positions[i] {
+			t.Fatalf("duplicate position %q", positions[i])
+		}
+	}
+	if rebalances > 50 {
+		t.Errorf("rebalanced %d times, want fewer", rebalances)
+	}
+}
@@ -0,0 +1,325 @@
+// Package recurrence はTodoの繰り返しルール（RFC 5545 のRRULE）を扱う
+// フォームで選んだ設定とRRULE文字列の相互変換、表示用の説明、次の発生日の計算を行う
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// 繰り返しの種類
+const (
+	FreqDaily    = "daily"
+	FreqWeekdays = "weekdays"
+	FreqWeekly   = "weekly"
+	FreqMonthly  = "monthly"
+	FreqYearly   = "yearly"
+)
+
+// 毎月の繰り返し方
+const (
+	MonthlyByDay     = "day"     // 毎月N日
+	MonthlyByLastDay = "last"    // 毎月最終日
+	MonthlyByWeekday = "weekday" // 毎月第N曜日
+)
+
+// maxInterval は「N日ごと」などで指定できる間隔の上限
+const maxInterval = 99
+
+// Weekday は曜日のRRULEでの表記と表示名
+type Weekday struct {
+	Code  string
+	Label string
+}
+
+// Weekdays は月曜始まりの曜日
+var Weekdays = []Weekday{
+	{Code: "MO", Label: "月"},
+	{Code: "TU", Label: "火"},
+	{Code: "WE", Label: "水"},
+	{Code: "TH", Label: "木"},
+	{Code: "FR", Label: "金"},
+	{Code: "SA", Label: "土"},
+	{Code: "SU", Label: "日"},
+}
+
+// workdays は「平日」に当たる曜日
+var workdays = []string{"MO", "TU", "WE", "TH", "FR"}
+
+// Options はフォームで選ぶ繰り返しの設定
+type Options struct {
+	Freq      string   // FreqDaily などのいずれか
+	Interval  int      // 何日・何週・何か月・何年ごとか（0は1とみなす）
+	Weekdays  []string // 毎週の曜日（MO〜SU）。空なら起点の日の曜日
+	MonthlyBy string   // 毎月の繰り返し方（MonthlyByDay などのいずれか）
+	MonthDay  int      // 毎月N日のN（1〜31）。その日がない月は飛ばす
+	Ordinal   int      // 毎月第N曜日のN（1〜4、-1は最終）
+	Weekday   string   // 毎月第N曜日の曜日（MO〜SU）
+}
+
+// ErrUnsupported はフォームで表せないRRULEであることを表す
+var ErrUnsupported = errors.New("recurrence: unsupported rule")
+
+// RRule は設定をRRULE文字列（"RRULE:" は付けない）に変換する
+func (o Options) RRule() (string, error) {
+	interval := o.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	if interval < 1 || interval > maxInterval {
+		return "", fmt.Errorf("間隔は1〜%dで指定してください", maxInterval)
+	}
+
+	var parts []string
+	switch o.Freq {
+	case FreqDaily:
+		parts = append(parts, "FREQ=DAILY")
+	case FreqWeekdays:
+		// 平日は間隔を指定できない
+		return "FREQ=WEEKLY;BYDAY=" + strings.Join(workdays, ","), nil
+	case FreqWeekly:
+		parts = append(parts, "FREQ=WEEKLY")
+	case FreqMonthly:
+		parts = append(parts, "FREQ=MONTHLY")
+	case FreqYearly:
+		parts = append(parts, "FREQ=YEARLY")
+	default:
+		return "", errors.New("繰り返しの種類が正しくありません")
+	}
+	if interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(interval))
+	}
+
+	switch o.Freq {
+	case FreqWeekly:
+		if len(o.Weekdays) > 0 {
+			var days []string
+			// 入力順によらず月曜始まりの順に並べる
+			for _, w := range Weekdays {
+				if slices.Contains(o.Weekdays, w.Code) {
+					days = append(days, w.Code)
+				}
+			}
+			if len(days) != len(o.Weekdays) {
+				return "", errors.New("曜日が正しくありません")
+			}
+			parts = append(parts, "BYDAY="+strings.Join(days, ","))
+		}
+	case FreqMonthly:
+		switch o.MonthlyBy {
+		case MonthlyByDay:
+			if o.MonthDay < 1 || o.MonthDay > 31 {
+				return "", errors.New("日付は1〜31で指定してください")
+			}
+			parts = append(parts, "BYMONTHDAY="+strconv.Itoa(o.MonthDay))
+		case MonthlyByLastDay:
+			parts = append(parts, "BYMONTHDAY=-1")
+		case MonthlyByWeekday:
+			if (o.Ordinal < 1 || o.Ordinal > 4) && o.Ordinal != -1 {
+				return "", errors.New("第何週かが正しくありません")
+			}
+			if !isWeekday(o.Weekday) {
+				return "", errors.New("曜日が正しくありません")
+			}
+			parts = append(parts, "BYDAY="+strconv.Itoa(o.Ordinal)+o.Weekday)
+		default:
+			return "", errors.New("毎月の繰り返し方が正しくありません")
+		}
+	}
+
+	rule := strings.Join(parts, ";")
+	if _, err := rrule.StrToROption(rule); err != nil {
+		return "", err
+	}
+	return rule, nil
+}
+
+// Parse はRRULE文字列をフォームの設定に戻す。フォームで表せないルールは ErrUnsupported を返す
+func Parse(rule string) (Options, error) {
+	fields := map[string]string{}
+	for part := range strings.SplitSeq(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Options{}, ErrUnsupported
+		}
+		fields[strings.ToUpper(key)] = strings.ToUpper(value)
+	}
+
+	var o Options
+	o.Interval = 1
+	if v, ok := fields["INTERVAL"]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxInterval {
+			return Options{}, ErrUnsupported
+		}
+		o.Interval = n
+	}
+	byDay, hasByDay := fields["BYDAY"]
+	byMonthDay, hasByMonthDay := fields["BYMONTHDAY"]
+	for key := range fields {
+		if key != "FREQ" && key != "INTERVAL" && key != "BYDAY" && key != "BYMONTHDAY" {
+			return Options{}, ErrUnsupported
+		}
+	}
+
+	switch fields["FREQ"] {
+	case "DAILY":
+		o.Freq = FreqDaily
+		if hasByDay || hasByMonthDay {
+			return Options{}, ErrUnsupported
+		}
+	case "WEEKLY":
+		if hasByMonthDay {
+			return Options{}, ErrUnsupported
+		}
+		if byDay == strings.Join(workdays, ",") && o.Interval == 1 {
+			o.Freq = FreqWeekdays
+			return o, nil
+		}
+		o.Freq = FreqWeekly
+		if hasByDay {
+			for day := range strings.SplitSeq(byDay, ",") {
+				if !isWeekday(day) {
+					return Options{}, ErrUnsupported
+				}
+				o.Weekdays = append(o.Weekdays, day)
+			}
+		}
+	case "MONTHLY":
+		o.Freq = FreqMonthly
+		switch {
+		case hasByDay && hasByMonthDay:
+			return Options{}, ErrUnsupported
+		case hasByMonthDay && byMonthDay == "-1":
+			o.MonthlyBy = MonthlyByLastDay
+		case hasByMonthDay:
+			n, err := strconv.Atoi(byMonthDay)
+			if err != nil || n < 1 || n > 31 {
+				return Options{}, ErrUnsupported
+			}
+			o.MonthlyBy, o.MonthDay = MonthlyByDay, n
+		case hasByDay:
+			if len(byDay) < 3 || !isWeekday(byDay[len(byDay)-2:]) {
+				return Options{}, ErrUnsupported
+			}
+			n, err := strconv.Atoi(byDay[:len(byDay)-2])
+			if err != nil || ((n < 1 || n > 4) && n != -1) {
+				return Options{}, ErrUnsupported
+			}
+			o.MonthlyBy, o.Ordinal, o.Weekday = MonthlyByWeekday, n, byDay[len(byDay)-2:]
+		default:
+			return Options{}, ErrUnsupported
+		}
+	case "YEARLY":
+		o.Freq = FreqYearly
+		if hasByDay || hasByMonthDay {
+			return Options{}, ErrUnsupported
+		}
+	default:
+		return Options{}, ErrUnsupported
+	}
+	return o, nil
+}
+
+// Describe は「毎日」「2週ごと 火」「毎月 最終日」のような表示用の説明を返す
+// フォームで表せないルールはRRULEをそのまま返す
+func Describe(rule string) string {
+	o, err := Parse(rule)
+	if err != nil {
+		return rule
+	}
+	every := func(unit, everyLabel string) string {
+		if o.Interval > 1 {
+			return strconv.Itoa(o.Interval) + unit + "ごと"
+		}
+		return everyLabel
+	}
+
+	switch o.Freq {
+	case FreqDaily:
+		return every("日", "毎日")
+	case FreqWeekdays:
+		return "平日"
+	case FreqWeekly:
+		s := every("週", "毎週")
+		if len(o.Weekdays) > 0 {
+			labels := make([]string, 0, len(o.Weekdays))
+			for _, day := range o.Weekdays {
+				labels = append(labels, weekdayLabel(day))
+			}
+			s += " " + strings.Join(labels, "・")
+		}
+		return s
+	case FreqMonthly:
+		s := every("か月", "毎月")
+		switch o.MonthlyBy {
+		case MonthlyByDay:
+			return s + " " + strconv.Itoa(o.MonthDay) + "日"
+		case MonthlyByLastDay:
+			return s + " 最終日"
+		default:
+			if o.Ordinal == -1 {
+				return s + " 最終" + weekdayLabel(o.Weekday) + "曜"
+			}
+			return s + " 第" + strconv.Itoa(o.Ordinal) + weekdayLabel(o.Weekday) + "曜"
+		}
+	default:
+		return every("年", "毎年")
+	}
+}
+
+// Next は start の日を起点とする繰り返しで、after の日より後の最初の発生日を返す
+// 日付は loc で数え、戻り値は loc におけるその日の0時になる。次の発生日がなければゼロ値を返す
+//
+// 夏時間の切り替えで時刻がずれて日付が変わらないよう、計算は時差のない日付だけで行う
+func Next(rule string, start, after time.Time, loc *time.Location) (time.Time, error) {
+	option, err := rrule.StrToROption(rule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	option.Dtstart = floatingDate(start, loc)
+	r, err := rrule.NewRRule(*option)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next := r.After(floatingDate(after, loc), false)
+	if next.IsZero() {
+		return time.Time{}, nil
+	}
+	return startOfDate(next.Year(), next.Month(), next.Day(), loc), nil
+}
+
+// startOfDate は loc における指定した日の最初の時刻を返す
+// 夏時間の切り替えで0時が存在しない日は、time.Date が前日の時刻を返すので切り替え後まで進める
+func startOfDate(year int, month time.Month, day int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	for t.Day() != day {
+		t = t.Add(15 * time.Minute)
+	}
+	return t
+}
+
+// floatingDate は loc におけるtの日付を、UTCの同じ日付の0時として返す
+func floatingDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func isWeekday(code string) bool {
+	return weekdayLabel(code) != ""
+}
+
+func weekdayLabel(code string) string {
+	for _, w := range Weekdays {
+		if w.Code == code {
+			return w.Label
+		}
+	}
+	return ""
+}
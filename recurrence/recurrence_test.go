@@ -0,0 +1,213 @@
+package recurrence
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestOptionsRRule(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		options Options
+		want    string
+		wantErr bool
+	}{
+		{name: "毎日", options: Options{Freq: FreqDaily}, want: "FREQ=DAILY"},
+		{name: "3日ごと", options: Options{Freq: FreqDaily, Interval: 3}, want: "FREQ=DAILY;INTERVAL=3"},
+		{name: "平日", options: Options{Freq: FreqWeekdays, Interval: 5}, want: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+		{name: "2週ごとの火曜", options: Options{Freq: FreqWeekly, Interval: 2, Weekdays: []string{"TU"}}, want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU"},
+		{name: "曜日は月曜始まりに並べる", options: Options{Freq: FreqWeekly, Weekdays: []string{"FR", "MO"}}, want: "FREQ=WEEKLY;BYDAY=MO,FR"},
+		{name: "毎月15日", options: Options{Freq: FreqMonthly, MonthlyBy: MonthlyByDay, MonthDay: 15}, want: "FREQ=MONTHLY;BYMONTHDAY=15"},
+		{name: "毎月最終日", options: Options{Freq: FreqMonthly, MonthlyBy: MonthlyByLastDay}, want: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+		{name: "毎月第2火曜", options: Options{Freq: FreqMonthly, MonthlyBy: MonthlyByWeekday, Ordinal: 2, Weekday: "TU"}, want: "FREQ=MONTHLY;BYDAY=2TU"},
+		{name: "3か月ごとの最終金曜", options: Options{Freq: FreqMonthly, Interval: 3, MonthlyBy: MonthlyByWeekday, Ordinal: -1, Weekday: "FR"}, want: "FREQ=MONTHLY;INTERVAL=3;BYDAY=-1FR"},
+		{name: "毎年", options: Options{Freq: FreqYearly}, want: "FREQ=YEARLY"},
+		{name: "種類なし", options: Options{}, wantErr: true},
+		{name: "間隔が大きすぎる", options: Options{Freq: FreqDaily, Interval: 100}, wantErr: true},
+		{name: "間隔が負", options: Options{Freq: FreqDaily, Interval: -1}, wantErr: true},
+		{name: "不正な曜日", options: Options{Freq: FreqWeekly, Weekdays: []string{"XX"}}, wantErr: true},
+		{name: "32日", options: Options{Freq: FreqMonthly, MonthlyBy: MonthlyByDay, MonthDay: 32}, wantErr: true},
+		{name: "第5週", options: Options{Freq: FreqMonthly, MonthlyBy: MonthlyByWeekday, Ordinal: 5, Weekday: "MO"}, wantErr: true},
+		{name: "毎月の繰り返し方なし", options: Options{Freq: FreqMonthly}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.options.RRule()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("RRule() = %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("RRule() = %q, want %q", got, tt.want)
+			}
+
+			// RRULEから同じ設定に戻せる
+			parsed, err := Parse(got)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", got, err)
+			}
+			again, err := parsed.RRule()
+			if err != nil || again != got {
+				t.Errorf("round trip = %q, %v; want %q", again, err, got)
+			}
+		})
+	}
+}
+
+func TestParseUnsupported(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=3",
+		"FREQ=MONTHLY;BYDAY=5MO",
+		"FREQ=MONTHLY;BYMONTHDAY=1;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"garbage",
+	} {
+		if _, err := Parse(rule); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("Parse(%q) error = %v, want ErrUnsupported", rule, err)
+		}
+	}
+
+	o, err := Parse("RRULE:FREQ=WEEKLY;BYDAY=MO,WE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Freq != FreqWeekly || !slices.Equal(o.Weekdays, []string{"MO", "WE"}) {
+		t.Errorf("Parse with prefix = %+v", o)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	for rule, want := range map[string]string{
+		"FREQ=DAILY":                         "毎日",
+		"FREQ=DAILY;INTERVAL=3":              "3日ごと",
+		"FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR":   "平日",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU":    "2週ごと 火",
+		"FREQ=WEEKLY;BYDAY=MO,WE,FR":         "毎週 月・水・金",
+		"FREQ=MONTHLY;BYMONTHDAY=15":         "毎月 15日",
+		"FREQ=MONTHLY;BYMONTHDAY=-1":         "毎月 最終日",
+		"FREQ=MONTHLY;BYDAY=2TU":             "毎月 第2火曜",
+		"FREQ=MONTHLY;INTERVAL=3;BYDAY=-1FR": "3か月ごと 最終金曜",
+		"FREQ=YEARLY":                        "毎年",
+		"FREQ=HOURLY":                        "FREQ=HOURLY",
+	} {
+		if got := Describe(rule); got != want {
+			t.Errorf("Describe(%q) = %q, want %q", rule, got, want)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, tokyo)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		rule  string
+		start time.Time
+		after time.Time
+		want  time.Time
+	}{
+		{"毎日", "FREQ=DAILY", date(2026, 10, 16), date(2026, 10, 16), date(2026, 10, 17)},
+		{"月末をまたぐ毎日", "FREQ=DAILY", date(2026, 10, 31), date(2026, 10, 31), date(2026, 11, 1)},
+		{"3日ごと", "FREQ=DAILY;INTERVAL=3", date(2026, 10, 16), date(2026, 10, 16), date(2026, 10, 19)},
+		{"遅れて完了した3日ごとは周期を保つ", "FREQ=DAILY;INTERVAL=3", date(2026, 10, 16), date(2026, 10, 20), date(2026, 10, 22)},
+		{"金曜の次の平日は月曜", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", date(2026, 10, 16), date(2026, 10, 16), date(2026, 10, 19)},
+		{"土曜起点の平日", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", date(2026, 10, 17), date(2026, 10, 17), date(2026, 10, 19)},
+		{"隔週火曜", "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU", date(2026, 10, 13), date(2026, 10, 13), date(2026, 10, 27)},
+		{"毎週月・金の月曜の次は金曜", "FREQ=WEEKLY;BYDAY=MO,FR", date(2026, 10, 12), date(2026, 10, 12), date(2026, 10, 16)},
+		{"毎月第2火曜", "FREQ=MONTHLY;BYDAY=2TU", date(2026, 10, 13), date(2026, 10, 13), date(2026, 11, 10)},
+		{"毎月最終金曜", "FREQ=MONTHLY;BYDAY=-1FR", date(2026, 10, 30), date(2026, 10, 30), date(2026, 11, 27)},
+		{"1月31日の次の最終日は2月28日", "FREQ=MONTHLY;BYMONTHDAY=-1", date(2027, 1, 31), date(2027, 1, 31), date(2027, 2, 28)},
+		{"閏年の2月は29日", "FREQ=MONTHLY;BYMONTHDAY=-1", date(2028, 1, 31), date(2028, 1, 31), date(2028, 2, 29)},
+		{"31日がない月は飛ばす", "FREQ=MONTHLY;BYMONTHDAY=31", date(2026, 10, 31), date(2026, 10, 31), date(2026, 12, 31)},
+		{"2か月ごと", "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=5", date(2026, 10, 5), date(2026, 10, 5), date(2026, 12, 5)},
+		{"毎年", "FREQ=YEARLY", date(2026, 10, 16), date(2026, 10, 16), date(2027, 10, 16)},
+		{"2月29日の毎年は次の閏年", "FREQ=YEARLY", date(2028, 2, 29), date(2028, 2, 29), date(2032, 2, 29)},
+		{"起点が条件に合わなければ最初の該当日", "FREQ=MONTHLY;BYMONTHDAY=-1", date(2026, 10, 16), date(2026, 10, 15), date(2026, 10, 31)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Next(tt.rule, tt.start, tt.after, tokyo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Next() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextAcrossDST(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		tz    string
+		rule  string
+		start [3]int
+		want  [3]int
+	}{
+		// 2026-03-08 2:00 に夏時間が始まる
+		{"ニューヨーク夏時間開始の前日から毎日", "America/New_York", "FREQ=DAILY", [3]int{2026, 3, 7}, [3]int{2026, 3, 8}},
+		{"ニューヨーク夏時間開始をまたぐ毎週", "America/New_York", "FREQ=WEEKLY", [3]int{2026, 3, 2}, [3]int{2026, 3, 9}},
+		// 2026-11-01 2:00 に夏時間が終わる（その日は25時間）
+		{"ニューヨーク夏時間終了の当日から毎日", "America/New_York", "FREQ=DAILY", [3]int{2026, 11, 1}, [3]int{2026, 11, 2}},
+		{"ニューヨーク夏時間終了をまたぐ毎月", "America/New_York", "FREQ=MONTHLY;BYMONTHDAY=-1", [3]int{2026, 10, 31}, [3]int{2026, 11, 30}},
+		// 2026-03-29 1:00 に夏時間が始まる
+		{"ロンドン夏時間開始をまたぐ平日", "Europe/London", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", [3]int{2026, 3, 27}, [3]int{2026, 3, 30}},
+		{"ロンドン夏時間終了をまたぐ隔週", "Europe/London", "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU", [3]int{2026, 10, 18}, [3]int{2026, 11, 1}},
+		// サンティアゴは0時に夏時間が始まり、その日の0時が存在しない
+		{"サンティアゴ0時の夏時間開始", "America/Santiago", "FREQ=DAILY", [3]int{2026, 9, 5}, [3]int{2026, 9, 6}},
+		{"サンティアゴ夏時間開始日の毎週", "America/Santiago", "FREQ=WEEKLY", [3]int{2026, 8, 30}, [3]int{2026, 9, 6}},
+		// ロード・ハウ島は夏時間の差が30分
+		{"ロード・ハウ島の夏時間開始", "Australia/Lord_Howe", "FREQ=DAILY", [3]int{2026, 10, 3}, [3]int{2026, 10, 4}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := mustLoadLocation(t, tt.tz)
+			start := time.Date(tt.start[0], time.Month(tt.start[1]), tt.start[2], 0, 0, 0, 0, loc)
+
+			got, err := Next(tt.rule, start, start, loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 保存するUTCからユーザーのタイムゾーンに戻しても同じ日付になる
+			y, m, d := got.UTC().In(loc).Date()
+			if [3]int{y, int(m), d} != tt.want {
+				t.Errorf("Next() = %d-%02d-%02d (%s), want %v", y, m, d, got, tt.want)
+			}
+			if prev := got.Add(-time.Minute).In(loc); prev.Day() == d {
+				t.Errorf("Next() = %s, want the first instant of the day", got)
+			}
+		})
+	}
+}
+
+func TestNextUsesLocalDate(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	// 東京の10月16日 8:00 はUTCでは10月15日
+	after := time.Date(2026, 10, 15, 23, 0, 0, 0, time.UTC)
+	got, err := Next("FREQ=DAILY", after, after, tokyo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 10, 17, 0, 0, 0, 0, tokyo); !got.Equal(want) {
+		t.Errorf("Next() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/recurrence"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// registerRecurrenceRoutes は繰り返し設定のルートを登録する
+// 繰り返しはサブタスクではないTodoにだけ設定できる
+func registerRecurrenceRoutes(g *echo.Group, db bob.DB) {
+	// 繰り返し設定ページ
+	g.GET("/:id/recurrence", func(c echo.Context) error {
+		todo := c.Get("todo").(*models.Todo)
+		if todo.ParentID.IsValue() {
+			return echo.NewHTTPError(http.StatusBadRequest, "サブタスクは繰り返せません")
+		}
+		options := defaultRecurrenceOptions(todo, time.Now(), c.Get("location").(*time.Location))
+		if rule, ok := todo.Recurrence.Get(); ok {
+			if parsed, err := recurrence.Parse(rule); err == nil {
+				options = parsed
+			}
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.RecurrencePage(todo, options, csrfToken, nil))
+	}, requireTodoRole(db, RoleEditor))
+
+	// 繰り返し設定の保存。種類が空なら繰り返しをやめる
+	g.POST("/:id/recurrence", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		loc := c.Get("location").(*time.Location)
+		if todo.ParentID.IsValue() {
+			return echo.NewHTTPError(http.StatusBadRequest, "サブタスクは繰り返せません")
+		}
+
+		setter := &models.TodoSetter{UpdatedAt: omit.From(time.Now().UTC())}
+		options := recurrenceOptionsFromForm(c)
+		if options.Freq == "" {
+			setter.Recurrence.Null()
+		} else {
+			rule, err := options.RRule()
+			if err != nil {
+				csrfToken := c.Get("csrf").(string)
+				return render(c, http.StatusBadRequest, views.RecurrencePage(todo, options, csrfToken, map[string][]string{"recurrence": {err.Error()}}))
+			}
+			setter.Recurrence = omitnull.From(rule)
+
+			// 期限日がなければ今日以降の最初の発生日を期限日にする
+			if !todo.DueAt.IsValue() {
+				today := startOfDay(time.Now(), loc)
+				first, err := recurrence.Next(rule, today, today.AddDate(0, 0, -1), loc)
+				if err != nil {
+					return err
+				}
+				if !first.IsZero() {
+					setter.DueAt = omitnull.From(first.UTC())
+				}
+			}
+		}
+
+		if err := todo.Update(ctx, db, setter); err != nil {
+			return err
+		}
+		path := "/todos"
+		if listID, ok := todo.ListID.Get(); ok {
+			path = "/lists/" + strconv.FormatInt(listID, 10) + "/todos"
+		}
+		return c.Redirect(http.StatusFound, path)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// recurrenceOptionsFromForm はフォームの入力を繰り返しの設定にする。数値でない値は0として扱い、RRule で検証する
+func recurrenceOptionsFromForm(c echo.Context) recurrence.Options {
+	atoi := func(name string) int {
+		n, _ := strconv.Atoi(c.FormValue(name))
+		return n
+	}
+	form, _ := c.FormParams()
+	return recurrence.Options{
+		Freq:      c.FormValue("freq"),
+		Interval:  atoi("interval"),
+		Weekdays:  form["weekdays"],
+		MonthlyBy: c.FormValue("monthly_by"),
+		MonthDay:  atoi("month_day"),
+		Ordinal:   atoi("ordinal"),
+		Weekday:   c.FormValue("weekday"),
+	}
+}
+
+// defaultRecurrenceOptions は繰り返しのないTodoの設定ページで最初に選ばれている値
+// 期限日（なければ今日）の曜日や日付を初期値にする
+func defaultRecurrenceOptions(todo *models.Todo, now time.Time, loc *time.Location) recurrence.Options {
+	day := todo.DueAt.GetOr(now).In(loc)
+	weekday := recurrence.Weekdays[(int(day.Weekday())+6)%7].Code
+	return recurrence.Options{
+		Interval:  1,
+		Weekdays:  []string{weekday},
+		MonthlyBy: recurrence.MonthlyByDay,
+		MonthDay:  day.Day(),
+		Ordinal:   (day.Day()-1)/7 + 1,
+		Weekday:   weekday,
+	}
+}
+
+// completeRecurringTodo は繰り返しのTodoを完了にし、次の発生日のTodoを作って返す
+// 完了したTodoは繰り返しを外して履歴として残し、タイトル・優先度・メモ・タグ・サブタスク（未完了に戻す）を引き継ぐ
+// 次の発生日のTodoは手動の並び順で完了したTodoの直後に置く
+// 次の発生日は期限日と今日のうち遅いほうより後の最初の日とし、遅れて完了しても過去の日付にはしない
+// 繰り返しが終わっていて次の発生日がなければnilを返す。複数の行を書くのでトランザクションの中で呼ぶ
+// setter には完了にするのと一緒に書き換える列を渡す（なければ nil）。完了状態・更新日時・繰り返しは上書きする
+func completeRecurringTodo(ctx context.Context, exec bob.Executor, todo *models.Todo, now time.Time, loc *time.Location, setter *models.TodoSetter) (*models.Todo, error) {
+	rule := todo.Recurrence.GetOrZero()
+	today := startOfDay(now, loc)
+	start := todo.DueAt.GetOr(today)
+	after := start
+	if after.Before(today) {
+		after = today
+	}
+	nextDue, err := recurrence.Next(rule, start, after, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if setter == nil {
+		setter = &models.TodoSetter{}
+	}
+	setter.Completed = omit.From(true)
+	setter.UpdatedAt = omit.From(now)
+	setter.Recurrence.Null()
+	if err := todo.Update(ctx, exec, setter); err != nil {
+		return nil, err
+	}
+	if nextDue.IsZero() {
+		return nil, nil
+	}
+
+	position, err := positionAfter(ctx, exec, todo)
+	if err != nil {
+		return nil, err
+	}
+	next, err := models.Todos.Insert(&models.TodoSetter{
+		UserID:     omit.From(todo.UserID),
+		ListID:     omitnull.FromNull(todo.ListID),
+		Title:      omit.From(todo.Title),
+		Priority:   omit.From(todo.Priority),
+		DueAt:      omitnull.From(nextDue.UTC()),
+		Recurrence: omitnull.From(rule),
+		Notes:      omit.From(todo.Notes),
+		Position:   omit.From(position),
+	}).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := todo.Tags().All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) > 0 {
+		setters := make([]*models.TodoTagSetter, len(tags))
+		for i, tag := range tags {
+			setters[i] = &models.TodoTagSetter{TodoID: omit.From(next.ID), TagID: omit.From(tag.ID)}
+		}
+		if _, err := models.TodoTags.Insert(bob.ToMods(setters...)).Exec(ctx, exec); err != nil {
+			return nil, err
+		}
+	}
+
+	children, err := todo.Children(sm.OrderBy(models.Todos.Columns.ID)).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		_, err := models.Todos.Insert(&models.TodoSetter{
+			UserID:   omit.From(child.UserID),
+			ListID:   omitnull.FromNull(child.ListID),
+			ParentID: omitnull.From(next.ID),
+			Title:    omit.From(child.Title),
+			Notes:    omit.From(child.Notes),
+		}).One(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next, nil
+}
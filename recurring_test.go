@@ -0,0 +1,238 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestToggleRecurringTodoSpawnsNext(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+	tokyo := loadLocation("Asia/Tokyo")
+
+	alice := createTestUser(t, db, "alice@example.com")
+	due := time.Date(2099, 1, 5, 0, 0, 0, 0, tokyo)
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.Title("weekly-review"),
+		factory.TodoMods.Completed(false),
+		factory.TodoMods.Priority(priorityHigh),
+		factory.TodoMods.DueAt(null.From(due.UTC())),
+		factory.TodoMods.Recurrence(null.From("FREQ=WEEKLY")),
+		factory.TodoMods.Notes("議事録を共有する"),
+		factory.TodoMods.Position("a"),
+	).CreateOrFail(ctx, t, db)
+	f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ParentID(null.From(todo.ID)),
+		factory.TodoMods.Title("checklist-item"),
+		factory.TodoMods.Completed(true),
+	).CreateOrFail(ctx, t, db)
+	// 同じ位置に並んでいる後ろのTodo
+	later := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Position("a")).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/tags", url.Values{"name": {"routine"}})
+
+	rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/toggle", url.Values{})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("toggle: status = %d", rec.Code)
+	}
+
+	// 完了したTodoは繰り返しを外して残る
+	if err := todo.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if !todo.Completed || todo.Recurrence.IsValue() {
+		t.Errorf("completed todo: completed = %v, recurrence = %v", todo.Completed, todo.Recurrence)
+	}
+
+	next, err := models.Todos.Query(
+		models.SelectWhere.Todos.Title.EQ("weekly-review"),
+		models.SelectWhere.Todos.Completed.EQ(false),
+	).One(ctx, db)
+	if err != nil {
+		t.Fatalf("next occurrence: %v", err)
+	}
+	if got := next.DueAt.GetOrZero(); !got.Equal(due.AddDate(0, 0, 7)) {
+		t.Errorf("next due = %s, want %s", got.In(tokyo), due.AddDate(0, 0, 7))
+	}
+	if next.Recurrence.GetOrZero() != "FREQ=WEEKLY" || next.Priority != priorityHigh || next.Notes != "議事録を共有する" {
+		t.Errorf("next = recurrence %v, priority %d, notes %q", next.Recurrence, next.Priority, next.Notes)
+	}
+	// 次のTodoは完了したTodoの直後に並び、ほかのTodoと同じ位置にならない
+	if err := later.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if !(todo.Position < next.Position && next.Position < later.Position) {
+		t.Errorf("positions = completed %q, next %q, later %q; want them in this order", todo.Position, next.Position, later.Position)
+	}
+	body := rec.Body.String()
+	for _, id := range []int64{todo.ID, next.ID} {
+		if !strings.Contains(body, `id="todo-`+strconv.FormatInt(id, 10)+`"`) {
+			t.Errorf("response does not contain todo-%d", id)
+		}
+	}
+
+	// タグとサブタスク（未完了に戻す）を引き継ぐ
+	if err := next.LoadTags(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if len(next.R.Tags) != 1 || next.R.Tags[0].Name != "routine" {
+		t.Errorf("next tags = %v, want routine", next.R.Tags)
+	}
+	if n, _ := models.TodoEvents.Query(
+		models.SelectWhere.TodoEvents.TodoID.EQ(next.ID),
+		models.SelectWhere.TodoEvents.Kind.EQ(todoEventTagAdded),
+	).Count(ctx, db); n != 1 {
+		t.Errorf("tag events of the next occurrence = %d, want 1", n)
+	}
+	children, err := next.Children().All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 1 || children[0].Title != "checklist-item" || children[0].Completed {
+		t.Errorf("next subtasks = %v, want one open checklist-item", children)
+	}
+}
+
+func TestToggleOverdueRecurringTodoSkipsPast(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	tokyo := loadLocation("Asia/Tokyo")
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.Title("daily-standup"),
+		factory.TodoMods.Completed(false),
+		factory.TodoMods.DueAt(null.From(time.Date(2020, 1, 1, 0, 0, 0, 0, tokyo).UTC())),
+		factory.TodoMods.Recurrence(null.From("FREQ=DAILY")),
+	).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/toggle", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("toggle: status = %d", rec.Code)
+	}
+	next, err := models.Todos.Query(
+		models.SelectWhere.Todos.Title.EQ("daily-standup"),
+		models.SelectWhere.Todos.Completed.EQ(false),
+	).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := startOfDay(time.Now(), tokyo).AddDate(0, 0, 1); !next.DueAt.GetOrZero().Equal(want) {
+		t.Errorf("next due = %s, want tomorrow %s", next.DueAt.GetOrZero().In(tokyo), want)
+	}
+}
+
+func TestSaveRecurrenceForm(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	tokyo := loadLocation("Asia/Tokyo")
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.DueAt(null.FromPtr[time.Time](nil))).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/recurrence"
+
+	if rec := tc.do(http.MethodGet, path, nil); rec.Code != http.StatusOK {
+		t.Fatalf("GET recurrence: status = %d", rec.Code)
+	}
+
+	rec := tc.do(http.MethodPost, path, url.Values{"freq": {"weekly"}, "interval": {"1"}, "weekdays": {"XX"}})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid weekday: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	rec = tc.do(http.MethodPost, path, url.Values{
+		"freq":       {"monthly"},
+		"interval":   {"1"},
+		"monthly_by": {"weekday"},
+		"ordinal":    {"2"},
+		"weekday":    {"TU"},
+	})
+	if rec.Code != http.StatusFound {
+		t.Fatalf("save: status = %d", rec.Code)
+	}
+	if err := todo.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if got := todo.Recurrence.GetOrZero(); got != "FREQ=MONTHLY;BYDAY=2TU" {
+		t.Errorf("recurrence = %q, want FREQ=MONTHLY;BYDAY=2TU", got)
+	}
+	// 期限日がなければ今日以降の最初の第2火曜が期限日になる
+	dueAt, ok := todo.DueAt.Get()
+	if !ok {
+		t.Fatal("due date not set")
+	}
+	local := dueAt.In(tokyo)
+	if local.Weekday() != time.Tuesday || (local.Day()-1)/7 != 1 || local.Before(startOfDay(time.Now(), tokyo)) {
+		t.Errorf("due = %s, want the next 2nd Tuesday", local)
+	}
+
+	// 種類を空にすると繰り返しをやめる
+	if rec := tc.do(http.MethodPost, path, url.Values{"freq": {""}}); rec.Code != http.StatusFound {
+		t.Fatalf("clear: status = %d", rec.Code)
+	}
+	if err := todo.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if todo.Recurrence.IsValue() {
+		t.Errorf("recurrence = %v, want null", todo.Recurrence)
+	}
+}
+
+func TestLastSubtaskCompletesRecurringParent(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	parent := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.Title("monthly-close"),
+		factory.TodoMods.Completed(false),
+		factory.TodoMods.Recurrence(null.From("FREQ=MONTHLY")),
+	).CreateOrFail(ctx, t, db)
+	child := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ParentID(null.From(parent.ID)),
+		factory.TodoMods.Title("reconcile"),
+		factory.TodoMods.Completed(false),
+	).CreateOrFail(ctx, t, db)
+
+	// 最後のサブタスクを完了すると、親は繰り返しを外して完了になり、次の発生日のTodoができる
+	rec := login(t, e, alice).doWithHeader(http.MethodPost, "/todos/"+strconv.FormatInt(child.ID, 10)+"/toggle", url.Values{},
+		http.Header{"Hx-Target": {"todo-" + strconv.FormatInt(parent.ID, 10)}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("toggle subtask: status = %d", rec.Code)
+	}
+	if err := parent.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if !parent.Completed || parent.Recurrence.IsValue() {
+		t.Errorf("parent: completed = %v, recurrence = %v", parent.Completed, parent.Recurrence)
+	}
+	next, err := models.Todos.Query(
+		models.SelectWhere.Todos.Title.EQ("monthly-close"),
+		models.SelectWhere.Todos.Completed.EQ(false),
+	).One(ctx, db)
+	if err != nil {
+		t.Fatalf("next occurrence: %v", err)
+	}
+	if next.Recurrence.GetOrZero() != "FREQ=MONTHLY" {
+		t.Errorf("next recurrence = %v", next.Recurrence)
+	}
+	if !strings.Contains(rec.Body.String(), `id="todo-`+strconv.FormatInt(next.ID, 10)+`"`) {
+		t.Error("response does not contain the next occurrence")
+	}
+}
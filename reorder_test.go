@@ -0,0 +1,215 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/rank"
+)
+
+func TestReorderTodos(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	tc := login(t, e, alice)
+	for _, title := range []string{"reorder-a", "reorder-b", "reorder-c", "reorder-d"} {
+		if rec := tc.do(http.MethodPost, "/todos", url.Values{"title": {title}}); rec.Code != http.StatusOK {
+			t.Fatalf("create %s: status = %d", title, rec.Code)
+		}
+	}
+	ids := map[string]string{}
+	todos, err := models.Todos.Query(models.SelectWhere.Todos.UserID.EQ(alice.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, todo := range todos {
+		ids[todo.Title] = strconv.FormatInt(todo.ID, 10)
+	}
+
+	// 作成した順に末尾へ並ぶ
+	rec := tc.do(http.MethodGet, "/todos?sort=position&dir=asc", nil)
+	assertOrder(t, rec.Body.String(), "reorder-a", "reorder-b", "reorder-c", "reorder-d")
+	if !strings.Contains(rec.Body.String(), `data-reorder-url="/todos/reorder"`) {
+		t.Error("todo list is not reorderable")
+	}
+
+	reorder := func(id, beforeID, afterID string) {
+		t.Helper()
+		rec := tc.do(http.MethodPost, "/todos/reorder", url.Values{"id": {id}, "before_id": {beforeID}, "after_id": {afterID}})
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("reorder %s: status = %d", id, rec.Code)
+		}
+	}
+	order := func(want ...string) {
+		t.Helper()
+		rec := tc.do(http.MethodGet, "/todos", nil)
+		assertOrder(t, rec.Body.String(), want...)
+	}
+
+	// 先頭へ
+	reorder(ids["reorder-d"], "", ids["reorder-a"])
+	order("reorder-d", "reorder-a", "reorder-b", "reorder-c")
+	// 途中へ
+	reorder(ids["reorder-c"], ids["reorder-d"], ids["reorder-a"])
+	order("reorder-d", "reorder-c", "reorder-a", "reorder-b")
+	// 末尾へ
+	reorder(ids["reorder-d"], ids["reorder-b"], "")
+	order("reorder-c", "reorder-a", "reorder-b", "reorder-d")
+
+	// 画面が古く前後が隣り合っていなければ before_id の直後に置く
+	reorder(ids["reorder-b"], ids["reorder-c"], ids["reorder-d"])
+	order("reorder-c", "reorder-b", "reorder-a", "reorder-d")
+
+	// 位置を1件ずつしか書き換えない
+	todos, err = models.Todos.Query(models.SelectWhere.Todos.UserID.EQ(alice.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, todo := range todos {
+		if len(todo.Position) > 2 {
+			t.Errorf("%s position = %q, want short rank", todo.Title, todo.Position)
+		}
+	}
+}
+
+func TestReorderRebalancesDensePositions(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	var todos []*models.Todo
+	for _, tt := range []struct{ title, position string }{
+		{"dense-a", "a"},
+		{"dense-b", "a0"},
+		{"dense-c", "b"},
+	} {
+		todos = append(todos, f.NewTodoWithContext(ctx,
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.Title(tt.title),
+			factory.TodoMods.Position(tt.position),
+			factory.TodoMods.ParentID(null.FromPtr[int64](nil)),
+			factory.TodoMods.ListID(null.FromPtr[int64](nil)),
+		).CreateOrFail(ctx, t, db))
+	}
+	tc := login(t, e, alice)
+
+	// "a" と "a0" の間には位置がないので、全体を振り直す
+	rec := tc.do(http.MethodPost, "/todos/reorder", url.Values{
+		"id":        {strconv.FormatInt(todos[2].ID, 10)},
+		"before_id": {strconv.FormatInt(todos[0].ID, 10)},
+		"after_id":  {strconv.FormatInt(todos[1].ID, 10)},
+	})
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("reorder: status = %d", rec.Code)
+	}
+	rec = tc.do(http.MethodGet, "/todos?sort=position&dir=asc", nil)
+	assertOrder(t, rec.Body.String(), "dense-a", "dense-c", "dense-b")
+
+	want := rank.Spread(3)
+	for i, todo := range []*models.Todo{todos[0], todos[2], todos[1]} {
+		if err := todo.Reload(ctx, db); err != nil {
+			t.Fatal(err)
+		}
+		if todo.Position != want[i] {
+			t.Errorf("%s position = %q, want %q", todo.Title, todo.Position, want[i])
+		}
+	}
+}
+
+func TestReorderRejects(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	viewer := createTestUser(t, db, "viewer@example.com")
+	stranger := createTestUser(t, db, "stranger@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(viewer),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+
+	newTodo := func(mods ...factory.TodoMod) string {
+		mods = append([]factory.TodoMod{
+			factory.TodoMods.WithExistingUser(owner),
+			factory.TodoMods.ListID(null.From(list.ID)),
+			factory.TodoMods.ParentID(null.FromPtr[int64](nil)),
+		}, mods...)
+		return strconv.FormatInt(f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db).ID, 10)
+	}
+	first := newTodo(factory.TodoMods.Position("1"))
+	second := newTodo(factory.TodoMods.Position("2"))
+	inbox := newTodo(factory.TodoMods.ListID(null.FromPtr[int64](nil)))
+	firstID, _ := strconv.ParseInt(first, 10, 64)
+	subtask := newTodo(factory.TodoMods.ParentID(null.From(firstID)))
+
+	ownerClient := login(t, e, owner)
+	for _, tt := range []struct {
+		name   string
+		tc     *testClient
+		form   url.Values
+		status int
+	}{
+		{"viewer", login(t, e, viewer), url.Values{"id": {second}, "after_id": {first}}, http.StatusForbidden},
+		{"stranger", login(t, e, stranger), url.Values{"id": {second}, "after_id": {first}}, http.StatusNotFound},
+		{"no neighbour", ownerClient, url.Values{"id": {second}}, http.StatusBadRequest},
+		{"other scope", ownerClient, url.Values{"id": {second}, "after_id": {inbox}}, http.StatusBadRequest},
+		{"subtask", ownerClient, url.Values{"id": {subtask}, "after_id": {first}}, http.StatusBadRequest},
+	} {
+		if rec := tt.tc.do(http.MethodPost, "/todos/reorder", tt.form); rec.Code != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.status)
+		}
+	}
+}
+
+func TestConcurrentCreatesGetDistinctPositions(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	clients := make([]*testClient, 8)
+	for i := range clients {
+		clients[i] = login(t, e, alice)
+	}
+
+	// 同時に末尾へ追加しても、末尾の位置を読んでから書くまでに割り込まれず同じ位置にならない
+	codes := make([]int, len(clients))
+	var wg sync.WaitGroup
+	for i, tc := range clients {
+		wg.Go(func() {
+			codes[i] = tc.do(http.MethodPost, "/todos", url.Values{"title": {"concurrent-" + strconv.Itoa(i)}}).Code
+		})
+	}
+	wg.Wait()
+	for i, code := range codes {
+		if code != http.StatusOK {
+			t.Errorf("create %d: status = %d", i, code)
+		}
+	}
+
+	todos, err := models.Todos.Query(models.SelectWhere.Todos.UserID.EQ(alice.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions := map[string]bool{}
+	for _, todo := range todos {
+		if positions[todo.Position] {
+			t.Errorf("position %q is shared", todo.Position)
+		}
+		positions[todo.Position] = true
+	}
+	if len(todos) != len(clients) {
+		t.Errorf("todos = %d, want %d", len(todos), len(clients))
+	}
+}
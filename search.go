@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// searchLimit は検索結果の最大件数
+const searchLimit = 20
+
+// maxSearchQueryLength は検索語の最大文字数。これより長い分は切り捨てる
+const maxSearchQueryLength = 100
+
+// minTrigramLength は全文検索の索引（trigram）で検索できる語の最小文字数
+// これより短い語を含む検索は索引を使わず、LIKEで探す
+const minTrigramLength = 3
+
+// snippetRunes はメモの抜粋で一致した部分の前後に残す文字数
+const snippetRunes = 30
+
+// registerSearchRoutes は全文検索のルートを登録する
+func registerSearchRoutes(g *echo.Group, db bob.DB) {
+	// Todoの検索。タイトルとメモから探し、関連の高い順に一致部分を強調して返す
+	// 検索ボックスのHTMXリクエストには結果部分だけを返す
+	g.GET("/search", func(c echo.Context) error {
+		q := c.QueryParam("q")
+		if utf8.RuneCountInString(q) > maxSearchQueryLength {
+			q = string([]rune(q)[:maxSearchQueryLength])
+		}
+		results, err := searchTodos(c.Request().Context(), db, c.Get("user_id").(int64), q)
+		if err != nil {
+			return err
+		}
+		if c.Request().Header.Get("HX-Request") == "true" {
+			return render(c, http.StatusOK, views.SearchResults(q, results))
+		}
+		return render(c, http.StatusOK, views.SearchPage(q, results))
+	})
+}
+
+// searchTodos はユーザーが見られるTodoからqの語をすべて含むものを探す
+// どの語も3文字以上なら全文検索の索引で探して関連の高い順（タイトルの一致を重視）に、
+// 短い語を含むならLIKEで探して更新の新しい順に返す
+// trigramの索引の snippet() は語の途中で切れるので、一致部分の強調と抜粋はGoで作る
+func searchTodos(ctx context.Context, db bob.DB, userID int64, q string) ([]views.SearchResult, error) {
+	terms := strings.Fields(q)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	var todos models.TodoSlice
+	var err error
+	if useTrigramIndex(terms) {
+		todos, err = searchTodosFTS(ctx, db, userID, terms)
+	} else {
+		todos, err = searchTodosLike(ctx, db, userID, terms)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := make([]string, len(terms))
+	for i, term := range terms {
+		patterns[i] = regexp.QuoteMeta(term)
+	}
+	re := regexp.MustCompile("(?i)" + strings.Join(patterns, "|"))
+	results := make([]views.SearchResult, len(todos))
+	for i, todo := range todos {
+		results[i] = views.SearchResult{
+			Todo:    todo,
+			Title:   searchParts(todo.Title, re),
+			Snippet: searchParts(excerpt(todo.Notes, re), re),
+		}
+	}
+	return results, nil
+}
+
+func useTrigramIndex(terms []string) bool {
+	for _, term := range terms {
+		if utf8.RuneCountInString(term) < minTrigramLength {
+			return false
+		}
+	}
+	return true
+}
+
+// searchTodosFTS は全文検索の索引で探す。語はそれぞれフレーズとして引用し、FTS5の演算子として解釈させない
+func searchTodosFTS(ctx context.Context, db bob.DB, userID int64, terms []string) (models.TodoSlice, error) {
+	phrases := make([]string, len(terms))
+	for i, term := range terms {
+		phrases[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+	}
+	ids, err := bob.All(ctx, db, sqlite.Select(
+		sm.Columns(models.Todos.Columns.ID),
+		sm.From("todos_fts"),
+		sm.InnerJoin(models.Todos.Name()).OnEQ(models.Todos.Columns.ID, sqlite.Quote("todos_fts", "rowid")),
+		sm.Where(sqlite.Quote("todos_fts").OP("MATCH", sqlite.Arg(strings.Join(phrases, " ")))),
+		sm.Where(accessibleTodos(userID)),
+		models.SelectWhere.Todos.DeletedAt.IsNull(),
+		sm.OrderBy(sqlite.Raw("bm25(todos_fts, 10.0, 1.0)")),
+		sm.OrderBy(models.Todos.Columns.ID).Desc(),
+		sm.Limit(searchLimit),
+	), scan.SingleColumnMapper[int64])
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	todos, err := models.Todos.Query(models.SelectWhere.Todos.ID.In(ids...)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	// 索引で探した関連の高い順に並べ直す
+	order := make(map[int64]int, len(ids))
+	for i, id := range ids {
+		order[id] = i
+	}
+	slices.SortFunc(todos, func(a, b *models.Todo) int { return order[a.ID] - order[b.ID] })
+	return todos, nil
+}
+
+// searchTodosLike は索引を使わずに探す
+func searchTodosLike(ctx context.Context, db bob.DB, userID int64, terms []string) (models.TodoSlice, error) {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(accessibleTodos(userID)),
+		sm.OrderBy(models.Todos.Columns.UpdatedAt).Desc(),
+		sm.OrderBy(models.Todos.Columns.ID).Desc(),
+		sm.Limit(searchLimit),
+	}
+	for _, term := range terms {
+		pattern := "%" + likeEscaper.Replace(term) + "%"
+		mods = append(mods, sm.Where(sqlite.Or(
+			sqlite.Raw(`"todos"."title" LIKE ? ESCAPE '\'`, pattern),
+			sqlite.Raw(`"todos"."notes" LIKE ? ESCAPE '\'`, pattern),
+		)))
+	}
+	return models.Todos.Query(mods...).All(ctx, db)
+}
+
+// likeEscaper はLIKEのワイルドカードを文字として扱うようエスケープする
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// excerpt はsのうち最初にreに一致した部分の前後を切り出す。一致しなければ空にする
+func excerpt(s string, re *regexp.Regexp) string {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return ""
+	}
+	before := []rune(s[:loc[0]])
+	after := []rune(s[loc[1]:])
+	prefix, suffix := "", ""
+	if len(before) > snippetRunes {
+		before, prefix = before[len(before)-snippetRunes:], "…"
+	}
+	if len(after) > snippetRunes {
+		after, suffix = after[:snippetRunes], "…"
+	}
+	return prefix + string(before) + s[loc[0]:loc[1]] + string(after) + suffix
+}
+
+// searchParts はsをreに一致した部分とそれ以外に分ける
+// HTMLはテンプレートでエスケープするので、ここでは文字列のまま扱う
+func searchParts(s string, re *regexp.Regexp) []views.SearchPart {
+	var parts []views.SearchPart
+	last := 0
+	for _, loc := range re.FindAllStringIndex(s, -1) {
+		if loc[0] > last {
+			parts = append(parts, views.SearchPart{Text: s[last:loc[0]]})
+		}
+		parts = append(parts, views.SearchPart{Text: s[loc[0]:loc[1]], Match: true})
+		last = loc[1]
+	}
+	if last < len(s) {
+		parts = append(parts, views.SearchPart{Text: s[last:]})
+	}
+	return parts
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestSearchTodos(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	bob := createTestUser(t, db, "bob@example.com")
+	shared := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(bob)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(shared),
+		factory.ListMemberMods.WithExistingUser(alice),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+
+	newTodo := func(owner *models.User, title, notes string, mods ...factory.TodoMod) *models.Todo {
+		mods = append([]factory.TodoMod{
+			factory.TodoMods.WithExistingUser(owner),
+			factory.TodoMods.Title(title),
+			factory.TodoMods.Notes(notes),
+		}, mods...)
+		return f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db)
+	}
+	newTodo(alice, "Budget review", "numbers for Q3")
+	newTodo(alice, "Call plumber", "ask about the budget estimate")
+	newTodo(bob, "Budget secret", "")
+	newTodo(bob, "Shared budget plan", "", factory.TodoMods.ListID(null.From(shared.ID)))
+	newTodo(alice, "Deleted budget", "", factory.TodoMods.DeletedAt(null.From(time.Now())))
+	renamed := newTodo(alice, "Old title", "")
+	newTodo(alice, "週次の会議資料を作る", "議事録も用意する")
+	tc := login(t, e, alice)
+
+	// 索引はタイトルの更新にも追従する
+	if err := renamed.Update(ctx, db, &models.TodoSetter{Title: omit.From("Quarterly budget")}); err != nil {
+		t.Fatal(err)
+	}
+
+	search := func(q string) string {
+		t.Helper()
+		rec := tc.doWithHeader(http.MethodGet, "/todos/search?q="+url.QueryEscape(q), nil, http.Header{"Hx-Request": {"true"}})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("search %q: status = %d", q, rec.Code)
+		}
+		return rec.Body.String()
+	}
+
+	body := search("budget")
+	for _, want := range []string{"review", "plumber", "Shared", "Quarterly"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("search budget: %q not found", want)
+		}
+	}
+	for _, unwanted := range []string{"secret", "Deleted", "<html"} {
+		if strings.Contains(body, unwanted) {
+			t.Errorf("search budget: %q found", unwanted)
+		}
+	}
+	// タイトルに一致したTodoをメモだけに一致したTodoより先に並べ、一致部分を強調する
+	assertOrder(t, body, "review", "plumber")
+	if !strings.Contains(body, "<mark>Budget</mark> review") || !strings.Contains(body, "the <mark>budget</mark> estimate") {
+		t.Errorf("search budget: no highlighted snippet: %s", body)
+	}
+
+	// 3文字未満の語（日本語の2文字の単語など）も探せる
+	body = search("会議")
+	if !strings.Contains(body, "週次の<mark>会議</mark>資料") {
+		t.Errorf("search 会議: %s", body)
+	}
+	if body := search("議事録"); !strings.Contains(body, "<mark>議事録</mark>も用意する") {
+		t.Errorf("search 議事録: %s", body)
+	}
+
+	// すべての語を含むものだけを返し、FTS5の構文として解釈しない
+	if body := search("budget plumber"); !strings.Contains(body, "plumber") || strings.Contains(body, "review") {
+		t.Errorf("search budget plumber: %s", body)
+	}
+	for _, q := range []string{`"budget`, "budget*", "NOT budget", "a_%"} {
+		search(q)
+	}
+
+	// JavaScriptなしではページ全体を返す
+	rec := tc.do(http.MethodGet, "/todos/search?q=budget", nil)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "<html") {
+		t.Errorf("search page: status = %d", rec.Code)
+	}
+}
+
+func TestSearchEscapesTitles(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	factory.New().NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.Title("<script>alert(1)</script> payload"),
+	).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	body := tc.do(http.MethodGet, "/todos/search?q=payload", nil).Body.String()
+	if strings.Contains(body, "<script>alert") {
+		t.Errorf("title is not escaped: %s", body)
+	}
+	if !strings.Contains(body, "&lt;script&gt;") {
+		t.Errorf("title not found: %s", body)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/a-h/templ"
+	"github.com/alexedwards/scs/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stephenafamo/bob"
+
+	z "github.com/Oudwins/zog"
+	"github.com/kimihito-sandbox/gostack-test/storage"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// newServer はミドルウェアとルーティングを設定したEchoインスタンスを返す
+// store は添付ファイルの保存先
+func newServer(db bob.DB, sessionManager *scs.SessionManager, store storage.Storage) *echo.Echo {
+	e := echo.New()
+	// トランザクションの中で返した echo.HTTPError は bob がラップするので、取り出してから既定の処理に渡す
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			err = he
+		}
+		e.DefaultHTTPErrorHandler(err, c)
+	}
+
+	// ミドルウェア
+	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		LogStatus:   true, // HTTPステータスコードを記録
+		LogURI:      true, // リクエストURIを記録
+		LogError:    true, // エラー情報を記録
+		HandleError: true, // エラー時もログを出力
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			if v.Error == nil {
+				e.Logger.Infof("REQUEST: uri=%v, status=%v", v.URI, v.Status)
+			} else {
+				e.Logger.Errorf("REQUEST ERROR: uri=%v, status=%v, err=%v", v.URI, v.Status, v.Error)
+			}
+			return nil
+		},
+	}))
+	e.Use(middleware.Recover())
+
+	// リクエストの大きさの上限（添付ファイルとマルチパートの区切りが収まる大きさ）
+	// CSRFミドルウェアがフォームを読む前に制限する
+	e.Use(middleware.BodyLimit("11M"))
+
+	// scsセッションミドルウェア
+	e.Use(echo.WrapMiddleware(sessionManager.LoadAndSave))
+
+	// CSRFミドルウェア
+	e.Use(middleware.CSRFWithConfig(middleware.CSRFConfig{
+		TokenLookup:    "form:csrf_token",       // フォームからトークンを取得
+		CookieName:     "_csrf",                 // Cookieの名前
+		CookiePath:     "/",                     // Cookie適用パス（全体）
+		CookieSecure:   false,                   // 開発環境ではfalse、本番ではtrue
+		CookieHTTPOnly: true,                    // JavaScriptからアクセス不可
+		CookieSameSite: http.SameSiteStrictMode, // CSRF対策を強化
+	}))
+
+	// ルーティング
+	e.GET("/", func(c echo.Context) error {
+		return c.Redirect(http.StatusFound, "/todos")
+	})
+
+	registerAuthRoutes(e, db, sessionManager)
+
+	// 認証が必要なルートグループ
+	todos := e.Group("/todos", requireAuth(sessionManager), loadSidebar(db))
+	registerTodoRoutes(todos, db, store)
+
+	lists := e.Group("/lists", requireAuth(sessionManager), loadSidebar(db))
+	registerListRoutes(lists, db, store)
+
+	tags := e.Group("/tags", requireAuth(sessionManager), loadSidebar(db))
+	registerTagRoutes(tags, db)
+
+	templates := e.Group("/templates", requireAuth(sessionManager), loadSidebar(db))
+	registerTemplateRoutes(templates, db)
+
+	calendar := e.Group("/calendar", requireAuth(sessionManager), loadSidebar(db))
+	registerCalendarRoutes(calendar, db)
+
+	timeReport := e.Group("/time", requireAuth(sessionManager), loadSidebar(db))
+	registerTimeReportRoutes(timeReport, db)
+
+	stats := e.Group("/stats", requireAuth(sessionManager), loadSidebar(db))
+	registerStatsRoutes(stats, db)
+
+	trash := e.Group("/trash", requireAuth(sessionManager), loadSidebar(db))
+	registerTrashRoutes(trash, db, store)
+
+	notifications := e.Group("/notifications", requireAuth(sessionManager), loadSidebar(db))
+	registerNotificationRoutes(notifications, db)
+
+	settings := e.Group("/settings", requireAuth(sessionManager), loadSidebar(db))
+	registerSettingsRoutes(settings, db, sessionManager)
+
+	return e
+}
+
+// render はTemplコンポーネントをEchoのレスポンスとして返すヘルパー関数
+func render(c echo.Context, statusCode int, t templ.Component) error {
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextHTMLCharsetUTF8)
+	c.Response().WriteHeader(statusCode)
+	return t.Render(c.Request().Context(), c.Response())
+}
+
+// requireAuth は認証を必要とするミドルウェア
+// ログイン中のユーザーIDはContextの "user_id" に、タイムゾーンは "location" に格納される
+func requireAuth(sessionManager *scs.SessionManager) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			userID := sessionManager.GetInt64(ctx, "user_id")
+			if userID == 0 {
+				return c.Redirect(http.StatusFound, "/auth/login")
+			}
+			loc := loadLocation(sessionManager.GetString(ctx, "timezone"))
+			c.Set("user_id", userID)
+			c.Set("location", loc)
+			ctx = views.LocationToContext(ctx, loc)
+			ctx = views.UserIDToContext(ctx, userID)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// paramID はパスパラメータ :id を数値として取り出す。不正な値は404とする
+func paramID(c echo.Context) (int64, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusNotFound)
+	}
+	return id, nil
+}
+
+// notFoundIfNoRows はレコードが見つからないエラーを404に変換する
+// 他ユーザーのデータの存在を漏らさないよう、所有者不一致も同じ扱いになる
+func notFoundIfNoRows(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	return err
+}
+
+// issuesToMap はzogのZogIssueListをフィールドごとのエラーマップに変換する
+func issuesToMap(issues z.ZogIssueList) map[string][]string {
+	errs := make(map[string][]string)
+	for _, issue := range issues {
+		if len(issue.Path) > 0 {
+			key := issue.Path[0]
+			errs[key] = append(errs[key], issue.Message)
+		}
+	}
+	return errs
+}
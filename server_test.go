@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/sqlite3store"
+	"github.com/alexedwards/scs/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/pressly/goose/v3"
+	"github.com/stephenafamo/bob"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/storage"
+)
+
+// testCSRFToken はテストで使うCSRFトークン（Cookieとフォームに同じ値を送る）
+const testCSRFToken = "test-csrf-token"
+
+// testPassword はテスト用ユーザーの平文パスワード
+const testPassword = "password1234"
+
+// newTestServer はマイグレーション済みの一時DBを使うテスト用サーバーを返す
+func newTestServer(t *testing.T) (*echo.Echo, bob.DB) {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "test.db")+dbOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	goose.SetLogger(goose.NopLogger())
+	if err := goose.SetDialect("sqlite3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goose.Up(sqlDB, "db/migrations"); err != nil {
+		t.Fatal(err)
+	}
+
+	sessionManager := scs.New()
+	sessionManager.Store = sqlite3store.NewWithCleanupInterval(sqlDB, 0)
+
+	store, err := storage.NewLocal(filepath.Join(t.TempDir(), "attachments"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := bob.NewDB(sqlDB)
+	e := newServer(db, sessionManager, store)
+	e.Logger.SetOutput(io.Discard)
+	return e, db
+}
+
+// setLocalTimezone はテストの間だけサーバーのタイムゾーン（time.Local）を UTC+hours にする
+// UTC以外のサーバーでも日時の保存と比較が食い違わないことを確かめるのに使う
+// 名前のないタイムゾーンはDBから読み戻せない形式で書かれるので、略称を付けておく
+func setLocalTimezone(t *testing.T, hours int) {
+	t.Helper()
+	local := time.Local
+	time.Local = time.FixedZone("TST", hours*60*60)
+	t.Cleanup(func() { time.Local = local })
+}
+
+// createTestUser はログイン可能なユーザーをfactoryで作成する
+func createTestUser(t *testing.T, db bob.DB, email string, mods ...factory.UserMod) *models.User {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mods = append(mods, factory.UserMods.Email(email), factory.UserMods.Password(string(hash)))
+	return factory.New().NewUserWithContext(t.Context(), mods...).CreateOrFail(t.Context(), t, db)
+}
+
+// testClient はCookieを引き継いでリクエストを送るテスト用クライアント
+type testClient struct {
+	t       *testing.T
+	e       *echo.Echo
+	cookies map[string]*http.Cookie
+}
+
+func newTestClient(t *testing.T, e *echo.Echo) *testClient {
+	return &testClient{t: t, e: e, cookies: map[string]*http.Cookie{}}
+}
+
+// do はリクエストを送信する。formがnilでなければCSRFトークン付きのフォームとして送る
+func (tc *testClient) do(method, target string, form url.Values) *httptest.ResponseRecorder {
+	tc.t.Helper()
+	return tc.doWithHeader(method, target, form, nil)
+}
+
+// doWithHeader はHTMXのヘッダーなどを付けてリクエストを送信する
+func (tc *testClient) doWithHeader(method, target string, form url.Values, header http.Header) *httptest.ResponseRecorder {
+	tc.t.Helper()
+
+	var req *http.Request
+	if form != nil {
+		form.Set("csrf_token", testCSRFToken)
+		req = httptest.NewRequestWithContext(context.Background(), method, target, strings.NewReader(form.Encode()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	} else {
+		req = httptest.NewRequestWithContext(context.Background(), method, target, nil)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	return tc.send(req)
+}
+
+// send はセッションとCSRFのCookieを付けてリクエストを送り、受け取ったCookieを保持する
+func (tc *testClient) send(req *http.Request) *httptest.ResponseRecorder {
+	tc.t.Helper()
+
+	req.AddCookie(&http.Cookie{Name: "_csrf", Value: testCSRFToken})
+	for _, cookie := range tc.cookies {
+		req.AddCookie(cookie)
+	}
+
+	rec := httptest.NewRecorder()
+	tc.e.ServeHTTP(rec, req)
+
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name != "_csrf" {
+			tc.cookies[cookie.Name] = cookie
+		}
+	}
+	return rec
+}
+
+// login は指定ユーザーでログインしたクライアントを返す
+func login(t *testing.T, e *echo.Echo, user *models.User) *testClient {
+	t.Helper()
+
+	tc := newTestClient(t, e)
+	rec := tc.do(http.MethodPost, "/auth/login", url.Values{
+		"email":    {user.Email},
+		"password": {testPassword},
+	})
+	if rec.Code != http.StatusFound {
+		t.Fatalf("login as %s: status = %d, body = %s", user.Email, rec.Code, rec.Body.String())
+	}
+	return tc
+}
+
+// assertOrder はbodyにwantの文字列がこの順で現れることを確認する
+func assertOrder(t *testing.T, body string, want ...string) {
+	t.Helper()
+
+	pos := -1
+	for _, s := range want {
+		i := strings.Index(body, s)
+		if i < 0 {
+			t.Errorf("%q not found in body", s)
+			return
+		}
+		if i < pos {
+			t.Errorf("%q appears before the previous item; want order %v", s, want)
+			return
+		}
+		pos = i
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/alexedwards/scs/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// registerSettingsRoutes はユーザー設定のルートを登録する
+func registerSettingsRoutes(g *echo.Group, db bob.DB, sessionManager *scs.SessionManager) {
+	// 設定ページ
+	g.GET("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user, err := models.FindUser(ctx, db, c.Get("user_id").(int64))
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.SettingsPage(user, csrfToken, nil))
+	})
+
+	// 設定の保存
+	g.POST("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user, err := models.FindUser(ctx, db, c.Get("user_id").(int64))
+		if err != nil {
+			return err
+		}
+
+		timezone := c.FormValue("timezone")
+		if _, err := userLocation(timezone); err != nil {
+			csrfToken := c.Get("csrf").(string)
+			return render(c, http.StatusBadRequest, views.SettingsPage(user, csrfToken, map[string][]string{"timezone": {"タイムゾーンが正しくありません"}}))
+		}
+
+		err = user.Update(ctx, db, &models.UserSetter{
+			Timezone:  omit.From(timezone),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		})
+		if err != nil {
+			return err
+		}
+		sessionManager.Put(ctx, "timezone", user.Timezone)
+
+		return c.Redirect(http.StatusFound, "/settings")
+	})
+}
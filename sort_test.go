@@ -0,0 +1,79 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestTodoSortIsRememberedPerUser(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	for _, tt := range []struct {
+		title    string
+		priority int64
+	}{
+		{"prio-low", priorityLow},
+		{"prio-urgent", priorityUrgent},
+		{"prio-medium", priorityMedium},
+	} {
+		f.NewTodoWithContext(ctx,
+			factory.TodoMods.WithExistingUser(alice),
+			factory.TodoMods.Title(tt.title),
+			factory.TodoMods.Priority(tt.priority),
+		).CreateOrFail(ctx, t, db)
+	}
+	tc := login(t, e, alice)
+
+	rec := tc.do(http.MethodGet, "/todos?sort=priority&dir=desc", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /todos?sort=priority&dir=desc: status = %d", rec.Code)
+	}
+	assertOrder(t, rec.Body.String(), "prio-urgent", "prio-medium", "prio-low")
+
+	// パラメータなしで開き直しても同じ並び順になる
+	rec = tc.do(http.MethodGet, "/todos", nil)
+	assertOrder(t, rec.Body.String(), "prio-urgent", "prio-medium", "prio-low")
+
+	if err := alice.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if alice.TodoSort != "priority" || alice.TodoSortDir != "desc" {
+		t.Errorf("saved sort = %s %s, want priority desc", alice.TodoSort, alice.TodoSortDir)
+	}
+
+	// 不正な値は無視して保存済みの並び順を使う
+	rec = tc.do(http.MethodGet, "/todos?sort=password&dir=asc", nil)
+	assertOrder(t, rec.Body.String(), "prio-urgent", "prio-medium", "prio-low")
+}
+
+func TestUpdateTodoPriority(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Priority(priorityNone)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10) + "/priority"
+
+	if rec := tc.do(http.MethodPost, path, url.Values{"priority": {"5"}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("priority 5: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec := tc.do(http.MethodPost, path, url.Values{"priority": {strconv.FormatInt(priorityHigh, 10)}}); rec.Code != http.StatusOK {
+		t.Fatalf("priority high: status = %d", rec.Code)
+	}
+	got, err := models.FindTodo(ctx, db, todo.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Priority != priorityHigh {
+		t.Errorf("priority = %d, want %d", got.Priority, priorityHigh)
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// defaultStatsDays は統計の既定の期間（今日までの日数）
+const defaultStatsDays = 30
+
+// maxStatsDays は統計で一度に集計できる最大の日数
+const maxStatsDays = 366
+
+// statsTodos はログイン中のユーザーが見られるTodo（受信箱と参加しているリストのTodo。サブタスクとゴミ箱にあるものを除く）の作成日時と完了日時
+// 未完了なら completed_at は NULL
+// WITH 句の1つとして使い、引数には accessibleTodos(userID) を渡す
+const statsTodos = `stats_todos(id, list_id, created_at, completed_at) AS (
+	SELECT id, list_id, created_at, CASE WHEN completed THEN completed_at END
+	FROM todos
+	WHERE ? AND parent_id IS NULL AND deleted_at IS NULL
+)`
+
+// registerStatsRoutes は統計のルートを登録する
+func registerStatsRoutes(g *echo.Group, db bob.DB) {
+	// 期間内に作った・完了したTodoの日ごとの件数、完了までの時間の中央値、連続して完了した日数、リスト・タグごとの件数
+	// 件数はSQLで集計し、日の境界や期間はユーザーのタイムゾーンで決める
+	g.GET("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		loc := c.Get("location").(*time.Location)
+		period, fromTime, endTime, err := parsePeriod(c, defaultStatsDays, maxStatsDays)
+		if err != nil {
+			return err
+		}
+		from, end := dbTime(fromTime), dbTime(endTime)
+
+		stats := views.Stats{Period: period}
+		if stats.Days, err = statsDays(ctx, db, userID, period, loc); err != nil {
+			return err
+		}
+		for _, day := range stats.Days {
+			stats.Created += day.Created
+			stats.Completed += day.Completed
+		}
+		stats.CurrentStreak, stats.LongestStreak = completionStreaks(stats.Days, time.Now().In(loc).Format(time.DateOnly))
+
+		completed, err := models.Todos.Query(
+			sm.Where(accessibleTodos(userID)),
+			models.SelectWhere.Todos.ParentID.IsNull(),
+			models.SelectWhere.Todos.Completed.EQ(true),
+			sm.Where(models.Todos.Columns.CompletedAt.GTE(sqlite.Arg(from))),
+			sm.Where(models.Todos.Columns.CompletedAt.LT(sqlite.Arg(end))),
+		).All(ctx, db)
+		if err != nil {
+			return err
+		}
+		stats.MedianCompletion = medianCompletion(completed)
+
+		stats.Lists, err = bob.All(ctx, db, sqlite.RawQuery(`
+			WITH `+statsTodos+`
+			SELECT COALESCE(l.name, '受信箱') AS label,
+				COUNT(CASE WHEN t.created_at >= ? AND t.created_at < ? THEN 1 END) AS created,
+				COUNT(CASE WHEN t.completed_at >= ? AND t.completed_at < ? THEN 1 END) AS completed
+			FROM stats_todos t LEFT JOIN lists l ON l.id = t.list_id
+			GROUP BY t.list_id
+			HAVING created > 0 OR completed > 0
+			ORDER BY completed DESC, created DESC, label`,
+			accessibleTodos(userID), from, end, from, end), scan.StructMapper[views.StatsBreakdown]())
+		if err != nil {
+			return err
+		}
+
+		// タグが複数あるTodoはそれぞれのタグに数える
+		stats.Tags, err = bob.All(ctx, db, sqlite.RawQuery(`
+			WITH `+statsTodos+`
+			SELECT COALESCE(g.name, 'タグなし') AS label,
+				COUNT(CASE WHEN t.created_at >= ? AND t.created_at < ? THEN 1 END) AS created,
+				COUNT(CASE WHEN t.completed_at >= ? AND t.completed_at < ? THEN 1 END) AS completed
+			FROM stats_todos t
+			LEFT JOIN todo_tags tt ON tt.todo_id = t.id
+			LEFT JOIN tags g ON g.id = tt.tag_id
+			GROUP BY g.id
+			HAVING created > 0 OR completed > 0
+			ORDER BY completed DESC, created DESC, label`,
+			accessibleTodos(userID), from, end, from, end), scan.StructMapper[views.StatsBreakdown]())
+		if err != nil {
+			return err
+		}
+
+		return render(c, http.StatusOK, views.StatsPage(stats))
+	})
+}
+
+// statsDays は期間の日ごとに、作ったTodoと完了したTodoの件数を数える
+// 日の境界はユーザーのタイムゾーンで決め、各日の始まりと終わりを VALUES でSQLに渡す
+func statsDays(ctx context.Context, exec bob.Executor, userID int64, period views.TimePeriod, loc *time.Location) ([]views.StatsDay, error) {
+	var values []string
+	var args []any
+	for day := civilDate(period.From); !day.After(civilDate(period.To)); day = day.AddDate(0, 0, 1) {
+		date := day.Format(time.DateOnly)
+		start, err := parseDueDate(date, loc)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseDueDate(day.AddDate(0, 0, 1).Format(time.DateOnly), loc)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, "(?, ?, ?)")
+		args = append(args, date, dbTime(start), dbTime(end))
+	}
+	args = append(args, accessibleTodos(userID))
+	return bob.All(ctx, exec, sqlite.RawQuery(`
+		WITH days(date, day_start, day_end) AS (VALUES `+strings.Join(values, ", ")+`), `+statsTodos+`
+		SELECT d.date,
+			(SELECT COUNT(*) FROM stats_todos WHERE created_at >= d.day_start AND created_at < d.day_end) AS created,
+			(SELECT COUNT(*) FROM stats_todos WHERE completed_at >= d.day_start AND completed_at < d.day_end) AS completed
+		FROM days d
+		ORDER BY d.date`, args...), scan.StructMapper[views.StatsDay]())
+}
+
+// medianCompletion は完了したTodoの、作ってから完了するまでの時間の中央値。todosが空なら NULL
+func medianCompletion(todos models.TodoSlice) null.Val[time.Duration] {
+	if len(todos) == 0 {
+		return null.Val[time.Duration]{}
+	}
+	durations := make([]time.Duration, len(todos))
+	for i, todo := range todos {
+		durations[i] = todo.CompletedAt.GetOrZero().Sub(todo.CreatedAt)
+	}
+	slices.Sort(durations)
+	n := len(durations)
+	if n%2 == 1 {
+		return null.From(durations[n/2])
+	}
+	return null.From((durations[n/2-1] + durations[n/2]) / 2)
+}
+
+// dbTime は日時をDBに書いた日時と文字列のまま比べられる形式（UTCの "2006-01-02 15:04:05"）にする
+// DBの既定値（CURRENT_TIMESTAMP）もアプリが書くUTCの日時もこの形式で始まるので、そのまま大小を比べられる
+func dbTime(t time.Time) string {
+	return t.UTC().Format(time.DateTime)
+}
+
+// completionStreaks は1件以上完了した日が続いた日数を返す。days は日付の古い順に渡すこと
+// current は期間の最終日まで続いている日数。最終日が今日でまだ完了していなければ、前日まで続いていれば数える
+func completionStreaks(days []views.StatsDay, today string) (current, longest int) {
+	run := 0
+	for _, day := range days {
+		if day.Completed > 0 {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	current = run
+	if n := len(days); current == 0 && n > 1 && days[n-1].Date == today {
+		for i := n - 2; i >= 0 && days[i].Completed > 0; i-- {
+			current++
+		}
+	}
+	return current, longest
+}
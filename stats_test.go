@@ -0,0 +1,206 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/pressly/goose/v3"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+func TestStatsPage(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+	now := time.Now().UTC().Truncate(time.Second) // 完了日時は秒単位で記録される
+
+	alice := createTestUser(t, db, "alice@example.com")
+	work := f.NewListWithContext(ctx, factory.ListMods.Name("Work"), factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tag := f.NewTagWithContext(ctx, factory.TagMods.WithExistingUser(alice), factory.TagMods.Name("billable")).CreateOrFail(ctx, t, db)
+	newTodo := func(user *models.User, createdAt time.Time, mods ...factory.TodoMod) *models.Todo {
+		mods = append(mods,
+			factory.TodoMods.WithExistingUser(user),
+			factory.TodoMods.Completed(false),
+			factory.TodoMods.CreatedAt(createdAt),
+		)
+		return f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db)
+	}
+	report := newTodo(alice, now.Add(-48*time.Hour), factory.TodoMods.ListID(null.From(work.ID)))
+	newTodo(alice, now.Add(-time.Hour))
+	newTodo(alice, now.AddDate(0, 0, -60))
+	// ほかのユーザーの受信箱のTodoは数えず、参加しているリストのTodoはほかのユーザーが作ったものも数える
+	bob := createTestUser(t, db, "bob@example.com")
+	newTodo(bob, now)
+	shared := f.NewListWithContext(ctx, factory.ListMods.Name("Shared"), factory.ListMods.WithExistingUser(bob)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(shared),
+		factory.ListMemberMods.WithExistingUser(alice),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+	newTodo(bob, now.Add(-2*time.Hour), factory.TodoMods.ListID(null.From(shared.ID)))
+	if _, err := models.TodoTags.Insert(&models.TodoTagSetter{TodoID: omit.From(report.ID), TagID: omit.From(tag.ID)}).Exec(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+
+	tc := login(t, e, alice)
+	if code := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(report.ID, 10)+"/toggle", url.Values{}).Code; code != http.StatusOK {
+		t.Fatalf("toggle: status = %d", code)
+	}
+
+	// 既定の30日間には60日前のTodoとほかのユーザーの受信箱のTodoを含めない。完了までは2日かかった
+	rec := tc.do(http.MethodGet, "/stats", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /stats: status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{
+		`<strong style="font-size: 1.5rem;">3</strong> 件`,
+		`<strong style="font-size: 1.5rem;">1</strong> 件`,
+		"2日0時間",
+		">Work<", ">Shared<", ">受信箱<", ">billable<", ">タグなし<",
+		"<polyline",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("stats page does not contain %q", want)
+		}
+	}
+
+	// 期間を変えると60日前のTodoも数える
+	from := now.AddDate(0, 0, -90).Format(time.DateOnly)
+	body = tc.do(http.MethodGet, "/stats?from="+from, nil).Body.String()
+	if !strings.Contains(body, `<strong style="font-size: 1.5rem;">4</strong> 件`) {
+		t.Error("stats for 90 days do not count the old todo")
+	}
+
+	if code := tc.do(http.MethodGet, "/stats?from=2026-02-01&to=2026-01-01", nil).Code; code != http.StatusBadRequest {
+		t.Errorf("reversed period: status = %d, want %d", code, http.StatusBadRequest)
+	}
+}
+
+func TestStatsCompletedAtOutsideUTC(t *testing.T) {
+	// 時差が大きいほど、ローカル時刻のまま保存した日時が集計の日の境界からずれやすい
+	setLocalTimezone(t, 23)
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	now := time.Now().UTC().Truncate(time.Second) // 完了日時は秒単位で記録される
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.Completed(false),
+		factory.TodoMods.CreatedAt(now.Add(-48*time.Hour)),
+	).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	if code := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/toggle", url.Values{}).Code; code != http.StatusOK {
+		t.Fatalf("toggle: status = %d", code)
+	}
+	// 完了してから3日後に編集しても、完了日と完了までの時間は変わらない
+	if err := todo.Update(ctx, db, &models.TodoSetter{Title: omit.From("edited"), UpdatedAt: omit.From(now.AddDate(0, 0, 3))}); err != nil {
+		t.Fatal(err)
+	}
+
+	today := now.In(loadLocation(defaultTimezone))
+	date := func(days int) string { return today.AddDate(0, 0, days).Format(time.DateOnly) }
+	body := tc.do(http.MethodGet, "/stats?from="+date(-3)+"&to="+date(3), nil).Body.String()
+	for _, want := range []string{date(0) + " 完了 1 件", date(3) + " 完了 0 件", "2日0時間"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("stats page does not contain %q", want)
+		}
+	}
+}
+
+func TestCompletedAtBackfill(t *testing.T) {
+	sqlDB, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "test.db")+dbOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	goose.SetLogger(goose.NopLogger())
+	if err := goose.SetDialect("sqlite3"); err != nil {
+		t.Fatal(err)
+	}
+	// completed_at を足す直前まで進め、いろいろな形式の日時を持つ完了済みのTodoを用意する
+	if err := goose.UpTo(sqlDB, "db/migrations", 20261017050000); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`INSERT INTO users (id, email, password) VALUES (1, 'alice@example.com', 'x')`); err != nil {
+		t.Fatal(err)
+	}
+	todos := []struct {
+		updatedAt   string
+		completedAt string // 完了の履歴の日時。空なら履歴なし
+		want        string
+	}{
+		{"2026-10-16 09:00:00", "", "2026-10-16 09:00:00"},                     // DBの既定値
+		{"2026-10-16 09:00:00.123456789 +0900 JST", "", "2026-10-16 00:00:00"}, // UTCより進んだサーバー
+		{"2026-10-15 22:30:00 -0530 X", "", "2026-10-16 04:00:00"},             // UTCより遅れたサーバー、日付もまたぐ
+		{"2026-10-16 09:00:00.5 +0000 UTC", "", "2026-10-16 09:00:00"},         // UTCのサーバー
+		// 完了の履歴があれば更新日時より優先する
+		{"2026-10-20 09:00:00", "2026-10-17 08:00:00.25 +0900 JST", "2026-10-16 23:00:00"},
+	}
+	for i, todo := range todos {
+		if _, err := sqlDB.Exec(`INSERT INTO todos (id, user_id, title, completed, updated_at) VALUES (?, 1, 'done', TRUE, ?)`, i+1, todo.updatedAt); err != nil {
+			t.Fatal(err)
+		}
+		if todo.completedAt == "" {
+			continue
+		}
+		if _, err := sqlDB.Exec(`INSERT INTO todo_events (todo_id, kind, old_value, new_value, created_at) VALUES (?, 'completed', 'false', 'true', ?)`, i+1, todo.completedAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := goose.Up(sqlDB, "db/migrations"); err != nil {
+		t.Fatal(err)
+	}
+	for i, todo := range todos {
+		var got string
+		if err := sqlDB.QueryRow(`SELECT completed_at FROM todos WHERE id = ?`, i+1).Scan(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.TrimSuffix(strings.Replace(got, "T", " ", 1), "Z"); got != todo.want {
+			t.Errorf("todo %d: completed_at = %q, want %q", i+1, got, todo.want)
+		}
+	}
+}
+
+func TestCompletionStreaks(t *testing.T) {
+	days := func(today string, completed ...int64) []views.StatsDay {
+		start := civilDate(today).AddDate(0, 0, -(len(completed) - 1))
+		result := make([]views.StatsDay, len(completed))
+		for i, n := range completed {
+			result[i] = views.StatsDay{Date: start.AddDate(0, 0, i).Format(time.DateOnly), Completed: n}
+		}
+		return result
+	}
+	tests := []struct {
+		name             string
+		days             []views.StatsDay
+		current, longest int
+	}{
+		{"none", days("2026-10-16", 0, 0, 0), 0, 0},
+		{"ends on the last day", days("2026-10-16", 1, 0, 2, 1, 3), 3, 3},
+		{"longest in the middle", days("2026-10-16", 1, 1, 1, 0, 1), 1, 3},
+		// 今日まだ完了していなくても、昨日まで続いていれば途切れていない
+		{"nothing yet today", days("2026-10-16", 0, 1, 1, 0), 2, 2},
+		// 過去の期間では最終日に完了がなければ途切れている
+		{"past period", days("2026-10-10", 0, 1, 1, 0), 0, 2},
+	}
+	for _, tt := range tests {
+		current, longest := completionStreaks(tt.days, "2026-10-16")
+		if current != tt.current || longest != tt.longest {
+			t.Errorf("%s: streaks = (%d, %d), want (%d, %d)", tt.name, current, longest, tt.current, tt.longest)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Package storage は添付ファイルの中身の保存先を扱う
+// 保存先はローカルのファイルシステムかS3互換のオブジェクトストレージで、どちらも Storage として使える
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+)
+
+// Storage はキーを指定して中身を保存・取得する保存先
+// キーは呼び出し側が決める。添付ファイルでは中身のSHA-256（16進）を使い、同じ中身は1つだけ保存する
+type Storage interface {
+	// Put は key に r の内容（size バイト）を保存する。同じキーがあれば上書きする
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Open は key の内容を読み出す。なければ ErrNotFound を返す
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete は key を削除する。なければ何もしない
+	Delete(ctx context.Context, key string) error
+}
+
+// ErrNotFound は指定したキーがないことを表す
+var ErrNotFound = errors.New("storage: not found")
+
+// ErrInvalidKey は使えない文字を含むキーであることを表す
+var ErrInvalidKey = errors.New("storage: invalid key")
+
+// keyPattern は使えるキー。パスの区切りや "." を含めず、保存先の外を指せないようにする
+var keyPattern = regexp.MustCompile(`^[0-9a-z][0-9a-z_-]{2,127}$`)
+
+func checkKey(key string) error {
+	if !keyPattern.MatchString(key) {
+		return ErrInvalidKey
+	}
+	return nil
+}
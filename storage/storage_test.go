@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testStorage は Storage の実装が満たすべき振る舞いを確かめる
+func testStorage(t *testing.T, s Storage) {
+	t.Helper()
+	ctx := t.Context()
+	key := strings.Repeat("ab", 32)
+	content := []byte("hello, attachment")
+
+	if _, err := s.Open(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Open before Put: err = %v, want ErrNotFound", err)
+	}
+	if err := s.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	// 同じキーへの2回目の保存は上書きになる
+	if err := s.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+		t.Fatalf("Put again: %v", err)
+	}
+
+	r, err := s.Open(ctx, key)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	got, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("Open = %q, want %q", got, content)
+	}
+
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Open(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open after Delete: err = %v, want ErrNotFound", err)
+	}
+	if err := s.Delete(ctx, key); err != nil {
+		t.Errorf("Delete missing key: %v", err)
+	}
+
+	for _, bad := range []string{"", "../etc/passwd", "ab/cd", "ab.cd", "AB", "a"} {
+		if err := s.Put(ctx, bad, bytes.NewReader(content), int64(len(content)), "text/plain"); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q): err = %v, want ErrInvalidKey", bad, err)
+		}
+		if _, err := s.Open(ctx, bad); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Open(%q): err = %v, want ErrInvalidKey", bad, err)
+		}
+	}
+}
+
+func TestLocal(t *testing.T) {
+	s, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStorage(t, s)
+}
+
+func TestLocalPutSizeMismatch(t *testing.T) {
+	s, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := strings.Repeat("cd", 32)
+	if err := s.Put(context.Background(), key, strings.NewReader("short"), 100, "text/plain"); err == nil {
+		t.Fatal("Put with wrong size: want error")
+	}
+	if _, err := s.Open(context.Background(), key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open after failed Put: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestS3(t *testing.T) {
+	fake := newFakeS3(t, "attachments", "test-access")
+	endpoint, err := url.Parse(fake.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewS3(S3Config{
+		Endpoint:  endpoint.Host,
+		Bucket:    "attachments",
+		Region:    "us-east-1",
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		PathStyle: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStorage(t, s)
+}
+
+// newFakeS3 はS3互換APIのうちオブジェクトの保存・取得・削除だけを持つテスト用のサーバー
+// パス形式（/バケット/キー）のリクエストを受け、署名はアクセスキーだけを確かめる
+func newFakeS3(t *testing.T, bucket, accessKey string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+	modified := time.Now().UTC()
+
+	s3Error := func(w http.ResponseWriter, status int, code string) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+accessKey+"/") {
+			s3Error(w, http.StatusForbidden, "AccessDenied")
+			return
+		}
+		name, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if name != bucket || key == "" {
+			s3Error(w, http.StatusNotFound, "NoSuchBucket")
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			if err == nil && strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+				body, err = decodeAWSChunked(body)
+			}
+			if err != nil {
+				s3Error(w, http.StatusBadRequest, "IncompleteBody")
+				return
+			}
+			objects[key] = body
+			sum := md5.Sum(body)
+			w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+		case http.MethodGet, http.MethodHead:
+			body, ok := objects[key]
+			if !ok {
+				s3Error(w, http.StatusNotFound, "NoSuchKey")
+				return
+			}
+			sum := md5.Sum(body)
+			w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+			w.Header().Set("Last-Modified", modified.Format(http.TimeFormat))
+			w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+			w.Header().Set("Content-Type", "application/octet-stream")
+			if r.Method == http.MethodGet {
+				w.Write(body)
+			}
+		case http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			s3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// decodeAWSChunked は署名付きチャンク形式（"サイズ;chunk-signature=...\r\nデータ\r\n" の繰り返し）の本文からデータを取り出す
+func decodeAWSChunked(body []byte) ([]byte, error) {
+	var out []byte
+	for {
+		header, rest, ok := bytes.Cut(body, []byte("\r\n"))
+		if !ok {
+			return nil, io.ErrUnexpectedEOF
+		}
+		sizeHex, _, _ := bytes.Cut(header, []byte(";"))
+		var size int
+		if _, err := fmt.Sscanf(string(sizeHex), "%x", &size); err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return out, nil
+		}
+		if len(rest) < size+2 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		out = append(out, rest[:size]...)
+		body = rest[size+2:]
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+// registerSubtaskRoutes はサブタスクのルートを登録する
+// サブタスクは1階層のみで、親と同じリスト（または受信箱）に属する
+func registerSubtaskRoutes(g *echo.Group, db bob.DB) {
+	// サブタスク作成
+	g.POST("/:id/subtasks", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		parent := c.Get("todo").(*models.Todo)
+
+		if parent.ParentID.IsValue() {
+			return echo.NewHTTPError(http.StatusBadRequest, "サブタスクにはサブタスクを追加できません")
+		}
+		title := strings.TrimSpace(c.FormValue("title"))
+		if title == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "タイトルは必須です")
+		}
+
+		setter := &models.TodoSetter{
+			UserID:   omit.From(userID),
+			ParentID: omitnull.From(parent.ID),
+			Title:    omit.From(title),
+		}
+		if listID, ok := parent.ListID.Get(); ok {
+			setter.ListID = omitnull.From(listID)
+		}
+		// 完了済みの親に未完了のサブタスクが増えたら親も未完了に戻す
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			if _, err := models.Todos.Insert(setter).One(ctx, exec); err != nil {
+				return err
+			}
+			_, err := syncParentCompletion(ctx, exec, parent, time.Now().UTC(), c.Get("location").(*time.Location))
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, parent)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// syncParentCompletion は親の完了状態をサブタスクに合わせる
+// サブタスクがすべて完了していれば親を完了にし、未完了が残っていれば親を未完了に戻す
+// 親が未完了のTodoにブロックされていれば、サブタスクがすべて完了しても親は未完了のままにする
+// 繰り返しの親を完了にしたときは次の発生日のTodoを作って返す（ほかはnil）。複数の行を書くのでトランザクションの中で呼ぶ
+func syncParentCompletion(ctx context.Context, exec bob.Executor, parent *models.Todo, now time.Time, loc *time.Location) (*models.Todo, error) {
+	children, err := parent.Children().All(ctx, exec)
+	if err != nil || len(children) == 0 {
+		return nil, err
+	}
+	done := true
+	for _, child := range children {
+		done = done && child.Completed
+	}
+	if parent.Completed == done {
+		return nil, nil
+	}
+	if done {
+		blockers, err := openBlockers(ctx, exec, parent)
+		if err != nil || len(blockers) > 0 {
+			return nil, err
+		}
+		if parent.Recurrence.IsValue() {
+			return completeRecurringTodo(ctx, exec, parent, now, loc, nil)
+		}
+	}
+	return nil, parent.Update(ctx, exec, &models.TodoSetter{
+		Completed: omit.From(done),
+		UpdatedAt: omit.From(now),
+	})
+}
+
+// subtaskParent はサブタスクの親を返す。サブタスクでなければnilを返す
+func subtaskParent(ctx context.Context, exec bob.Executor, todo *models.Todo) (*models.Todo, error) {
+	parentID, ok := todo.ParentID.Get()
+	if !ok {
+		return nil, nil
+	}
+	return models.FindTodo(ctx, exec, parentID)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestSubtaskProgressAndAutoComplete(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	parent := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	parentPath := "/todos/" + strconv.FormatInt(parent.ID, 10)
+
+	for _, title := range []string{"sub-one", "sub-two"} {
+		rec := tc.do(http.MethodPost, parentPath+"/subtasks", url.Values{"title": {title}})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("create subtask: status = %d", rec.Code)
+		}
+	}
+	children, err := parent.Children().All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("children = %d, want 2", len(children))
+	}
+
+	// 一覧ではサブタスクは親の行の中だけに表示する
+	body := tc.do(http.MethodGet, "/todos", nil).Body.String()
+	if !strings.Contains(body, "0 / 2 完了") {
+		t.Error("index does not show subtask progress")
+	}
+	if strings.Contains(body, `id="todo-`+strconv.FormatInt(children[0].ID, 10)+`"`) {
+		t.Error("subtask is listed as a top-level todo")
+	}
+
+	toggle := func(child *models.Todo) string {
+		t.Helper()
+		rec := tc.doWithHeader(http.MethodPost, "/todos/"+strconv.FormatInt(child.ID, 10)+"/toggle", url.Values{},
+			http.Header{"Hx-Target": {"todo-" + strconv.FormatInt(parent.ID, 10)}})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("toggle subtask: status = %d", rec.Code)
+		}
+		if err := parent.Reload(ctx, db); err != nil {
+			t.Fatal(err)
+		}
+		return rec.Body.String()
+	}
+
+	// 親の行から操作したときは親の行を返す
+	if body := toggle(children[0]); !strings.Contains(body, `id="todo-`+strconv.FormatInt(parent.ID, 10)+`"`) || !strings.Contains(body, "1 / 2 完了") {
+		t.Errorf("toggle response is not the parent row with progress: %s", body)
+	}
+	if parent.Completed {
+		t.Error("parent completed while a subtask is open")
+	}
+
+	toggle(children[1])
+	if !parent.Completed {
+		t.Error("parent not completed after all subtasks are done")
+	}
+
+	// サブタスクを未完了に戻すと親も未完了に戻る
+	toggle(children[1])
+	if parent.Completed {
+		t.Error("parent still completed after reopening a subtask")
+	}
+
+	// 親が未完了のTodoにブロックされていれば、サブタスクがすべて完了しても親は未完了のまま
+	blocker := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	factory.New().NewTodoDependencyWithContext(ctx,
+		factory.TodoDependencyMods.WithExistingTodo(parent),
+		factory.TodoDependencyMods.WithExistingBlocker(blocker),
+	).CreateOrFail(ctx, t, db)
+	toggle(children[1])
+	if parent.Completed {
+		t.Error("blocked parent completed after all subtasks are done")
+	}
+
+	// サブタスクの下にはサブタスクを作れない
+	rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(children[0].ID, 10)+"/subtasks", url.Values{"title": {"nested"}})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("nested subtask: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestDeleteTodoWithSubtasks(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	parent := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	done := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.ParentID(null.From(parent.ID)), factory.TodoMods.Completed(true)).CreateOrFail(ctx, t, db)
+	open := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.ParentID(null.From(parent.ID)), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	// 最後の未完了のサブタスクを削除すると親が完了になる
+	rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(open.ID, 10)+"/delete", url.Values{})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("delete subtask: status = %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), "1 / 1 完了") {
+		t.Errorf("delete subtask response has no updated progress: %s", rec.Body.String())
+	}
+	if err := parent.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if !parent.Completed {
+		t.Error("parent not completed after deleting the last open subtask")
+	}
+
+	// 親を削除するとサブタスクも削除される
+	if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(parent.ID, 10)+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("delete parent: status = %d", rec.Code)
+	}
+	if exists, _ := models.TodoExists(ctx, db, done.ID); exists {
+		t.Error("subtask still exists after deleting its parent")
+	}
+}
+
+func TestMoveTodoWithSubtasks(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	parent := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	child := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.ParentID(null.From(parent.ID))).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	form := url.Values{"list_id": {strconv.FormatInt(list.ID, 10)}}
+
+	// サブタスクだけを移動することはできない
+	if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(child.ID, 10)+"/move", form); rec.Code != http.StatusBadRequest {
+		t.Errorf("move subtask: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(parent.ID, 10)+"/move", form); rec.Code != http.StatusOK {
+		t.Fatalf("move parent: status = %d", rec.Code)
+	}
+	if err := child.Reload(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if child.ListID.GetOr(0) != list.ID {
+		t.Errorf("subtask list_id = %v, want %d", child.ListID, list.ID)
+	}
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"hash/fnv"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/scan"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zhttp"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+type TagInput struct {
+	Name  string `zog:"name"`
+	Color string `zog:"color"`
+}
+
+var tagSchema = z.Struct(z.Shape{
+	"Name":  z.String().Trim().Required(z.Message("タグ名は必須です")).Max(30, z.Message("タグ名は30文字以内で入力してください")),
+	"Color": z.String().Required(z.Message("色は必須です")).Match(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), z.Message("色が正しくありません")),
+})
+
+// tagColors は新しく作ったタグに割り当てる色
+var tagColors = []string{"#1e88e5", "#43a047", "#fb8c00", "#8e24aa", "#e53935", "#00897b", "#6d4c41", "#546e7a"}
+
+// registerTagRoutes はタグ管理のルートを登録する。タグはユーザーごとに持つ
+func registerTagRoutes(g *echo.Group, db bob.DB) {
+	// タグ一覧
+	g.GET("", func(c echo.Context) error {
+		tags, err := listTagSummaries(c.Request().Context(), db, c.Get("user_id").(int64))
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.TagIndex(tags, csrfToken, nil))
+	})
+
+	// 名前と色の変更
+	g.POST("/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		tag, err := findTag(c, db)
+		if err != nil {
+			return err
+		}
+
+		renderErrors := func(errs map[string][]string) error {
+			tags, err := listTagSummaries(ctx, db, userID)
+			if err != nil {
+				return err
+			}
+			csrfToken := c.Get("csrf").(string)
+			return render(c, http.StatusBadRequest, views.TagIndex(tags, csrfToken, errs))
+		}
+
+		var input TagInput
+		if issues := tagSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			return renderErrors(issuesToMap(issues))
+		}
+		if input.Name != tag.Name {
+			exists, err := models.Tags.Query(
+				models.SelectWhere.Tags.UserID.EQ(userID),
+				models.SelectWhere.Tags.Name.EQ(input.Name),
+			).Exists(ctx, db)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return renderErrors(map[string][]string{"name": {"同じ名前のタグがあります。統合してください"}})
+			}
+		}
+
+		err = tag.Update(ctx, db, &models.TagSetter{
+			Name:      omit.From(input.Name),
+			Color:     omit.From(strings.ToLower(input.Color)),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		})
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/tags")
+	})
+
+	// 別のタグへ統合（付いていたTodoを統合先に付け替えて元のタグを削除する）
+	g.POST("/:id/merge", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		tag, err := findTag(c, db)
+		if err != nil {
+			return err
+		}
+		intoID, err := strconv.ParseInt(c.FormValue("into"), 10, 64)
+		if err != nil || intoID == tag.ID {
+			return echo.NewHTTPError(http.StatusBadRequest, "統合先のタグが正しくありません")
+		}
+		into, err := models.Tags.Query(
+			models.SelectWhere.Tags.ID.EQ(intoID),
+			models.SelectWhere.Tags.UserID.EQ(userID),
+		).One(ctx, db)
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusBadRequest, "統合先のタグが正しくありません")
+		}
+		if err != nil {
+			return err
+		}
+
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			todoTags, err := models.TodoTags.Query(models.SelectWhere.TodoTags.TagID.EQ(tag.ID)).All(ctx, exec)
+			if err != nil {
+				return err
+			}
+			if len(todoTags) > 0 {
+				setters := make([]*models.TodoTagSetter, len(todoTags))
+				for i, todoTag := range todoTags {
+					setters[i] = &models.TodoTagSetter{TodoID: omit.From(todoTag.TodoID), TagID: omit.From(into.ID)}
+				}
+				// 両方のタグが付いていたTodoは重複させない
+				_, err := models.TodoTags.Insert(bob.ToMods(setters...), im.OnConflict().DoNothing()).Exec(ctx, exec)
+				if err != nil {
+					return err
+				}
+			}
+			return deleteTag(ctx, exec, tag)
+		})
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/tags")
+	})
+
+	// タグ削除（Todoからも外れる）
+	g.POST("/:id/delete", func(c echo.Context) error {
+		tag, err := findTag(c, db)
+		if err != nil {
+			return err
+		}
+		err = db.RunInTx(c.Request().Context(), nil, func(ctx context.Context, exec bob.Executor) error {
+			return deleteTag(ctx, exec, tag)
+		})
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/tags")
+	})
+}
+
+// registerTodoTagRoutes はTodoへのタグの付け外しのルートを登録する
+func registerTodoTagRoutes(g *echo.Group, db bob.DB) {
+	// タグ名を指定してタグを付ける。自分のタグに同じ名前がなければ作る
+	g.POST("/:id/tags", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		todo := c.Get("todo").(*models.Todo)
+
+		name := strings.TrimSpace(c.FormValue("name"))
+		if name == "" || len([]rune(name)) > 30 {
+			return echo.NewHTTPError(http.StatusBadRequest, "タグ名は1〜30文字で入力してください")
+		}
+		tag, err := findOrCreateTag(ctx, db, userID, name)
+		if err != nil {
+			return err
+		}
+		_, err = models.TodoTags.Insert(&models.TodoTagSetter{
+			TodoID: omit.From(todo.ID),
+			TagID:  omit.From(tag.ID),
+		}, im.OnConflict().DoNothing()).Exec(ctx, db)
+		if err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+
+	// タグを外す
+	g.POST("/:id/tags/:tag_id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		tagID, err := strconv.ParseInt(c.Param("tag_id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		_, err = models.TodoTags.Delete(
+			models.DeleteWhere.TodoTags.TodoID.EQ(todo.ID),
+			models.DeleteWhere.TodoTags.TagID.EQ(tagID),
+		).Exec(ctx, db)
+		if err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// findTag はパスパラメータ :id のログイン中ユーザーのタグを取得する
+func findTag(c echo.Context, db bob.DB) (*models.Tag, error) {
+	id, err := paramID(c)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := models.Tags.Query(
+		models.SelectWhere.Tags.ID.EQ(id),
+		models.SelectWhere.Tags.UserID.EQ(c.Get("user_id").(int64)),
+	).One(c.Request().Context(), db)
+	if err != nil {
+		return nil, notFoundIfNoRows(err)
+	}
+	return tag, nil
+}
+
+// deleteTag はタグをすべてのTodoから外してから削除する
+// 外部キーの CASCADE で消すと外したことが履歴に残らないので、先に models.TodoTags.Delete で外す
+func deleteTag(ctx context.Context, exec bob.Executor, tag *models.Tag) error {
+	_, err := models.TodoTags.Delete(models.DeleteWhere.TodoTags.TagID.EQ(tag.ID)).Exec(ctx, exec)
+	if err != nil {
+		return err
+	}
+	return tag.Delete(ctx, exec)
+}
+
+// findOrCreateTag はユーザーの同名のタグを返し、なければ色を割り当てて作る
+func findOrCreateTag(ctx context.Context, db bob.DB, userID int64, name string) (*models.Tag, error) {
+	tag, err := models.Tags.Query(
+		models.SelectWhere.Tags.UserID.EQ(userID),
+		models.SelectWhere.Tags.Name.EQ(name),
+	).One(ctx, db)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return tag, err
+	}
+	now := time.Now().UTC()
+	return models.Tags.Insert(&models.TagSetter{
+		UserID:    omit.From(userID),
+		Name:      omit.From(name),
+		Color:     omit.From(tagColor(name)),
+		CreatedAt: omit.From(now),
+		UpdatedAt: omit.From(now),
+	}).One(ctx, db)
+}
+
+// tagColor はタグ名から決まる色を返す。同じ名前なら同じ色になる
+func tagColor(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return tagColors[h.Sum32()%uint32(len(tagColors))]
+}
+
+// tagTodoCount はタグごとのTodo件数の集計結果
+type tagTodoCount struct {
+	TagID     int64 `db:"tag_id"`
+	TodoCount int64 `db:"todo_count"`
+}
+
+// listTagSummaries はユーザーのタグを名前順に、付いているTodoの件数とあわせて取得する
+func listTagSummaries(ctx context.Context, db bob.DB, userID int64) ([]views.TagSummary, error) {
+	tags, err := models.Tags.Query(
+		models.SelectWhere.Tags.UserID.EQ(userID),
+		sm.OrderBy(models.Tags.Columns.Name),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := bob.All(ctx, db, sqlite.Select(
+		sm.Columns(models.TodoTags.Columns.TagID, sqlite.Raw("COUNT(*)").As("todo_count")),
+		sm.From(models.TodoTags.Name()),
+		sm.Where(models.TodoTags.Columns.TagID.OP("IN", sqlite.Select(
+			sm.Columns(models.Tags.Columns.ID),
+			sm.From(models.Tags.Name()),
+			models.SelectWhere.Tags.UserID.EQ(userID),
+		))),
+		// ゴミ箱のTodoは数えない
+		sm.Where(models.TodoTags.Columns.TodoID.OP("IN", sqlite.Select(
+			sm.Columns(models.Todos.Columns.ID),
+			sm.From(models.Todos.Name()),
+			models.SelectWhere.Todos.DeletedAt.IsNull(),
+		))),
+		sm.GroupBy(models.TodoTags.Columns.TagID),
+	), scan.StructMapper[tagTodoCount]())
+	if err != nil {
+		return nil, err
+	}
+
+	countByTag := make(map[int64]int64, len(counts))
+	for _, count := range counts {
+		countByTag[count.TagID] = count.TodoCount
+	}
+	summaries := make([]views.TagSummary, 0, len(tags))
+	for _, tag := range tags {
+		summaries = append(summaries, views.TagSummary{Tag: tag, TodoCount: countByTag[tag.ID]})
+	}
+	return summaries, nil
+}
+
+// todoTagFilter はタグ名で絞り込むWHERE句。共有リストでは他のメンバーが付けた同名のタグも対象になる
+func todoTagFilter(name string) bob.Mod[*dialect.SelectQuery] {
+	return sm.Where(models.Todos.Columns.ID.OP("IN", sqlite.Select(
+		sm.Columns(models.TodoTags.Columns.TodoID),
+		sm.From(models.TodoTags.Name()),
+		sm.InnerJoin(models.Tags.Name()).OnEQ(models.Tags.Columns.ID, models.TodoTags.Columns.TagID),
+		models.SelectWhere.Tags.Name.EQ(name),
+	)))
+}
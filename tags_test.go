@@ -0,0 +1,214 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestAddAndRemoveTodoTags(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	first := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	second := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+
+	// 同じ名前を何度付けてもタグは1つで、別のTodoでも使い回される
+	for _, todo := range []*models.Todo{first, first, second} {
+		rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/tags", url.Values{"name": {" work "}})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("add tag: status = %d", rec.Code)
+		}
+		if !strings.Contains(rec.Body.String(), "/todos?tag=work") {
+			t.Errorf("response has no chip for work: %s", rec.Body.String())
+		}
+	}
+	tags, err := models.Tags.Query(models.SelectWhere.Tags.UserID.EQ(alice.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0].Name != "work" {
+		t.Fatalf("tags = %v, want only work", tags)
+	}
+	if n, _ := models.TodoTags.Query().Count(ctx, db); n != 2 {
+		t.Errorf("todo_tags = %d, want 2", n)
+	}
+
+	rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(first.ID, 10)+"/tags/"+strconv.FormatInt(tags[0].ID, 10)+"/delete", url.Values{})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("remove tag: status = %d", rec.Code)
+	}
+	if n, _ := models.TodoTags.Query().Count(ctx, db); n != 1 {
+		t.Errorf("todo_tags after remove = %d, want 1", n)
+	}
+
+	// 空のタグ名は付けられない
+	rec = tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(first.ID, 10)+"/tags", url.Values{"name": {"  "}})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("empty tag: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestFilterTodosByTag(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	tc := login(t, e, alice)
+	for _, tt := range []struct{ title, tag string }{
+		{"todo-work", "work"},
+		{"todo-home", "home"},
+		{"todo-untagged", ""},
+	} {
+		todo := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title(tt.title)).CreateOrFail(ctx, t, db)
+		if tt.tag != "" {
+			tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/tags", url.Values{"name": {tt.tag}})
+		}
+	}
+
+	rec := tc.do(http.MethodGet, "/todos?tag=work", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /todos?tag=work: status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "todo-work") {
+		t.Error("filtered list does not contain todo-work")
+	}
+	for _, title := range []string{"todo-home", "todo-untagged"} {
+		if strings.Contains(body, title) {
+			t.Errorf("filtered list contains %s", title)
+		}
+	}
+
+	// 絞り込みなしでは全件とタグのチップが表示される
+	body = tc.do(http.MethodGet, "/todos", nil).Body.String()
+	for _, want := range []string{"todo-work", "todo-home", "todo-untagged", "/todos?tag=home"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("list does not contain %s", want)
+		}
+	}
+}
+
+func TestRenameAndMergeTags(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	bob := createTestUser(t, db, "bob@example.com")
+	tc := login(t, e, alice)
+
+	first := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	second := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(first.ID, 10)+"/tags", url.Values{"name": {"work"}})
+	tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(first.ID, 10)+"/tags", url.Values{"name": {"job"}})
+	tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(second.ID, 10)+"/tags", url.Values{"name": {"job"}})
+
+	findTagByName := func(name string) *models.Tag {
+		t.Helper()
+		tag, err := models.Tags.Query(
+			models.SelectWhere.Tags.UserID.EQ(alice.ID),
+			models.SelectWhere.Tags.Name.EQ(name),
+		).One(ctx, db)
+		if err != nil {
+			t.Fatalf("tag %s: %v", name, err)
+		}
+		return tag
+	}
+	work, job := findTagByName("work"), findTagByName("job")
+
+	t.Run("既存の名前への変更はエラー", func(t *testing.T) {
+		rec := tc.do(http.MethodPost, "/tags/"+strconv.FormatInt(job.ID, 10), url.Values{"name": {"work"}, "color": {"#123456"}})
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("名前と色を変更", func(t *testing.T) {
+		rec := tc.do(http.MethodPost, "/tags/"+strconv.FormatInt(work.ID, 10), url.Values{"name": {"office"}, "color": {"#ABCDEF"}})
+		if rec.Code != http.StatusFound {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusFound)
+		}
+		if err := work.Reload(ctx, db); err != nil {
+			t.Fatal(err)
+		}
+		if work.Name != "office" || work.Color != "#abcdef" {
+			t.Errorf("tag = %s %s, want office #abcdef", work.Name, work.Color)
+		}
+	})
+
+	t.Run("他のユーザーのタグは変更できない", func(t *testing.T) {
+		other := login(t, e, bob)
+		rec := other.do(http.MethodPost, "/tags/"+strconv.FormatInt(work.ID, 10), url.Values{"name": {"stolen"}, "color": {"#000000"}})
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+		}
+		rec = other.do(http.MethodPost, "/tags/"+strconv.FormatInt(job.ID, 10)+"/merge", url.Values{"into": {strconv.FormatInt(work.ID, 10)}})
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("merge: status = %d, want %d", rec.Code, http.StatusNotFound)
+		}
+	})
+
+	t.Run("統合すると付いていたTodoが統合先に移る", func(t *testing.T) {
+		rec := tc.do(http.MethodPost, "/tags/"+strconv.FormatInt(job.ID, 10)+"/merge", url.Values{"into": {strconv.FormatInt(work.ID, 10)}})
+		if rec.Code != http.StatusFound {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusFound)
+		}
+		if exists, _ := models.TagExists(ctx, db, job.ID); exists {
+			t.Error("merged tag still exists")
+		}
+		todos, err := work.Todos().All(ctx, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(todos) != 2 {
+			t.Errorf("todos with merged tag = %d, want 2", len(todos))
+		}
+	})
+
+	// 統合や削除でタグが付け替わった・外れたことも履歴に残る
+	tagEvents := func(todo *models.Todo) []string {
+		t.Helper()
+		events, err := models.TodoEvents.Query(
+			models.SelectWhere.TodoEvents.TodoID.EQ(todo.ID),
+			models.SelectWhere.TodoEvents.Kind.In(todoEventTagAdded, todoEventTagRemoved),
+			sm.OrderBy(models.TodoEvents.Columns.ID),
+		).All(ctx, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, event := range events {
+			got = append(got, event.Kind+":"+event.OldValue.GetOrZero()+event.NewValue.GetOrZero())
+		}
+		return got
+	}
+	t.Run("統合と削除の履歴", func(t *testing.T) {
+		if rec := tc.do(http.MethodPost, "/tags/"+strconv.FormatInt(work.ID, 10)+"/delete", url.Values{}); rec.Code != http.StatusFound {
+			t.Fatalf("delete: status = %d", rec.Code)
+		}
+		for _, tt := range []struct {
+			todo *models.Todo
+			want []string
+		}{
+			{first, []string{"tag_added:work", "tag_added:job", "tag_removed:job", "tag_removed:office"}},
+			{second, []string{"tag_added:job", "tag_added:office", "tag_removed:job", "tag_removed:office"}},
+		} {
+			if got := tagEvents(tt.todo); !slices.Equal(got, tt.want) {
+				t.Errorf("todo %d: tag events = %v, want %v", tt.todo.ID, got, tt.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zhttp"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+type ChecklistTemplateInput struct {
+	Name  string `zog:"name"`
+	Items string `zog:"items"`
+}
+
+var checklistTemplateSchema = z.Struct(z.Shape{
+	"Name":  z.String().Trim().Required(z.Message("テンプレート名は必須です")).Max(50, z.Message("テンプレート名は50文字以内で入力してください")),
+	"Items": z.String().Trim().Required(z.Message("項目を1つ以上入力してください")),
+})
+
+// maxTemplateItems はテンプレート1つに入れられる項目の最大数
+const maxTemplateItems = 100
+
+// templateDuePattern は項目の行末の相対期限（"+2d" で使った日の2日後、"+1w" で1週間後）
+var templateDuePattern = regexp.MustCompile(`(?:^|\s+)\+(\d{1,3})([dw])$`)
+
+// templateItem はテンプレートの項目の入力1行分
+type templateItem struct {
+	Title   string
+	DueDays null.Val[int64]
+}
+
+// parseTemplateItems は項目の入力を1行1項目で読む。空行は飛ばす
+// エラーは入力エラーとしてそのまま表示できるメッセージにする
+func parseTemplateItems(text string) ([]templateItem, error) {
+	var items []templateItem
+	for line := range strings.Lines(text) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		item := templateItem{Title: line}
+		if m := templateDuePattern.FindStringSubmatch(line); m != nil {
+			days, _ := strconv.ParseInt(m[1], 10, 64)
+			if m[2] == "w" {
+				days *= 7
+			}
+			item.Title = strings.TrimSpace(strings.TrimSuffix(line, m[0]))
+			item.DueDays = null.From(days)
+		}
+		if item.Title == "" {
+			return nil, errors.New("タイトルのない項目があります")
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, errors.New("項目を1つ以上入力してください")
+	}
+	if len(items) > maxTemplateItems {
+		return nil, errors.New("項目は" + strconv.Itoa(maxTemplateItems) + "個までです")
+	}
+	return items, nil
+}
+
+// registerTemplateRoutes はチェックリストのテンプレートのルートを登録する。テンプレートはユーザーごとに持つ
+func registerTemplateRoutes(g *echo.Group, db bob.DB) {
+	// テンプレートの管理ページ
+	g.GET("", func(c echo.Context) error {
+		return renderTemplateIndex(c, db, http.StatusOK, nil)
+	})
+
+	// テンプレートの作成
+	g.POST("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		input, items, errs := parseTemplateInput(c)
+		if errs != nil {
+			return renderTemplateIndex(c, db, http.StatusBadRequest, errs)
+		}
+		if exists, err := templateNameExists(ctx, db, userID, input.Name); err != nil || exists {
+			if err != nil {
+				return err
+			}
+			return renderTemplateIndex(c, db, http.StatusBadRequest, map[string][]string{"name": {"同じ名前のテンプレートがあります"}})
+		}
+
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			now := time.Now().UTC()
+			tmpl, err := models.ChecklistTemplates.Insert(&models.ChecklistTemplateSetter{
+				UserID:    omit.From(userID),
+				Name:      omit.From(input.Name),
+				CreatedAt: omit.From(now),
+				UpdatedAt: omit.From(now),
+			}).One(ctx, exec)
+			if err != nil {
+				return err
+			}
+			return insertTemplateItems(ctx, exec, tmpl.ID, items)
+		})
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/templates")
+	})
+
+	// 名前と項目の変更（項目はすべて入れ替える）
+	g.POST("/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		tmpl, err := findChecklistTemplateParam(c, db)
+		if err != nil {
+			return err
+		}
+		input, items, errs := parseTemplateInput(c)
+		if errs != nil {
+			return renderTemplateIndex(c, db, http.StatusBadRequest, errs)
+		}
+		if input.Name != tmpl.Name {
+			if exists, err := templateNameExists(ctx, db, tmpl.UserID, input.Name); err != nil || exists {
+				if err != nil {
+					return err
+				}
+				return renderTemplateIndex(c, db, http.StatusBadRequest, map[string][]string{"name": {"同じ名前のテンプレートがあります"}})
+			}
+		}
+
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			if err := tmpl.Update(ctx, exec, &models.ChecklistTemplateSetter{
+				Name:      omit.From(input.Name),
+				UpdatedAt: omit.From(time.Now().UTC()),
+			}); err != nil {
+				return err
+			}
+			_, err := models.ChecklistTemplateItems.Delete(
+				models.DeleteWhere.ChecklistTemplateItems.TemplateID.EQ(tmpl.ID),
+			).Exec(ctx, exec)
+			if err != nil {
+				return err
+			}
+			return insertTemplateItems(ctx, exec, tmpl.ID, items)
+		})
+		if err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/templates")
+	})
+
+	// テンプレートの削除（作ったTodoはそのまま残る）
+	g.POST("/:id/delete", func(c echo.Context) error {
+		tmpl, err := findChecklistTemplateParam(c, db)
+		if err != nil {
+			return err
+		}
+		if err := tmpl.Delete(c.Request().Context(), db); err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/templates")
+	})
+
+	// Todo一覧の「テンプレートから追加」フォーム。list_id があればそのリストに、なければ受信箱に追加する
+	g.GET("/picker", func(c echo.Context) error {
+		templates, err := models.ChecklistTemplates.Query(
+			models.SelectWhere.ChecklistTemplates.UserID.EQ(c.Get("user_id").(int64)),
+			sm.OrderBy(models.ChecklistTemplates.Columns.Name),
+		).All(c.Request().Context(), db)
+		if err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, views.TemplatePicker(templates, c.QueryParam("list_id"), c.Get("csrf").(string)))
+	})
+
+	// テンプレートを使う。項目をまとめて1つのトランザクションでTodoとして作り、一覧の末尾に追加する行を返す
+	// 相対期限は使った日（ユーザーのタイムゾーン）から数えた日を期限日にする
+	// 使うテンプレートは template_id で指定する
+	g.POST("/apply", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		id, err := strconv.ParseInt(c.FormValue("template_id"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "テンプレートを選んでください")
+		}
+		tmpl, err := findChecklistTemplate(ctx, db, userID, id)
+		if err != nil {
+			return err
+		}
+		items, err := tmpl.Items(sm.OrderBy(models.ChecklistTemplateItems.Columns.Position)).All(ctx, db)
+		if err != nil {
+			return err
+		}
+
+		var listID int64
+		if v := c.FormValue("list_id"); v != "" {
+			listID, err = strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "リストが正しくありません")
+			}
+			if _, _, err := findListWithRole(ctx, db, userID, listID, RoleEditor); err != nil {
+				return err
+			}
+		}
+
+		loc := c.Get("location").(*time.Location)
+		today := civilDate(time.Now().In(loc).Format(time.DateOnly))
+		var created models.TodoSlice
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			scope := positionScopeForList(listID, userID)
+			for _, item := range items {
+				position, err := appendPosition(ctx, exec, scope)
+				if err != nil {
+					return err
+				}
+				setter := &models.TodoSetter{
+					UserID:   omit.From(userID),
+					Title:    omit.From(item.Title),
+					Position: omit.From(position),
+				}
+				if listID != 0 {
+					setter.ListID = omitnull.From(listID)
+				}
+				if days, ok := item.DueDays.Get(); ok {
+					dueAt, err := parseDueDate(today.AddDate(0, 0, int(days)).Format(time.DateOnly), loc)
+					if err != nil {
+						return err
+					}
+					setter.DueAt = omitnull.From(dueAt)
+				}
+				todo, err := models.Todos.Insert(setter).One(ctx, exec)
+				if err != nil {
+					return err
+				}
+				created = append(created, todo)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		csrfToken := c.Get("csrf").(string)
+		components := make([]templ.Component, len(created))
+		for i, todo := range created {
+			if err := loadTodoItem(ctx, db, todo); err != nil {
+				return err
+			}
+			components[i] = views.TodoItem(todo, csrfToken)
+		}
+		return render(c, http.StatusOK, templ.Join(components...))
+	})
+}
+
+// renderTemplateIndex はテンプレートの管理ページを返す。errs があれば入力エラーとして表示する
+func renderTemplateIndex(c echo.Context, db bob.DB, status int, errs map[string][]string) error {
+	templates, err := models.ChecklistTemplates.Query(
+		models.SelectWhere.ChecklistTemplates.UserID.EQ(c.Get("user_id").(int64)),
+		sm.OrderBy(models.ChecklistTemplates.Columns.Name),
+		models.SelectThenLoad.ChecklistTemplate.Items(sm.OrderBy(models.ChecklistTemplateItems.Columns.Position)),
+	).All(c.Request().Context(), db)
+	if err != nil {
+		return err
+	}
+	return render(c, status, views.TemplateIndex(templates, c.Get("csrf").(string), errs))
+}
+
+// parseTemplateInput はテンプレートのフォームを検証する。入力エラーは項目ごとのメッセージで返す
+func parseTemplateInput(c echo.Context) (ChecklistTemplateInput, []templateItem, map[string][]string) {
+	var input ChecklistTemplateInput
+	if issues := checklistTemplateSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+		return input, nil, issuesToMap(issues)
+	}
+	items, err := parseTemplateItems(input.Items)
+	if err != nil {
+		return input, nil, map[string][]string{"items": {err.Error()}}
+	}
+	return input, items, nil
+}
+
+func templateNameExists(ctx context.Context, db bob.DB, userID int64, name string) (bool, error) {
+	return models.ChecklistTemplates.Query(
+		models.SelectWhere.ChecklistTemplates.UserID.EQ(userID),
+		models.SelectWhere.ChecklistTemplates.Name.EQ(name),
+	).Exists(ctx, db)
+}
+
+func insertTemplateItems(ctx context.Context, exec bob.Executor, templateID int64, items []templateItem) error {
+	setters := make([]*models.ChecklistTemplateItemSetter, len(items))
+	for i, item := range items {
+		setters[i] = &models.ChecklistTemplateItemSetter{
+			TemplateID: omit.From(templateID),
+			Title:      omit.From(item.Title),
+			DueDays:    omitnull.FromNull(item.DueDays),
+			Position:   omit.From(int64(i)),
+		}
+	}
+	_, err := models.ChecklistTemplateItems.Insert(bob.ToMods(setters...)).Exec(ctx, exec)
+	return err
+}
+
+// findChecklistTemplateParam はパスパラメータ :id のログイン中のユーザーのテンプレートを返す
+func findChecklistTemplateParam(c echo.Context, db bob.DB) (*models.ChecklistTemplate, error) {
+	id, err := paramID(c)
+	if err != nil {
+		return nil, err
+	}
+	return findChecklistTemplate(c.Request().Context(), db, c.Get("user_id").(int64), id)
+}
+
+// findChecklistTemplate はユーザーのテンプレートを返す。ほかのユーザーのテンプレートは見つからないものとして扱う
+func findChecklistTemplate(ctx context.Context, db bob.DB, userID, id int64) (*models.ChecklistTemplate, error) {
+	tmpl, err := models.ChecklistTemplates.Query(
+		models.SelectWhere.ChecklistTemplates.ID.EQ(id),
+		models.SelectWhere.ChecklistTemplates.UserID.EQ(userID),
+	).One(ctx, db)
+	if err != nil {
+		return nil, notFoundIfNoRows(err)
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestParseTemplateItems(t *testing.T) {
+	items, err := parseTemplateItems("write notes\n\n  review +2d \nship it +1w\n+3d\n")
+	if err == nil {
+		t.Fatalf("items = %v, want error for an item without a title", items)
+	}
+
+	items, err = parseTemplateItems("write notes\r\nreview +2d\nship it +1w\nversion 1+2d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []templateItem{
+		{Title: "write notes"},
+		{Title: "review", DueDays: null.From[int64](2)},
+		{Title: "ship it", DueDays: null.From[int64](7)},
+		{Title: "version 1+2d"},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("items = %v, want %v", items, want)
+	}
+	for i := range want {
+		if items[i].Title != want[i].Title || items[i].DueDays != want[i].DueDays {
+			t.Errorf("items[%d] = %v, want %v", i, items[i], want[i])
+		}
+	}
+
+	if _, err := parseTemplateItems(strings.Repeat("item\n", maxTemplateItems+1)); err == nil {
+		t.Error("too many items were accepted")
+	}
+}
+
+func TestChecklistTemplates(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+	loc := loadLocation(defaultTimezone)
+
+	alice := createTestUser(t, db, "alice@example.com")
+	tc := login(t, e, alice)
+
+	rec := tc.do(http.MethodPost, "/templates", url.Values{"name": {"Release"}, "items": {"changelog\nstaging check +1d\nrelease +1w"}})
+	if rec.Code != http.StatusFound {
+		t.Fatalf("create: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	for _, form := range []url.Values{
+		{"name": {"Release"}, "items": {"another"}},
+		{"name": {"Empty"}, "items": {"  \n "}},
+	} {
+		if rec := tc.do(http.MethodPost, "/templates", form); rec.Code != http.StatusBadRequest {
+			t.Errorf("create %v: status = %d, want %d", form, rec.Code, http.StatusBadRequest)
+		}
+	}
+	tmpl, err := models.ChecklistTemplates.Query(models.SelectWhere.ChecklistTemplates.Name.EQ("Release")).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := tc.do(http.MethodGet, "/templates", nil).Body.String(); !strings.Contains(body, "staging check +1d\nrelease +7d") {
+		t.Errorf("management page does not show items: %s", body)
+	}
+
+	// 受信箱に使うと、項目の順に期限を解決したTodoを作る
+	templateID := strconv.FormatInt(tmpl.ID, 10)
+	rec = tc.do(http.MethodPost, "/templates/apply", url.Values{"template_id": {templateID}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("apply: status = %d", rec.Code)
+	}
+	assertOrder(t, rec.Body.String(), "changelog", "staging check", "release")
+	todos, err := models.Todos.Query(models.SelectWhere.Todos.UserID.EQ(alice.ID), sm.OrderBy(models.Todos.Columns.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(todos) != 3 {
+		t.Fatalf("todos = %d, want 3", len(todos))
+	}
+	today := civilDate(time.Now().In(loc).Format(time.DateOnly))
+	for i, days := range []int{-1, 1, 7} {
+		var want null.Val[time.Time]
+		if days >= 0 {
+			d, err := parseDueDate(today.AddDate(0, 0, days).Format(time.DateOnly), loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want = null.From(d)
+		}
+		if todos[i].DueAt != want || todos[i].ListID.IsValue() {
+			t.Errorf("todo %q: due_at = %v, list_id = %v, want due %v in inbox", todos[i].Title, todos[i].DueAt, todos[i].ListID, want)
+		}
+	}
+
+	// リストに使うには編集者以上の権限が要る
+	bob := createTestUser(t, db, "bob@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(bob)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(alice),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+	listID := strconv.FormatInt(list.ID, 10)
+	if rec := tc.do(http.MethodPost, "/templates/apply", url.Values{"template_id": {templateID}, "list_id": {listID}}); rec.Code != http.StatusForbidden {
+		t.Errorf("apply to a viewed list: status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	if n, _ := models.Todos.Query(models.SelectWhere.Todos.ListID.EQ(list.ID)).Count(ctx, db); n != 0 {
+		t.Errorf("todos in list = %d, want 0", n)
+	}
+
+	// ほかのユーザーのテンプレートは使えない
+	if rec := login(t, e, bob).do(http.MethodPost, "/templates/apply", url.Values{"template_id": {templateID}, "list_id": {listID}}); rec.Code != http.StatusNotFound {
+		t.Errorf("apply another user's template: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// 変更すると項目を入れ替える
+	tc.do(http.MethodPost, "/templates/"+templateID, url.Values{"name": {"Release v2"}, "items": {"tag +0d"}})
+	items, err := tmpl.Items().All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].Title != "tag" || items[0].DueDays != null.From[int64](0) {
+		t.Errorf("items = %v", items)
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// defaultTimeReportDays は作業時間のレポートの既定の期間（今日までの日数）
+const defaultTimeReportDays = 7
+
+// maxTimeReportDays は作業時間のレポートで一度に集計できる最大の日数
+const maxTimeReportDays = 366
+
+// registerTimerRoutes はTodoのタイマーのルートを登録する
+// タイマーはサブタスクを除くTodoの行に表示し、操作のたびに行を描き直す
+func registerTimerRoutes(g *echo.Group, db bob.DB) {
+	// タイマーを開始する。ほかのTodoで計測中のタイマーは止め、その行も out-of-band で描き直す
+	g.POST("/:id/timer/start", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		todo := c.Get("todo").(*models.Todo)
+		if todo.ParentID.IsValue() {
+			return echo.NewHTTPError(http.StatusBadRequest, "サブタスクでは時間を計測できません")
+		}
+
+		var stopped *models.TimeEntry
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			running, err := runningTimer(ctx, exec, userID)
+			if err != nil || running != nil && running.TodoID == todo.ID {
+				return err
+			}
+			// レポートの期間はDBに書いた文字列のまま比べるので、期間の境界と同じUTCにそろえる
+			now := time.Now().UTC()
+			if running != nil {
+				if err := running.Update(ctx, exec, &models.TimeEntrySetter{EndedAt: omitnull.From(now)}); err != nil {
+					return err
+				}
+				stopped = running
+			}
+			_, err = models.TimeEntries.Insert(&models.TimeEntrySetter{
+				TodoID:    omit.From(todo.ID),
+				UserID:    omit.From(userID),
+				StartedAt: omit.From(now),
+			}).Exec(ctx, exec)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		items, err := todoItems(c, db, todo)
+		if err != nil {
+			return err
+		}
+		if stopped != nil {
+			// 止めたTodoがゴミ箱にあれば一覧にも無いので描き直さない
+			other, err := models.FindTodo(ctx, db, stopped.TodoID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			if other != nil {
+				if err := loadTodoItem(ctx, db, other); err != nil {
+					return err
+				}
+				items = append(items, views.TodoItemOOB(other, c.Get("csrf").(string)))
+			}
+		}
+		return render(c, http.StatusOK, templ.Join(items...))
+	}, requireTodoRole(db, RoleEditor))
+
+	// タイマーを止める。このTodoで計測中でなければ何もしない
+	g.POST("/:id/timer/stop", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		running, err := runningTimer(ctx, db, c.Get("user_id").(int64))
+		if err != nil {
+			return err
+		}
+		if running != nil && running.TodoID == todo.ID {
+			if err := running.Update(ctx, db, &models.TimeEntrySetter{EndedAt: omitnull.From(time.Now().UTC())}); err != nil {
+				return err
+			}
+		}
+		return renderTodoItem(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+}
+
+// runningTimer はユーザーが計測中のタイマーを返す。計測中でなければnilを返す
+func runningTimer(ctx context.Context, exec bob.Executor, userID int64) (*models.TimeEntry, error) {
+	entry, err := models.TimeEntries.Query(
+		models.SelectWhere.TimeEntries.UserID.EQ(userID),
+		models.SelectWhere.TimeEntries.EndedAt.IsNull(),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return entry, err
+}
+
+// registerTimeReportRoutes は作業時間のレポートのルートを登録する
+// レポートはログイン中のユーザー自身の記録を、開始した日（ユーザーのタイムゾーン）で期間に含めて集計する
+func registerTimeReportRoutes(g *echo.Group, db bob.DB) {
+	// 日・リスト・タグごとの合計と記録の一覧
+	g.GET("", func(c echo.Context) error {
+		period, entries, err := loadTimeEntries(c, db)
+		if err != nil {
+			return err
+		}
+		report := buildTimeReport(entries, time.Now(), c.Get("location").(*time.Location))
+		report.Period = period
+		return render(c, http.StatusOK, views.TimeReportPage(report))
+	})
+
+	// 期間内の記録をCSVで書き出す（1行に1件の記録）
+	g.GET("/export", func(c echo.Context) error {
+		period, entries, err := loadTimeEntries(c, db)
+		if err != nil {
+			return err
+		}
+		loc := c.Get("location").(*time.Location)
+		now := time.Now()
+
+		filename := "time-" + strings.ReplaceAll(period.From, "-", "") + "-" + strings.ReplaceAll(period.To, "-", "") + ".csv"
+		c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="`+filename+`"`)
+		c.Response().WriteHeader(http.StatusOK)
+		// Excelで文字化けしないようにBOMを付ける
+		if _, err := c.Response().Write([]byte("\ufeff")); err != nil {
+			return err
+		}
+		w := csv.NewWriter(c.Response())
+		if err := w.Write([]string{"日付", "開始", "終了", "時間（分）", "リスト", "Todo", "タグ"}); err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			start := entry.StartedAt.In(loc)
+			end := ""
+			if endedAt, ok := entry.EndedAt.Get(); ok {
+				end = endedAt.In(loc).Format("15:04")
+			}
+			if err := w.Write([]string{
+				start.Format(time.DateOnly),
+				start.Format("15:04"),
+				end,
+				strconv.FormatInt(int64(views.EntryDuration(entry, now).Minutes()), 10),
+				timeEntryList(entry),
+				entry.R.Todo.Title,
+				strings.Join(timeEntryTags(entry), " "),
+			}); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	})
+}
+
+// loadTimeEntries はクエリパラメータ from・to の期間にログイン中のユーザーが開始した記録を読み込む
+// Todoはゴミ箱にあるものも含め、リストとタグも読み込む
+func loadTimeEntries(c echo.Context, db bob.DB) (views.TimePeriod, models.TimeEntrySlice, error) {
+	period, from, end, err := parsePeriod(c, defaultTimeReportDays, maxTimeReportDays)
+	if err != nil {
+		return period, nil, err
+	}
+
+	entries, err := models.TimeEntries.Query(
+		models.SelectWhere.TimeEntries.UserID.EQ(c.Get("user_id").(int64)),
+		models.SelectWhere.TimeEntries.StartedAt.GTE(from),
+		models.SelectWhere.TimeEntries.StartedAt.LT(end),
+		sm.OrderBy(models.TimeEntries.Columns.StartedAt),
+		sm.OrderBy(models.TimeEntries.Columns.ID),
+		models.SelectThenLoad.TimeEntry.Todo(
+			models.SelectThenLoad.Todo.List(),
+			models.SelectThenLoad.Todo.Tags(sm.OrderBy(models.Tags.Columns.Name)),
+		),
+	).All(withDeletedTodos(c.Request().Context()), db)
+	return period, entries, err
+}
+
+// parsePeriod はクエリパラメータ from・to（"2006-01-02" 形式、両端を含む）をレポートの期間として読む
+// 指定がなければ今日までの defaultDays 日間にする。from は初日の最初の時刻、end は最終日の翌日の最初の時刻（ユーザーのタイムゾーン）
+func parsePeriod(c echo.Context, defaultDays, maxDays int) (period views.TimePeriod, from, end time.Time, err error) {
+	loc := c.Get("location").(*time.Location)
+	today := time.Now().In(loc).Format(time.DateOnly)
+	period = views.TimePeriod{From: c.QueryParam("from"), To: c.QueryParam("to")}
+	if period.To == "" {
+		period.To = today
+	}
+	to, err := parseDueDate(period.To, loc)
+	if err != nil {
+		return period, from, end, echo.NewHTTPError(http.StatusBadRequest, "期間の指定が正しくありません")
+	}
+	if period.From == "" {
+		period.From = to.In(loc).AddDate(0, 0, -(defaultDays - 1)).Format(time.DateOnly)
+	}
+	from, err = parseDueDate(period.From, loc)
+	if err != nil || from.After(to) {
+		return period, from, end, echo.NewHTTPError(http.StatusBadRequest, "期間の指定が正しくありません")
+	}
+	end, err = parseDueDate(to.In(loc).AddDate(0, 0, 1).Format(time.DateOnly), loc)
+	if err != nil {
+		return period, from, end, err
+	}
+	if end.Sub(from) > time.Duration(maxDays)*24*time.Hour {
+		return period, from, end, echo.NewHTTPError(http.StatusBadRequest, "期間は"+strconv.Itoa(maxDays)+"日以内で指定してください")
+	}
+	return period, from, end, nil
+}
+
+// buildTimeReport は記録を日・リスト・タグごとに合計する
+// 日は古い順、リストとタグは時間の長い順に並べる。タグが複数ある記録は、それぞれのタグに全部の時間を数える
+func buildTimeReport(entries models.TimeEntrySlice, now time.Time, loc *time.Location) views.TimeReport {
+	report := views.TimeReport{Entries: entries}
+	days, lists, tags := map[string]time.Duration{}, map[string]time.Duration{}, map[string]time.Duration{}
+	for _, entry := range entries {
+		d := views.EntryDuration(entry, now)
+		report.Total += d
+		days[entry.StartedAt.In(loc).Format(time.DateOnly)] += d
+		lists[timeEntryList(entry)] += d
+		entryTags := timeEntryTags(entry)
+		if len(entryTags) == 0 {
+			entryTags = []string{"タグなし"}
+		}
+		for _, tag := range entryTags {
+			tags[tag] += d
+		}
+	}
+
+	summaries := func(totals map[string]time.Duration) []views.TimeSummary {
+		var s []views.TimeSummary
+		for label, d := range totals {
+			s = append(s, views.TimeSummary{Label: label, Duration: d})
+		}
+		slices.SortFunc(s, func(a, b views.TimeSummary) int {
+			if c := cmp.Compare(b.Duration, a.Duration); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Label, b.Label)
+		})
+		return s
+	}
+	report.Days = summaries(days)
+	slices.SortFunc(report.Days, func(a, b views.TimeSummary) int { return strings.Compare(a.Label, b.Label) })
+	report.Lists = summaries(lists)
+	report.Tags = summaries(tags)
+	return report
+}
+
+// timeEntryList は記録のTodoのリスト名。受信箱なら「受信箱」にする
+func timeEntryList(entry *models.TimeEntry) string {
+	if list := entry.R.Todo.R.List; list != nil {
+		return list.Name
+	}
+	return "受信箱"
+}
+
+func timeEntryTags(entry *models.TimeEntry) []string {
+	names := make([]string, len(entry.R.Todo.R.Tags))
+	for i, tag := range entry.R.Todo.R.Tags {
+		names[i] = tag.Name
+	}
+	return names
+}
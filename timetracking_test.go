@@ -0,0 +1,177 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestTimerStartStop(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	a := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	b := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	timerPath := func(todo *models.Todo, action string) string {
+		return "/todos/" + strconv.FormatInt(todo.ID, 10) + "/timer/" + action
+	}
+	running := func() models.TimeEntrySlice {
+		t.Helper()
+		entries, err := models.TimeEntries.Query(models.SelectWhere.TimeEntries.EndedAt.IsNull()).All(ctx, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return entries
+	}
+
+	rec := tc.do(http.MethodPost, timerPath(a, "start"), url.Values{})
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `/timer/stop"`) {
+		t.Fatalf("start a: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	// 計測中のTodoでもう一度開始しても記録は増やさない
+	tc.do(http.MethodPost, timerPath(a, "start"), url.Values{})
+	if n, _ := models.TimeEntries.Query().Count(ctx, db); n != 1 {
+		t.Errorf("entries = %d, want 1", n)
+	}
+
+	// 別のTodoで開始すると、計測中のタイマーは止まり、その行も描き直す
+	rec = tc.do(http.MethodPost, timerPath(b, "start"), url.Values{})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("start b: status = %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `<li id="todo-`+strconv.FormatInt(a.ID, 10)+`" hx-swap-oob="true"`) {
+		t.Error("response does not update the stopped todo")
+	}
+	if entries := running(); len(entries) != 1 || entries[0].TodoID != b.ID {
+		t.Errorf("running timers = %v, want only todo %d", entries, b.ID)
+	}
+
+	// 止めるのは計測中のTodoのタイマーだけ
+	tc.do(http.MethodPost, timerPath(a, "stop"), url.Values{})
+	if len(running()) != 1 {
+		t.Error("stopping another todo stopped the running timer")
+	}
+	if rec := tc.do(http.MethodPost, timerPath(b, "stop"), url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("stop b: status = %d", rec.Code)
+	}
+	if entries := running(); len(entries) != 0 {
+		t.Errorf("running timers = %v, want none", entries)
+	}
+
+	// 計測中のタイマーが2つになる記録はデータベースでも拒否する
+	for i, todo := range []*models.Todo{a, b} {
+		_, err := models.TimeEntries.Insert(&models.TimeEntrySetter{
+			TodoID:    omit.From(todo.ID),
+			UserID:    omit.From(alice.ID),
+			StartedAt: omit.From(time.Now()),
+		}).Exec(ctx, db)
+		if i == 1 && err == nil {
+			t.Error("second running timer was inserted")
+		}
+	}
+}
+
+func TestTimeReport(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(alice), factory.ListMods.Name("client-a")).CreateOrFail(ctx, t, db)
+	tag := f.NewTagWithContext(ctx, factory.TagMods.WithExistingUser(alice), factory.TagMods.Name("billable")).CreateOrFail(ctx, t, db)
+	listed := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Title("design review"),
+	).CreateOrFail(ctx, t, db)
+	inbox := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title("inbox chore")).CreateOrFail(ctx, t, db)
+	if _, err := models.TodoTags.Insert(&models.TodoTagSetter{TodoID: omit.From(listed.ID), TagID: omit.From(tag.ID)}).Exec(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC)
+	for _, entry := range []struct {
+		todo     *models.Todo
+		start    time.Time
+		duration time.Duration
+	}{
+		{listed, day, time.Hour},
+		{listed, day.Add(2 * time.Hour), 30 * time.Minute},
+		{inbox, day.Add(3 * time.Hour), 15 * time.Minute},
+		{inbox, day.AddDate(0, 0, 3), time.Hour}, // 期間外
+	} {
+		_, err := models.TimeEntries.Insert(&models.TimeEntrySetter{
+			TodoID:    omit.From(entry.todo.ID),
+			UserID:    omit.From(alice.ID),
+			StartedAt: omit.From(entry.start),
+			EndedAt:   omitnull.From(entry.start.Add(entry.duration)),
+		}).Exec(ctx, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tc := login(t, e, alice)
+
+	body := tc.do(http.MethodGet, "/time?from=2026-03-02&to=2026-03-02", nil).Body.String()
+	for _, s := range []string{"合計 <strong>1:45</strong>", "client-a", "billable", "タグなし", "受信箱"} {
+		if !strings.Contains(body, s) {
+			t.Errorf("report does not contain %q", s)
+		}
+	}
+
+	rec := tc.do(http.MethodGet, "/time/export?from=2026-03-02&to=2026-03-02", nil)
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/csv") {
+		t.Fatalf("export: status = %d, content type = %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	lines := strings.Split(strings.TrimSpace(strings.TrimPrefix(rec.Body.String(), "\ufeff")), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("csv lines = %d, want 4: %q", len(lines), lines)
+	}
+	if !strings.HasPrefix(lines[0], "日付,") || !strings.Contains(lines[1], ",60,client-a,design review,billable") {
+		t.Errorf("csv = %q", lines)
+	}
+
+	for _, query := range []string{"?from=2026-03-05&to=2026-03-01", "?from=bogus", "?from=2020-01-01&to=2026-03-01"} {
+		if rec := tc.do(http.MethodGet, "/time"+query, nil); rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", query, rec.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+// サーバーがUTC以外で動いていても、今日計測した時間は今日のレポートとCSVに入る
+func TestTimeReportOutsideUTC(t *testing.T) {
+	// 時差が大きいほど、ローカル時刻のまま保存した記録がレポートの期間から外れやすい
+	setLocalTimezone(t, 23)
+	e, db := newTestServer(t)
+	ctx := t.Context()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := factory.New().NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title("night shift")).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	for _, action := range []string{"start", "stop"} {
+		if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/timer/"+action, url.Values{}); rec.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d", action, rec.Code)
+		}
+	}
+
+	today := time.Now().In(loadLocation(defaultTimezone)).Format(time.DateOnly)
+	query := "?from=" + today + "&to=" + today
+	if body := tc.do(http.MethodGet, "/time"+query, nil).Body.String(); !strings.Contains(body, "night shift") {
+		t.Error("report does not contain today's entry")
+	}
+	if body := tc.do(http.MethodGet, "/time/export"+query, nil).Body.String(); !strings.Contains(body, "night shift") {
+		t.Error("csv does not contain today's entry")
+	}
+}
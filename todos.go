@@ -0,0 +1,514 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zhttp"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/storage"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+type TodoInput struct {
+	Title string `zog:"title"`
+}
+
+var todoSchema = z.Struct(z.Shape{
+	"Title": z.String().Trim().Required(z.Message("タイトルは必須です")).Min(1, z.Message("タイトルは必須です")),
+})
+
+// Todoの優先度（todos.priority の値）
+const (
+	priorityNone int64 = iota
+	priorityLow
+	priorityMedium
+	priorityHigh
+	priorityUrgent
+)
+
+// registerTodoRoutes はTodoのルートを登録する
+// requireAuth の後ろに置く。個別のTodoへの操作はrequireTodoRoleで権限を確認する
+func registerTodoRoutes(g *echo.Group, db bob.DB, store storage.Storage) {
+	// 受信箱（リストに属さないTodo）の一覧
+	g.GET("", func(c echo.Context) error {
+		userID := c.Get("user_id").(int64)
+		return renderTodoIndex(c, db, nil, bob.Mods[*dialect.SelectQuery]{
+			models.SelectWhere.Todos.UserID.EQ(userID),
+			models.SelectWhere.Todos.ListID.IsNull(),
+		})
+	})
+
+	// 今日・近日（アクセスできる未完了のTodoを期限日で分類）
+	g.GET("/upcoming", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		todos, err := models.Todos.Query(
+			sm.Where(accessibleTodos(userID)),
+			models.SelectWhere.Todos.Completed.EQ(false),
+			models.SelectWhere.Todos.DueAt.IsNotNull(),
+			sm.OrderBy(models.Todos.Columns.DueAt),
+			sm.OrderBy(models.Todos.Columns.ID),
+			models.SelectThenLoad.Todo.Tags(sm.OrderBy(models.Tags.Columns.Name)),
+			models.SelectThenLoad.Todo.Children(sm.OrderBy(models.Todos.Columns.ID)),
+			models.SelectThenLoad.Todo.TimeEntries(),
+			models.SelectThenLoad.Todo.Assignee(),
+			todoBlockersLoad(),
+		).All(ctx, db)
+		if err != nil {
+			return err
+		}
+		groups := groupByDue(todos, time.Now(), c.Get("location").(*time.Location))
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.UpcomingIndex(groups, csrfToken))
+	})
+
+	// Todo作成
+	g.POST("", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		title := c.FormValue("title")
+		if title == "" {
+			return c.Redirect(http.StatusFound, "/todos")
+		}
+		var todo *models.Todo
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			position, err := appendPosition(ctx, exec, positionScopeForList(0, userID))
+			if err != nil {
+				return err
+			}
+			todo, err = models.Todos.Insert(&models.TodoSetter{
+				UserID:   omit.From(userID),
+				Title:    omit.From(title),
+				Position: omit.From(position),
+			}).One(ctx, exec)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, todo)
+	})
+
+	// Todo完了状態の切り替え
+	// 未完了のTodoにブロックされているTodoは、force を指定したときだけ完了にする
+	g.POST("/:id/toggle", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		if !todo.Completed && c.FormValue("force") == "" {
+			if err := checkNotBlocked(ctx, db, todo); err != nil {
+				return err
+			}
+		}
+
+		// 繰り返しのTodoを完了にしたら次の発生日のTodoを作り、完了したTodoとあわせて返す
+		if todo.Recurrence.IsValue() && !todo.Completed {
+			var next *models.Todo
+			err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+				var err error
+				next, err = completeRecurringTodo(ctx, exec, todo, time.Now().UTC(), c.Get("location").(*time.Location), nil)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			return renderTodoItem(c, db, todo, next)
+		}
+
+		// サブタスクならすべて完了したかどうかで親の完了状態を更新する
+		parent, err := subtaskParent(ctx, db, todo)
+		if err != nil {
+			return err
+		}
+		// 繰り返しの親が完了になったら、次の発生日のTodoもあわせて返す
+		now := time.Now().UTC()
+		var next *models.Todo
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			err := todo.Update(ctx, exec, &models.TodoSetter{
+				Completed: omit.From(!todo.Completed),
+				UpdatedAt: omit.From(now),
+			})
+			if err != nil || parent == nil {
+				return err
+			}
+			next, err = syncParentCompletion(ctx, exec, parent, now, c.Get("location").(*time.Location))
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, todo, next)
+	}, requireTodoRole(db, RoleEditor))
+
+	// タイトルの編集フォーム
+	g.GET("/:id/edit", func(c echo.Context) error {
+		todo := c.Get("todo").(*models.Todo)
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.TodoTitleForm(todo, todo.Title, nil, csrfToken))
+	}, requireTodoRole(db, RoleEditor))
+
+	// タイトルの表示（編集をやめたとき）
+	g.GET("/:id/title", func(c echo.Context) error {
+		return render(c, http.StatusOK, views.TodoTitle(c.Get("todo").(*models.Todo)))
+	}, requireTodoRole(db, RoleViewer))
+
+	// タイトルの変更。入力に誤りがあれば、入力した値を残したままフォームにエラーを表示する
+	// エラーのフォームはHTMXに差し替えさせるため 422 で返す（frontend/src/main.js）
+	updateTodo := func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+
+		var input TodoInput
+		if issues := todoSchema.Parse(zhttp.Request(c.Request()), &input); len(issues) > 0 {
+			csrfToken := c.Get("csrf").(string)
+			return render(c, http.StatusUnprocessableEntity, views.TodoTitleForm(todo, c.FormValue("title"), issuesToMap(issues)["title"], csrfToken))
+		}
+		err := todo.Update(ctx, db, &models.TodoSetter{
+			Title:     omit.From(input.Title),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		})
+		if err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, views.TodoTitle(todo))
+	}
+	g.PUT("/:id", updateTodo, requireTodoRole(db, RoleEditor))
+	g.POST("/:id", updateTodo, requireTodoRole(db, RoleEditor))
+
+	// 優先度の変更
+	g.POST("/:id/priority", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+
+		priority, err := strconv.ParseInt(c.FormValue("priority"), 10, 64)
+		if err != nil || priority < priorityNone || priority > priorityUrgent {
+			return echo.NewHTTPError(http.StatusBadRequest, "優先度が正しくありません")
+		}
+		err = todo.Update(ctx, db, &models.TodoSetter{
+			Priority:  omit.From(priority),
+			UpdatedAt: omit.From(time.Now().UTC()),
+		})
+		if err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+
+	// 期限日の設定・変更・クリア（due_on が空か clear 指定ならクリア）
+	g.POST("/:id/due", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+
+		setter := &models.TodoSetter{UpdatedAt: omit.From(time.Now().UTC())}
+		if v := c.FormValue("due_on"); v != "" && c.FormValue("clear") == "" {
+			dueAt, err := parseDueDate(v, c.Get("location").(*time.Location))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "期限日が正しくありません")
+			}
+			setter.DueAt = omitnull.From(dueAt)
+		} else {
+			setter.DueAt.Null()
+		}
+
+		if err := todo.Update(ctx, db, setter); err != nil {
+			return err
+		}
+		return renderTodoItem(c, db, todo)
+	}, requireTodoRole(db, RoleEditor))
+
+	// Todoを別のリストへ移動（list_id が空なら自分の受信箱へ移す）
+	g.POST("/:id/move", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID := c.Get("user_id").(int64)
+		todo := c.Get("todo").(*models.Todo)
+		if todo.ParentID.IsValue() {
+			return echo.NewHTTPError(http.StatusBadRequest, "サブタスクは親のTodoと一緒に移動してください")
+		}
+
+		setter := &models.TodoSetter{UpdatedAt: omit.From(time.Now().UTC())}
+		scope := positionScope{UserID: userID}
+		if v := c.FormValue("list_id"); v != "" {
+			id, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest)
+			}
+			// 移動先のリストにも編集権限が必要
+			list, _, err := findListWithRole(ctx, db, userID, id, RoleEditor)
+			if err != nil {
+				return err
+			}
+			setter.ListID = omitnull.From(list.ID)
+			scope.ListID = null.From(list.ID)
+		} else {
+			setter.ListID.Null()
+			setter.UserID = omit.From(userID)
+		}
+
+		// サブタスクも親と同じ移動先へ移す。親は移動先の末尾に並べる
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			childSetter := *setter
+			position, err := appendPosition(ctx, exec, scope)
+			if err != nil {
+				return err
+			}
+			setter.Position = omit.From(position)
+			if err := todo.Update(ctx, exec, setter); err != nil {
+				return err
+			}
+			_, err = models.Todos.Update(
+				childSetter.UpdateMod(),
+				models.UpdateWhere.Todos.ParentID.EQ(todo.ID),
+			).Exec(ctx, exec)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusOK)
+	}, requireTodoRole(db, RoleEditor))
+
+	// Todo削除。削除したTodoはサブタスクと一緒にゴミ箱に入れ、元に戻すボタンのトーストを返す
+	// 行はすぐには消さず、元に戻したときに同じ場所へ描き直せるよう空の行に置き換える
+	g.POST("/:id/delete", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo := c.Get("todo").(*models.Todo)
+		parent, err := subtaskParent(ctx, db, todo)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		var next *models.Todo
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			if err := softDeleteTodo(ctx, exec, todo, now); err != nil {
+				return err
+			}
+			// サブタスクを削除したら残りのサブタスクで親の完了状態と進み具合を更新する
+			if parent != nil {
+				var err error
+				next, err = syncParentCompletion(ctx, exec, parent, now, c.Get("location").(*time.Location))
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		csrfToken := c.Get("csrf").(string)
+		if parent == nil {
+			return render(c, http.StatusOK, templ.Join(views.DeletedTodoItem(todo), views.Toast(todo, csrfToken)))
+		}
+		items, err := todoItems(c, db, parent, next)
+		if err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, templ.Join(append(items, views.Toast(todo, csrfToken))...))
+	}, requireTodoRole(db, RoleEditor))
+
+	registerTodoTagRoutes(g, db)
+	registerSubtaskRoutes(g, db)
+	registerRecurrenceRoutes(g, db)
+	registerReorderRoutes(g, db)
+	registerSearchRoutes(g, db)
+	registerBulkRoutes(g, db)
+	registerNoteRoutes(g, db)
+	registerCommentRoutes(g, db)
+	registerAttachmentRoutes(g, db, store)
+	registerTodoEventRoutes(g, db)
+	registerTimerRoutes(g, db)
+	registerTodoStatusRoutes(g, db)
+	registerTodoScheduleRoutes(g, db)
+	registerDependencyRoutes(g, db)
+	registerAssigneeRoutes(g, db)
+}
+
+// todoSortColumns は並び順の項目と並び替えに使う列
+// 索引（20261017070000_add_todos_sort_indexes.sql）と同じ式にして、どの並び順でも索引でたどれるようにする
+var todoSortColumns = map[string]sqlite.Expression{
+	"created":  todoSortTime("created_at"),
+	"updated":  todoSortTime("updated_at"),
+	"priority": models.Todos.Columns.Priority,
+	"due":      todoSortTime("due_at"),
+	"title":    models.Todos.Columns.Title,
+	"position": models.Todos.Columns.Position,
+}
+
+// todoSortTime は日時の列を並び替えに使う式。先頭の秒までの文字列で並べ、同じ秒のTodoはIDで並べる
+// 日時はアプリが書いた形式（time.Time.String()）とDBの既定値（CURRENT_TIMESTAMP）の形式が混ざっているが、先頭の秒まではそろっている
+func todoSortTime(column string) sqlite.Expression {
+	return sqlite.Raw(`substr("todos"."` + column + `", 1, 19)`)
+}
+
+// resolveTodoSort はTodo一覧の並び順を決める
+// クエリパラメータ sort と dir が正しければそれを使ってユーザーの設定として保存し、
+// 指定がなければ前回保存した並び順を使う
+func resolveTodoSort(c echo.Context, db bob.DB) (views.TodoSort, error) {
+	ctx := c.Request().Context()
+	user, err := models.FindUser(ctx, db, c.Get("user_id").(int64))
+	if err != nil {
+		return views.TodoSort{}, err
+	}
+
+	sort := views.TodoSort{Field: c.QueryParam("sort"), Dir: c.QueryParam("dir")}
+	if sort.Dir == "" {
+		sort.Dir = "asc"
+	}
+	if _, ok := todoSortColumns[sort.Field]; !ok || (sort.Dir != "asc" && sort.Dir != "desc") {
+		return views.TodoSort{Field: user.TodoSort, Dir: user.TodoSortDir}, nil
+	}
+
+	if sort.Field != user.TodoSort || sort.Dir != user.TodoSortDir {
+		err := user.Update(ctx, db, &models.UserSetter{
+			TodoSort:    omit.From(sort.Field),
+			TodoSortDir: omit.From(sort.Dir),
+		})
+		if err != nil {
+			return views.TodoSort{}, err
+		}
+	}
+	return sort, nil
+}
+
+// todoOrderBy は並び順に対応するORDER BY句を返す
+// 期限のないTodoは向きにかかわらず最後にし、同順位はIDで並べる
+func todoOrderBy(sort views.TodoSort) bob.Mod[*dialect.SelectQuery] {
+	column, ok := todoSortColumns[sort.Field]
+	if !ok {
+		column = todoSortColumns["created"]
+	}
+	// 手動の並び順は常に位置の昇順
+	if sort.Field == "position" {
+		return positionOrder()
+	}
+	order := sm.OrderBy(column).Asc()
+	tiebreak := sm.OrderBy(models.Todos.Columns.ID).Asc()
+	if sort.Dir == "desc" {
+		order = sm.OrderBy(column).Desc()
+		tiebreak = sm.OrderBy(models.Todos.Columns.ID).Desc()
+	}
+	if sort.Field == "due" {
+		order = order.NullsLast()
+	}
+	return bob.Mods[*dialect.SelectQuery]{order, tiebreak}
+}
+
+// todoIndexMods はTodo一覧の並び順と絞り込み、タグ・サブタスク・作業時間・ブロックしているTodoの読み込みをまとめたもの
+// 一覧にはサブタスクを除いたTodoを並べ、サブタスクは親の行の中に表示する
+// これらはTodoごとではなく一覧全体でそれぞれ1回のクエリで読み込む
+func todoIndexMods(sort views.TodoSort, filter views.TodoFilter) bob.Mod[*dialect.SelectQuery] {
+	mods := bob.Mods[*dialect.SelectQuery]{
+		models.SelectWhere.Todos.ParentID.IsNull(),
+		todoStatusFilter(filter.Status),
+		todoOrderBy(sort),
+		models.SelectThenLoad.Todo.Tags(sm.OrderBy(models.Tags.Columns.Name)),
+		models.SelectThenLoad.Todo.Children(sm.OrderBy(models.Todos.Columns.ID)),
+		models.SelectThenLoad.Todo.TimeEntries(),
+		models.SelectThenLoad.Todo.Assignee(),
+		todoBlockersLoad(),
+	}
+	if filter.Tag != "" {
+		mods = append(mods, todoTagFilter(filter.Tag))
+	}
+	return mods
+}
+
+// renderTodoIndex はTodo一覧ページを返す。scope は受信箱かリストかの条件
+// 並び替えや絞り込みのHTMXリクエストには一覧部分だけを、続きの読み込み（HX-Target が todo-items）には
+// 続きの行と次の読み込みの目印だけを返す
+func renderTodoIndex(c echo.Context, db bob.DB, list *models.List, scope bob.Mod[*dialect.SelectQuery]) error {
+	ctx := c.Request().Context()
+	sort, err := resolveTodoSort(c, db)
+	if err != nil {
+		return err
+	}
+	filter := todoIndexFilter(c)
+	after, err := todoIndexAfter(c)
+	if err != nil {
+		return err
+	}
+	page, err := loadTodoPage(ctx, db, scope, sort, filter, after)
+	if err != nil {
+		return err
+	}
+
+	csrfToken := c.Get("csrf").(string)
+	target := c.Request().Header.Get("HX-Target")
+	if target == "todo-items" {
+		return render(c, http.StatusOK, views.TodoPageItems(list, page, sort, filter, csrfToken))
+	}
+	if page.Counts, err = countTodos(ctx, db, scope, filter); err != nil {
+		return err
+	}
+	if target == "todo-list" {
+		return render(c, http.StatusOK, views.TodoListView(list, page, sort, filter, csrfToken))
+	}
+	return render(c, http.StatusOK, views.TodoIndex(list, page, sort, filter, csrfToken))
+}
+
+// renderTodoItem はタグとサブタスクを読み込んだうえでTodo1件分の行を返す
+// 親の行の中で操作されたサブタスク（HX-Target が親の行）なら、進み具合も変わるので親の行を返す
+// 繰り返しで作られた次のTodoなど、続けて表示する行があれば others に渡す（nilは無視する）
+func renderTodoItem(c echo.Context, db bob.DB, todo *models.Todo, others ...*models.Todo) error {
+	items, err := todoItems(c, db, todo, others...)
+	if err != nil {
+		return err
+	}
+	return render(c, http.StatusOK, templ.Join(items...))
+}
+
+// todoItems は renderTodoItem が返す行を、トーストなど別の部品と並べられるようにコンポーネントとして返す
+func todoItems(c echo.Context, db bob.DB, todo *models.Todo, others ...*models.Todo) ([]templ.Component, error) {
+	ctx := c.Request().Context()
+	parent, err := subtaskParent(ctx, db, todo)
+	if err != nil {
+		return nil, err
+	}
+	if parent != nil && c.Request().Header.Get("HX-Target") == "todo-"+strconv.FormatInt(parent.ID, 10) {
+		todo = parent
+	}
+
+	csrfToken := c.Get("csrf").(string)
+	var items []templ.Component
+	for _, t := range append([]*models.Todo{todo}, others...) {
+		if t == nil {
+			continue
+		}
+		if err := loadTodoItem(ctx, db, t); err != nil {
+			return nil, err
+		}
+		items = append(items, views.TodoItem(t, csrfToken))
+	}
+	return items, nil
+}
+
+// loadTodoItem は TodoItem が表示するタグ・サブタスク・作業時間・ブロックしているTodoを読み込む
+func loadTodoItem(ctx context.Context, db bob.DB, todo *models.Todo) error {
+	if err := todo.LoadTags(ctx, db, sm.OrderBy(models.Tags.Columns.Name)); err != nil {
+		return err
+	}
+	if err := todo.LoadChildren(ctx, db, sm.OrderBy(models.Todos.Columns.ID)); err != nil {
+		return err
+	}
+	if err := todo.LoadTimeEntries(ctx, db); err != nil {
+		return err
+	}
+	if todo.AssigneeID.IsValue() {
+		if err := todo.LoadAssignee(ctx, db); err != nil {
+			return err
+		}
+	}
+	return todo.LoadDependencies(ctx, db, models.SelectThenLoad.TodoDependency.Blocker())
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+func TestTodosAreIsolatedPerUser(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	bob := createTestUser(t, db, "bob@example.com")
+	aliceTodo := f.NewTodoWithContext(ctx, factory.TodoMods.Title("alice-todo"), factory.TodoMods.Completed(false), factory.TodoMods.WithExistingUser(alice)).CreateOrFail(ctx, t, db)
+	bobTodo := f.NewTodoWithContext(ctx, factory.TodoMods.Title("bob-todo"), factory.TodoMods.Completed(false), factory.TodoMods.WithExistingUser(bob)).CreateOrFail(ctx, t, db)
+
+	for _, tt := range []struct {
+		name  string
+		user  *models.User
+		own   *models.Todo
+		other *models.Todo
+	}{
+		{name: "alice", user: alice, own: aliceTodo, other: bobTodo},
+		{name: "bob", user: bob, own: bobTodo, other: aliceTodo},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := login(t, e, tt.user)
+			before, err := models.FindTodo(ctx, db, tt.other.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rec := tc.do(http.MethodGet, "/todos", nil)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("GET /todos: status = %d", rec.Code)
+			}
+			if !strings.Contains(rec.Body.String(), tt.own.Title) {
+				t.Errorf("GET /todos: own todo %q not listed", tt.own.Title)
+			}
+			if strings.Contains(rec.Body.String(), tt.other.Title) {
+				t.Errorf("GET /todos: other user's todo %q is listed", tt.other.Title)
+			}
+
+			otherID := strconv.FormatInt(tt.other.ID, 10)
+			if rec := tc.do(http.MethodPost, "/todos/"+otherID+"/toggle", url.Values{}); rec.Code != http.StatusNotFound {
+				t.Errorf("toggle other user's todo: status = %d, want %d", rec.Code, http.StatusNotFound)
+			}
+			if rec := tc.do(http.MethodPost, "/todos/"+otherID+"/delete", url.Values{}); rec.Code != http.StatusNotFound {
+				t.Errorf("delete other user's todo: status = %d, want %d", rec.Code, http.StatusNotFound)
+			}
+
+			after, err := models.FindTodo(ctx, db, tt.other.ID)
+			if err != nil {
+				t.Fatalf("other user's todo was deleted: %v", err)
+			}
+			if after.Completed != before.Completed {
+				t.Errorf("other user's todo was toggled")
+			}
+
+			ownID := strconv.FormatInt(tt.own.ID, 10)
+			if rec := tc.do(http.MethodPost, "/todos/"+ownID+"/toggle", url.Values{}); rec.Code != http.StatusOK {
+				t.Errorf("toggle own todo: status = %d, want %d", rec.Code, http.StatusOK)
+			}
+		})
+	}
+}
+
+func TestCreateTodoAssignsOwner(t *testing.T) {
+	e, db := newTestServer(t)
+	alice := createTestUser(t, db, "alice@example.com")
+	tc := login(t, e, alice)
+
+	rec := tc.do(http.MethodPost, "/todos", url.Values{"title": {"new todo"}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST /todos: status = %d", rec.Code)
+	}
+
+	todos, err := alice.Todos().All(t.Context(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(todos) != 1 || todos[0].Title != "new todo" {
+		t.Fatalf("alice's todos = %v, want one todo titled %q", todos, "new todo")
+	}
+}
+
+func TestUpdateTodoTitle(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	bob := createTestUser(t, db, "bob@example.com")
+	todo := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title("old title")).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	path := "/todos/" + strconv.FormatInt(todo.ID, 10)
+
+	rec := tc.do(http.MethodGet, path+"/edit", nil)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `value="old title"`) {
+		t.Fatalf("edit form: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	// 空白だけのタイトルは入力した値を残したままエラーを返し、保存しない
+	rec = tc.do(http.MethodPost, path, url.Values{"title": {"   "}})
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("blank title: status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, "タイトルは必須です") || !strings.Contains(body, `value="   "`) {
+		t.Errorf("blank title response = %s", body)
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut} {
+		rec = tc.do(method, path, url.Values{"title": {"  new title " + method + "  "}})
+		if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "new title "+method+"</span>") {
+			t.Errorf("%s: status = %d, body = %s", method, rec.Code, rec.Body.String())
+		}
+		if err := todo.Reload(ctx, db); err != nil || todo.Title != "new title "+method {
+			t.Errorf("%s: title = %q, %v", method, todo.Title, err)
+		}
+	}
+
+	// 編集をやめたときは元のタイトルを返す
+	if rec := tc.do(http.MethodGet, path+"/title", nil); !strings.Contains(rec.Body.String(), `id="todo-title-`) {
+		t.Errorf("cancel: body = %s", rec.Body.String())
+	}
+	if rec := login(t, e, bob).do(http.MethodPost, path, url.Values{"title": {"hijacked"}}); rec.Code != http.StatusNotFound {
+		t.Errorf("other user: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
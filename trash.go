@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/labstack/echo/v4"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/storage"
+	"github.com/kimihito-sandbox/gostack-test/views"
+)
+
+// defaultTrashRetention はゴミ箱のTodoを完全に削除するまでの既定の期間
+const defaultTrashRetention = 30 * 24 * time.Hour
+
+// trashPurgeInterval は保持期間を過ぎたTodoを探す間隔
+const trashPurgeInterval = time.Hour
+
+// 削除したTodo（deleted_at が入っているTodo）は models.Todos のクエリから常に除く
+// ゴミ箱の表示や完全な削除など、削除したTodoも扱うクエリは withDeletedTodos のContextで実行する
+func init() {
+	models.Todos.SelectQueryHooks.AppendHooks(excludeDeletedTodos)
+}
+
+// includeDeletedTodosKey は削除したTodoもクエリの対象にするかどうかのContextキー
+type includeDeletedTodosKey struct{}
+
+// withDeletedTodos は削除したTodoもクエリの対象にするContextを返す
+func withDeletedTodos(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedTodosKey{}, true)
+}
+
+func excludeDeletedTodos(ctx context.Context, _ bob.Executor, q *dialect.SelectQuery) (context.Context, error) {
+	if include, _ := ctx.Value(includeDeletedTodosKey{}).(bool); !include {
+		q.AppendWhere(models.Todos.Columns.DeletedAt.IsNull())
+	}
+	return ctx, nil
+}
+
+// registerTrashRoutes はゴミ箱のルートを登録する
+// ゴミ箱には編集権限のあるTodoのうち、削除したものを表示する。親と一緒に削除したサブタスクは親の行にまとめる
+func registerTrashRoutes(g *echo.Group, db bob.DB, store storage.Storage) {
+	// ゴミ箱
+	g.GET("", func(c echo.Context) error {
+		todos, err := trashedTodos(c.Request().Context(), db, c.Get("user_id").(int64))
+		if err != nil {
+			return err
+		}
+		csrfToken := c.Get("csrf").(string)
+		return render(c, http.StatusOK, views.TrashIndex(todos, csrfToken))
+	})
+
+	// 元に戻す。親と一緒に削除したサブタスクも戻す
+	// 削除直後のトーストから戻した場合（HX-Target が一覧の行）は、その行を描き直してトーストを消す
+	g.POST("/:id/restore", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todo, err := findTrashedTodo(c, db)
+		if err != nil {
+			return err
+		}
+		parent, err := subtaskParent(ctx, db, todo)
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusBadRequest, "親のTodoがゴミ箱にあるため戻せません")
+		}
+		if err != nil {
+			return err
+		}
+
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			if err := restoreTodo(ctx, exec, todo); err != nil {
+				return err
+			}
+			if parent != nil {
+				_, err := syncParentCompletion(ctx, exec, parent, time.Now().UTC(), c.Get("location").(*time.Location))
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if !strings.HasPrefix(c.Request().Header.Get("HX-Target"), "todo-") {
+			return c.Redirect(http.StatusFound, "/trash")
+		}
+		items, err := todoItems(c, db, todo)
+		if err != nil {
+			return err
+		}
+		return render(c, http.StatusOK, templ.Join(append(items, views.Toast(nil, ""))...))
+	})
+
+	// 完全に削除
+	g.POST("/:id/purge", func(c echo.Context) error {
+		todo, err := findTrashedTodo(c, db)
+		if err != nil {
+			return err
+		}
+		if err := purgeTodo(c.Request().Context(), db, store, todo); err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/trash")
+	})
+
+	// ゴミ箱を空にする
+	g.POST("/empty", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		todos, err := trashedTodos(ctx, db, c.Get("user_id").(int64))
+		if err != nil {
+			return err
+		}
+		for _, todo := range todos {
+			if err := purgeTodo(ctx, db, store, todo); err != nil {
+				return err
+			}
+		}
+		return c.Redirect(http.StatusFound, "/trash")
+	})
+}
+
+// trashedTodos はユーザーが編集できる削除済みのTodoを、削除が新しい順に返す
+// 親と一緒に削除したサブタスクは含めず、親の R.Children に読み込む
+func trashedTodos(ctx context.Context, db bob.DB, userID int64) (models.TodoSlice, error) {
+	ctx = withDeletedTodos(ctx)
+	candidates, err := models.Todos.Query(
+		models.SelectWhere.Todos.DeletedAt.IsNotNull(),
+		sm.Where(accessibleTodos(userID)),
+		// 親がゴミ箱にあるサブタスクは親と一緒に扱う
+		sm.Where(sqlite.Or(
+			models.Todos.Columns.ParentID.IsNull(),
+			models.Todos.Columns.ParentID.OP("IN", sqlite.Select(
+				sm.Columns(models.Todos.Columns.ID),
+				sm.From(models.Todos.Name()),
+				models.SelectWhere.Todos.DeletedAt.IsNull(),
+			)),
+		)),
+		sm.OrderBy(models.Todos.Columns.DeletedAt).Desc(),
+		sm.OrderBy(models.Todos.Columns.ID).Desc(),
+		models.SelectThenLoad.Todo.List(),
+		models.SelectThenLoad.Todo.Parent(),
+		models.SelectThenLoad.Todo.Children(
+			models.SelectWhere.Todos.DeletedAt.IsNotNull(),
+			sm.OrderBy(models.Todos.Columns.ID),
+		),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	// 閲覧だけのリストのTodoは戻したり削除したりできないので表示しない
+	todos := make(models.TodoSlice, 0, len(candidates))
+	for _, todo := range candidates {
+		role, err := todoRole(ctx, db, userID, todo)
+		if err != nil {
+			return nil, err
+		}
+		if role >= RoleEditor {
+			todos = append(todos, todo)
+		}
+	}
+	return todos, nil
+}
+
+// findTrashedTodo はパスの :id の削除済みのTodoを、編集権限を確かめてから返す
+func findTrashedTodo(c echo.Context, db bob.DB) (*models.Todo, error) {
+	ctx := c.Request().Context()
+	id, err := paramID(c)
+	if err != nil {
+		return nil, err
+	}
+	todo, err := models.Todos.Query(
+		models.SelectWhere.Todos.ID.EQ(id),
+		models.SelectWhere.Todos.DeletedAt.IsNotNull(),
+	).One(withDeletedTodos(ctx), db)
+	if err != nil {
+		return nil, notFoundIfNoRows(err)
+	}
+	role, err := todoRole(ctx, db, c.Get("user_id").(int64), todo)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRole(role, RoleEditor); err != nil {
+		return nil, err
+	}
+	return todo, nil
+}
+
+// softDeleteTodo はTodoとまだ削除していないサブタスクをゴミ箱に入れる
+func softDeleteTodo(ctx context.Context, exec bob.Executor, todo *models.Todo, now time.Time) error {
+	return softDeleteTodos(ctx, exec, models.TodoSlice{todo}, now)
+}
+
+// softDeleteTodos は複数のTodoとまだ削除していないサブタスクを1回の更新でゴミ箱に入れる
+// サブタスクには親と同じ削除日時を入れ、親を戻すときに一緒に戻せるようにする
+func softDeleteTodos(ctx context.Context, exec bob.Executor, todos models.TodoSlice, now time.Time) error {
+	// 戻すときに削除日時の一致で比べるので、DBに書いた値と読み戻した値が同じ文字列になるようUTCにそろえる
+	now = now.UTC()
+	ids := make([]int64, len(todos))
+	for i, todo := range todos {
+		ids[i] = todo.ID
+	}
+	_, err := models.Todos.Update(
+		models.TodoSetter{DeletedAt: omitnull.From(now)}.UpdateMod(),
+		sqlite.WhereOr(
+			models.UpdateWhere.Todos.ID.In(ids...),
+			models.UpdateWhere.Todos.ParentID.In(ids...),
+		),
+		models.UpdateWhere.Todos.DeletedAt.IsNull(),
+	).Exec(ctx, exec)
+	if err != nil {
+		return err
+	}
+	for _, todo := range todos {
+		todo.DeletedAt = null.From(now)
+	}
+	return nil
+}
+
+// restoreTodo はTodoと、親と一緒に削除したサブタスクをゴミ箱から戻す
+// 先に個別に削除していたサブタスクはゴミ箱に残す
+func restoreTodo(ctx context.Context, exec bob.Executor, todo *models.Todo) error {
+	deletedAt, ok := todo.DeletedAt.Get()
+	if !ok {
+		return nil
+	}
+	setter := models.TodoSetter{}
+	setter.DeletedAt.Null()
+	_, err := models.Todos.Update(
+		setter.UpdateMod(),
+		sqlite.WhereOr(
+			models.UpdateWhere.Todos.ID.EQ(todo.ID),
+			sqlite.WhereAnd(
+				models.UpdateWhere.Todos.ParentID.EQ(todo.ID),
+				models.UpdateWhere.Todos.DeletedAt.EQ(deletedAt),
+			),
+		),
+	).Exec(ctx, exec)
+	if err != nil {
+		return err
+	}
+	todo.DeletedAt.Null()
+	return nil
+}
+
+// purgeTodo はTodoを完全に削除する。サブタスクは外部キーの ON DELETE CASCADE で一緒に削除され、
+// どの添付ファイルからも参照されなくなった中身も保存先から消す
+func purgeTodo(ctx context.Context, db bob.DB, store storage.Storage, todo *models.Todo) error {
+	keys, err := todoAttachmentKeys(ctx, db, todo.ID)
+	if err != nil {
+		return err
+	}
+	if err := todo.Delete(ctx, db); err != nil {
+		return err
+	}
+	return deleteUnusedBlobs(ctx, db, store, keys...)
+}
+
+// purgeExpiredTodos は before より前に削除したTodoを完全に削除し、削除した件数を返す
+func purgeExpiredTodos(ctx context.Context, db bob.DB, store storage.Storage, before time.Time) (int, error) {
+	todos, err := models.Todos.Query(
+		models.SelectWhere.Todos.DeletedAt.LT(before),
+		// 親から先に消し、親と一緒に消えたサブタスクは飛ばす
+		sm.OrderBy(models.Todos.Columns.ParentID).Asc().NullsFirst(),
+	).All(withDeletedTodos(ctx), db)
+	if err != nil {
+		return 0, err
+	}
+	purged := make(map[int64]bool, len(todos))
+	for _, todo := range todos {
+		if parentID, ok := todo.ParentID.Get(); ok && purged[parentID] {
+			continue
+		}
+		if err := purgeTodo(ctx, db, store, todo); err != nil {
+			return 0, err
+		}
+		purged[todo.ID] = true
+	}
+	return len(purged), nil
+}
+
+// runTrashPurger は保持期間を過ぎたゴミ箱のTodoを定期的に完全に削除する。ctx が終わるまで戻らない
+func runTrashPurger(ctx context.Context, db bob.DB, store storage.Storage, retention time.Duration, logger echo.Logger) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		n, err := purgeExpiredTodos(ctx, db, store, time.Now().UTC().Add(-retention))
+		if err != nil {
+			logger.Errorf("purge trash: %v", err)
+		} else if n > 0 {
+			logger.Infof("purge trash: %d todos", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// trashRetention は環境変数 TRASH_RETENTION_DAYS（既定は30日）で指定したゴミ箱の保持期間を返す
+func trashRetention() (time.Duration, error) {
+	v := os.Getenv("TRASH_RETENTION_DAYS")
+	if v == "" {
+		return defaultTrashRetention, nil
+	}
+	days, err := strconv.Atoi(v)
+	if err != nil || days < 1 {
+		return 0, fmt.Errorf("TRASH_RETENTION_DAYS must be a positive number of days: %q", v)
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
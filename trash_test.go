@@ -0,0 +1,215 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/kimihito-sandbox/gostack-test/factory"
+	"github.com/kimihito-sandbox/gostack-test/models"
+	"github.com/kimihito-sandbox/gostack-test/storage"
+)
+
+func TestDeleteTodoMovesToTrashAndUndo(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	todo := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Title("trash-parent")).CreateOrFail(ctx, t, db)
+	child := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(alice),
+		factory.TodoMods.ParentID(null.From(todo.ID)),
+		factory.TodoMods.Title("trash-child"),
+	).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	todoID := strconv.FormatInt(todo.ID, 10)
+
+	rec := tc.do(http.MethodPost, "/todos/"+todoID+"/delete", url.Values{})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("delete: status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `<li id="todo-`+todoID+`" hidden>`) || !strings.Contains(body, `hx-swap-oob="true"`) || !strings.Contains(body, "/trash/"+todoID+"/restore") {
+		t.Errorf("delete response has no placeholder or undo toast: %s", body)
+	}
+
+	// 通常の一覧や検索からは消え、ゴミ箱に出る
+	if exists, _ := models.TodoExists(ctx, db, child.ID); exists {
+		t.Error("subtask is still visible after deleting its parent")
+	}
+	if rec := tc.do(http.MethodGet, "/todos", nil); strings.Contains(rec.Body.String(), "trash-parent") {
+		t.Error("deleted todo is listed in the inbox")
+	}
+	rec = tc.do(http.MethodGet, "/trash", nil)
+	if !strings.Contains(rec.Body.String(), "trash-parent") || !strings.Contains(rec.Body.String(), "サブタスク1件") {
+		t.Errorf("trash does not list the deleted todo: %s", rec.Body.String())
+	}
+
+	// トーストから元に戻すと行を描き直し、トーストを消す
+	rec = tc.doWithHeader(http.MethodPost, "/trash/"+todoID+"/restore", url.Values{}, http.Header{"Hx-Target": {"todo-" + todoID}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("undo: status = %d", rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, "trash-child") || !strings.Contains(body, `<div id="toast" hx-swap-oob="true"`) {
+		t.Errorf("undo response = %s", body)
+	}
+	for _, todo := range []*models.Todo{todo, child} {
+		if exists, _ := models.TodoExists(ctx, db, todo.ID); !exists {
+			t.Errorf("%s not restored", todo.Title)
+		}
+	}
+	if rec := tc.do(http.MethodGet, "/trash", nil); strings.Contains(rec.Body.String(), "trash-parent") {
+		t.Error("restored todo is still in the trash")
+	}
+}
+
+func TestRestoreSubtask(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	alice := createTestUser(t, db, "alice@example.com")
+	parent := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	done := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.ParentID(null.From(parent.ID)), factory.TodoMods.Completed(true)).CreateOrFail(ctx, t, db)
+	open := f.NewTodoWithContext(ctx, factory.TodoMods.WithExistingUser(alice), factory.TodoMods.ParentID(null.From(parent.ID)), factory.TodoMods.Completed(false)).CreateOrFail(ctx, t, db)
+	tc := login(t, e, alice)
+	openPath := "/trash/" + strconv.FormatInt(open.ID, 10) + "/restore"
+
+	// 未完了のサブタスクを削除すると親が完了になり、戻すと未完了に戻る
+	if rec := tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(open.ID, 10)+"/delete", url.Values{}); rec.Code != http.StatusOK {
+		t.Fatalf("delete subtask: status = %d", rec.Code)
+	}
+	if err := parent.Reload(ctx, db); err != nil || !parent.Completed {
+		t.Fatalf("parent completed = %v, %v; want true", parent.Completed, err)
+	}
+	if rec := tc.do(http.MethodPost, openPath, url.Values{}); rec.Code != http.StatusFound {
+		t.Fatalf("restore subtask: status = %d", rec.Code)
+	}
+	if err := parent.Reload(ctx, db); err != nil || parent.Completed {
+		t.Errorf("parent completed = %v, %v; want false", parent.Completed, err)
+	}
+
+	// 親がゴミ箱にあるサブタスクだけを戻すことはできない。親を戻すと一緒に削除したサブタスクも戻る
+	tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(open.ID, 10)+"/delete", url.Values{})
+	tc.do(http.MethodPost, "/todos/"+strconv.FormatInt(parent.ID, 10)+"/delete", url.Values{})
+	if rec := tc.do(http.MethodPost, openPath, url.Values{}); rec.Code != http.StatusBadRequest {
+		t.Errorf("restore subtask of deleted parent: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec := tc.do(http.MethodPost, "/trash/"+strconv.FormatInt(parent.ID, 10)+"/restore", url.Values{}); rec.Code != http.StatusFound {
+		t.Fatalf("restore parent: status = %d", rec.Code)
+	}
+	if exists, _ := models.TodoExists(ctx, db, done.ID); !exists {
+		t.Error("subtask deleted with its parent was not restored")
+	}
+	if exists, _ := models.TodoExists(ctx, db, open.ID); exists {
+		t.Error("subtask deleted before its parent was restored with it")
+	}
+}
+
+func TestTrashAccess(t *testing.T) {
+	e, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+
+	owner := createTestUser(t, db, "owner@example.com")
+	viewer := createTestUser(t, db, "viewer@example.com")
+	stranger := createTestUser(t, db, "stranger@example.com")
+	list := f.NewListWithContext(ctx, factory.ListMods.WithExistingUser(owner)).CreateOrFail(ctx, t, db)
+	f.NewListMemberWithContext(ctx,
+		factory.ListMemberMods.WithExistingList(list),
+		factory.ListMemberMods.WithExistingUser(viewer),
+		factory.ListMemberMods.Role(memberRoleViewer),
+	).CreateOrFail(ctx, t, db)
+	todo := f.NewTodoWithContext(ctx,
+		factory.TodoMods.WithExistingUser(owner),
+		factory.TodoMods.ListID(null.From(list.ID)),
+		factory.TodoMods.Title("shared-deleted"),
+		factory.TodoMods.DeletedAt(null.From(time.Now())),
+	).CreateOrFail(ctx, t, db)
+	restorePath := "/trash/" + strconv.FormatInt(todo.ID, 10) + "/restore"
+
+	viewerClient := login(t, e, viewer)
+	if rec := viewerClient.do(http.MethodGet, "/trash", nil); strings.Contains(rec.Body.String(), "shared-deleted") {
+		t.Error("viewer sees a todo they cannot restore")
+	}
+	if rec := viewerClient.do(http.MethodPost, restorePath, url.Values{}); rec.Code != http.StatusForbidden {
+		t.Errorf("viewer restore: status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	if rec := login(t, e, stranger).do(http.MethodPost, restorePath, url.Values{}); rec.Code != http.StatusNotFound {
+		t.Errorf("stranger restore: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	// 削除したTodoには通常のルートから触れない
+	ownerClient := login(t, e, owner)
+	if rec := ownerClient.do(http.MethodGet, "/todos/"+strconv.FormatInt(todo.ID, 10)+"/notes", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("notes of deleted todo: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if rec := ownerClient.do(http.MethodPost, "/trash/empty", url.Values{}); rec.Code != http.StatusFound {
+		t.Fatalf("empty trash: status = %d", rec.Code)
+	}
+	if exists, _ := models.TodoExists(withDeletedTodos(ctx), db, todo.ID); exists {
+		t.Error("todo still exists after emptying the trash")
+	}
+}
+
+func TestPurgeExpiredTodos(t *testing.T) {
+	_, db := newTestServer(t)
+	ctx := t.Context()
+	f := factory.New()
+	store, err := storage.NewLocal(filepath.Join(t.TempDir(), "attachments"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alice := createTestUser(t, db, "alice@example.com")
+	now := time.Now()
+	newTodo := func(deletedAt null.Val[time.Time], mods ...factory.TodoMod) *models.Todo {
+		mods = append([]factory.TodoMod{factory.TodoMods.WithExistingUser(alice), factory.TodoMods.DeletedAt(deletedAt)}, mods...)
+		return f.NewTodoWithContext(ctx, mods...).CreateOrFail(ctx, t, db)
+	}
+	expired := newTodo(null.From(now.AddDate(0, 0, -31)))
+	expiredChild := newTodo(null.From(now.AddDate(0, 0, -31)), factory.TodoMods.ParentID(null.From(expired.ID)))
+	recent := newTodo(null.From(now.AddDate(0, 0, -1)))
+	alive := newTodo(null.FromPtr[time.Time](nil))
+
+	n, err := purgeExpiredTodos(ctx, db, store, now.AddDate(0, 0, -30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("purged = %d, want 1", n)
+	}
+	for _, tt := range []struct {
+		todo *models.Todo
+		want bool
+	}{
+		{expired, false},
+		{expiredChild, false},
+		{recent, true},
+		{alive, true},
+	} {
+		if exists, _ := models.TodoExists(withDeletedTodos(ctx), db, tt.todo.ID); exists != tt.want {
+			t.Errorf("todo %d exists = %v, want %v", tt.todo.ID, exists, tt.want)
+		}
+	}
+}
+
+func TestTrashRetention(t *testing.T) {
+	t.Setenv("TRASH_RETENTION_DAYS", "")
+	if got, err := trashRetention(); err != nil || got != defaultTrashRetention {
+		t.Errorf("default retention = %s, %v", got, err)
+	}
+	t.Setenv("TRASH_RETENTION_DAYS", "7")
+	if got, err := trashRetention(); err != nil || got != 7*24*time.Hour {
+		t.Errorf("retention = %s, %v; want 168h", got, err)
+	}
+	t.Setenv("TRASH_RETENTION_DAYS", "0")
+	if _, err := trashRetention(); err == nil {
+		t.Error("retention of 0 days accepted")
+	}
+}
@@ -0,0 +1,22 @@
+package views
+
+import (
+	"context"
+	"time"
+)
+
+type locationContextKey struct{}
+
+// LocationToContext はログイン中のユーザーのタイムゾーンをContextに格納する
+func LocationToContext(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationContextKey{}, loc)
+}
+
+// LocationFromContext はContextからユーザーのタイムゾーンを取り出す
+// 格納されていない場合はUTCを返す
+func LocationFromContext(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(locationContextKey{}).(*time.Location); ok {
+		return loc
+	}
+	return time.UTC
+}
@@ -0,0 +1,25 @@
+package views
+
+import "context"
+
+// Permission は表示中のリストに対して画面に出す操作
+type Permission struct {
+	CanEdit   bool // Todoの作成・変更・削除
+	CanManage bool // メンバー管理
+}
+
+type permissionContextKey struct{}
+
+// PermissionToContext は表示中のリストに対する権限をContextに格納する
+func PermissionToContext(ctx context.Context, permission Permission) context.Context {
+	return context.WithValue(ctx, permissionContextKey{}, permission)
+}
+
+// PermissionFromContext はContextから権限を取り出す
+// 格納されていない場合は自分の受信箱なのですべての操作を許可する
+func PermissionFromContext(ctx context.Context) Permission {
+	if permission, ok := ctx.Value(permissionContextKey{}).(Permission); ok {
+		return permission
+	}
+	return Permission{CanEdit: true, CanManage: true}
+}
@@ -0,0 +1,34 @@
+package views
+
+import (
+	"context"
+
+	"github.com/kimihito-sandbox/gostack-test/models"
+)
+
+// SidebarList はサイドバーに表示するリストと未完了Todoの件数
+type SidebarList struct {
+	List      *models.List
+	OpenCount int64
+}
+
+// Sidebar はLayoutのサイドバーに表示する内容
+type Sidebar struct {
+	InboxOpenCount          int64
+	Lists                   []SidebarList
+	UnreadNotificationCount int64
+}
+
+type sidebarContextKey struct{}
+
+// SidebarToContext はサイドバーの内容をContextに格納する
+// 格納されている場合のみLayoutがサイドバーを表示する
+func SidebarToContext(ctx context.Context, sidebar Sidebar) context.Context {
+	return context.WithValue(ctx, sidebarContextKey{}, sidebar)
+}
+
+// SidebarFromContext はContextからサイドバーの内容を取り出す
+func SidebarFromContext(ctx context.Context) (Sidebar, bool) {
+	sidebar, ok := ctx.Value(sidebarContextKey{}).(Sidebar)
+	return sidebar, ok
+}
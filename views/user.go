@@ -0,0 +1,16 @@
+package views
+
+import "context"
+
+type userIDContextKey struct{}
+
+// UserIDToContext はログイン中のユーザーのIDをContextに格納する
+func UserIDToContext(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDContextKey{}, userID)
+}
+
+// UserIDFromContext はContextからログイン中のユーザーのIDを取り出す。格納されていない場合は0を返す
+func UserIDFromContext(ctx context.Context) int64 {
+	userID, _ := ctx.Value(userIDContextKey{}).(int64)
+	return userID
+}